@@ -0,0 +1,138 @@
+/*
+ * Test methods for the array methods (sorting, copying and iteration) and
+ * the Map collection.
+ *
+ * Copyright (C) 2005-2026 J.M. Heisz.  All Rights Reserved.
+ * See the LICENSE file accompanying the distribution your rights to use
+ * this software.
+ */
+
+package gescript
+
+import (
+	"testing"
+)
+
+func TestArraySort(tst *testing.T) {
+	ctx := NewScriptContext()
+
+	// Comparators are called through the process, undefined sorts last
+	checkScript(tst, ctx, `[10, 9, 1, undefined, 2].sort(function(a, b) {
+                               return a - b; }).join()`,
+		"1,2,9,10,")
+	checkScript(tst, ctx, "[10, 9, 1, 2].sort().join()", "1,10,2,9")
+	checkScript(tst, ctx, `var a = [3, 1, 2];
+                           a.toSorted(function(a, b) { return b - a; }).join() +
+                               ':' + a.join()`,
+		"3,2,1:3,1,2")
+	checkScript(tst, ctx, `[{k: 'b', v: 1}, {k: 'a', v: 2}, {k: 'b', v: 0}]
+                               .sort(function(x, y) {
+                                   return x.k < y.k ? -1 : x.k > y.k ? 1 : 0;
+                               }).map(function(e) { return e.v; }).join()`,
+		"2,1,0")
+
+	// Undefined and null elements join as empty strings
+	checkScript(tst, ctx, "[3, undefined, 1].sort().join()", "1,3,")
+	checkScript(tst, ctx, "[1, null, undefined, 2].join('-')", "1---2")
+	checkScript(tst, ctx, "[null].join() + String([null, 1])", ",1")
+	checkScript(tst, ctx, "[1, 2].join(undefined)", "1,2")
+
+	for src, expected := range map[string]string{
+		"[1, 2].sort(function() { throw {name: 'Abort'}; })":     "Abort",
+		"[1, 2].toSorted(function() { throw {name: 'Abort'}; })": "Abort",
+		"[1, 2].sort(5)":             "TypeError",
+		"[1, 2].with(2, 0)":          "RangeError",
+		"[1].flatMap(3)":             "TypeError",
+		"Array.from(null)":           "TypeError",
+		"Array.from([1], 5)":         "TypeError",
+		"Object.groupBy(5, String)":  "TypeError",
+		"new Map([1])":               "TypeError",
+		"[1].findLast(undefined)":    "TypeError",
+		"[1, 2].sort(undefined)[0]":  "",
+		"[...new Map([[1, 2]])][0]":  "",
+		"[2, 1].toSorted()[0] + 'x'": "",
+	} {
+		checkScript(tst, ctx, `var r = '';
+                               try { `+src+`; } catch (e) { r = e.name; }
+                               r`, expected)
+	}
+}
+
+func TestArrayMethods(tst *testing.T) {
+	ctx := NewScriptContext()
+
+	// Copying (non-mutating) methods
+	checkScript(tst, ctx, `var a = [3, 1, 2];
+                           [a.toReversed().join(' '),
+                            a.toSpliced(1, 1, 'x', 'y').join(' '),
+                            a.toSpliced(1).join(' '), a.with(-1, 9).join(' '),
+                            a.join(' ')].join(',')`,
+		"2 1 3,3 x y 2,3,3 1 9,3 1 2")
+
+	// Searching, access and flattening
+	checkScript(tst, ctx, `[[1, 2, 3].at(-1), [1, 2].at(5),
+                            [1, 2, 3, 4].findLast(function(v) {
+                                return v % 2; }),
+                            [1, 2].findLastIndex(function(v) { return v > 5; }),
+                            [[1, 2], [3]].flatMap(function(v) {
+                                return [v.length, v]; }).join(' '),
+                            [1, 2, 3, 4, 5].copyWithin(0, 3).join(' '),
+                            [1, 2, 3, 4, 5].copyWithin(-2, 0, 1).join(' ')]
+                               .join(',')`,
+		"3,,3,-1,2 1,2 1 3,4 5 3 4 5,1 2 3 1 5")
+
+	// Iterators and construction from iterables
+	checkScript(tst, ctx, `var s = '', a = ['a', 'b'];
+                           for (var k of a.keys()) s = s + k;
+                           for (var v of a.values()) s = s + v;
+                           for (var e of a.entries()) s = s + e[0] + e[1];
+                           s + ':' + [...a.keys()].join()`,
+		"01ab0a1b:0,1")
+	checkScript(tst, ctx, `[Array.from('a😀b').length,
+                            Array.from([1, 2], function(v, i) {
+                                return v * 10 + i; }).join(' '),
+                            Array.from({length: 2, '1': 'x'}).join(' '),
+                            Array.from(5).length,
+                            Array.of(7).length].join(',')`,
+		"3,10 21, x,0,1")
+}
+
+func TestMapCollection(tst *testing.T) {
+	ctx := NewScriptContext()
+
+	// Keys are by SameValueZero, in insertion order
+	checkScript(tst, ctx, `var m = new Map([[1, 'a'], ['1', 'b']]);
+                           m.set(0 / 0, 'n'); m.set(-0, 'z'); m.set(0, 'zz');
+                           [m.size, m.get(1), m.get('1'), m.get(0 / 0),
+                            m.get(0), m.has(2), m.delete(1), m.size,
+                            [...m.keys()].join(' ')].join(',')`,
+		"4,a,b,n,zz,false,true,3,1 NaN 0")
+	checkScript(tst, ctx, `var m = new Map(), k = {};
+                           m.set('a', 1).set(k, 2);
+                           var s = '';
+                           for (var e of m) s = s + e[1];
+                           m.forEach(function(v, k) { s = s + v; });
+                           [s, m.get(k), m.get({}), Array.from(m.values()),
+                            typeof m, m instanceof Map, String(m)].join(',')`,
+		"1212,2,,1,2,object,true,[object Map]")
+
+	// Live iteration (entries added during iteration are visited)
+	checkScript(tst, ctx, `var m = new Map([[1, 1]]), s = '';
+                           for (var e of m) {
+                               s = s + e[0];
+                               if (e[0] < 3) m.set(e[0] + 1, 0);
+                               m.delete(1);
+                           }
+                           s + ':' + m.size`,
+		"123:2")
+
+	// Grouping, onto an object or a map
+	checkScript(tst, ctx, `var g = Object.groupBy([1, 2, 3, 4], function(v) {
+                               return v % 2 ? 'odd' : 'even'; });
+                           var mg = Map.groupBy([1, 2, 3, 4], function(v, i) {
+                               return i < 2; });
+                           [g.odd.join(' '), g.even.join(' '),
+                            mg.get(true).join(' '), mg.get(false).join(' '),
+                            mg.size].join(',')`,
+		"1 3,2 4,1 2,3 4,2")
+}
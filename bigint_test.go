@@ -0,0 +1,160 @@
+/*
+ * Test methods for the BigInt primitive type.
+ *
+ * Copyright (C) 2005-2026 J.M. Heisz.  All Rights Reserved.
+ * See the LICENSE file accompanying the distribution your rights to use
+ * this software.
+ */
+
+package gescript
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/heisz/gescript/types"
+)
+
+func TestBigIntScript(tst *testing.T) {
+	ctx := NewScriptContext()
+
+	// Literals, conversion and exact arithmetic beyond the int64 range
+	checkScript(tst, ctx, "typeof 10n + ':' + typeof BigInt(10)",
+		"bigint:bigint")
+	checkScript(tst, ctx, "'' + (9223372036854775807n * 2n + 1n)",
+		"18446744073709551615")
+	checkScript(tst, ctx, `[7n + 3n, 7n - 10n, 7n * 3n, 7n / 2n, -7n / 2n,
+                            7n % 3n, -7n % 3n, -(5n), 0xffn].join(',')`,
+		"10,-3,21,3,-3,1,-1,-5,255")
+	checkScript(tst, ctx, `[6n & 3n, 6n | 3n, 6n ^ 3n, ~5n, -6n & 0xffn,
+                            1n << 70n, -9n >> 1n, 256n >> -2n].join(',')`,
+		"2,7,5,-6,250,1180591620717411303424,-5,1024")
+	checkScript(tst, ctx, "var i = 9007199254740993n; i++; ++i; '' + i",
+		"9007199254740995")
+	checkScript(tst, ctx, `[BigInt('0x10'), BigInt(' -42 '), BigInt(true),
+                            BigInt(1e21), BigInt(''), 255n.toString(16),
+                            (-255n).toString(2)].join(',')`,
+		"16,-42,1,1000000000000000000000,0,ff,-11111111")
+	checkScript(tst, ctx, "Number(9007199254740993n) + ':' + Number(-1n)",
+		"9007199254740992:-1")
+	checkScript(tst, ctx, "'x' + 1n + 2n", "x12")
+
+	// Wrapping to fixed widths
+	checkScript(tst, ctx, `[BigInt.asIntN(8, 255n), BigInt.asIntN(8, 127n),
+                            BigInt.asUintN(8, -1n), BigInt.asUintN(64, -1n),
+                            BigInt.asIntN(64, 18446744073709551615n),
+                            BigInt.asIntN(0, 5n)].join(',')`,
+		"-1,127,255,18446744073709551615,-1,0")
+
+	// Comparison and equality (across types, no TypeError)
+	checkScript(tst, ctx, `[1n < 2, 2n > 1.5, 3n <= '3', 1n < 0 / 0, 2n >= 3n,
+                            1n == 1, 1n == '1', 1n == true, 1n === 1,
+                            1n === 1n, 1n != 2n, 0n == '', 1n == 'x'].join(',')`,
+		"true,true,true,false,false,true,true,true,false,true,true,true,false")
+	checkScript(tst, ctx, "!!0n + ':' + !!1n + ':' + (0n ? 'y' : 'n')",
+		"false:true:n")
+	checkScript(tst, ctx, "1n instanceof BigInt", true)
+
+	// Number arithmetic is inexact beyond the int64 range (not wrapped)
+	checkScript(tst, ctx, "9223372036854775806 + 1", int64(9223372036854775807))
+	checkScript(tst, ctx, "-4611686018427387904 * 2", int64(-1<<63))
+	for src, expected := range map[string]float64{
+		"9223372036854775807 + 1":                         1 << 63,
+		"-9223372036854775807 - 2":                        -(1 << 63),
+		"4294967296 * 4294967296":                         1 << 64,
+		"-4294967296 * 4294967296":                        -(1 << 64),
+		"-(-9223372036854775807 - 1)":                     1 << 63,
+		"var i = 9223372036854775807; i++; i":             1 << 63,
+		"var i = -9223372036854775807 - 1; --i; i":        -(1 << 63),
+		"var o = {v: 9223372036854775807}; o.v += 2; o.v": 1 << 63,
+	} {
+		checkScript(tst, ctx, src, expected)
+	}
+
+	// Mixing and other errors
+	for src, expected := range map[string]string{
+		"1n + 1":                  "TypeError",
+		"2 * 1n":                  "TypeError",
+		"1n < Symbol()":           "TypeError",
+		"+1n":                     "TypeError",
+		"1n >>> 1n":               "TypeError",
+		"1n / 0n":                 "RangeError",
+		"1n % 0n":                 "RangeError",
+		"1n - 1n / 0n":            "RangeError",
+		"1n << 100000000n":        "RangeError",
+		"BigInt(1.5)":             "RangeError",
+		"BigInt('1.5')":           "SyntaxError",
+		"BigInt(undefined)":       "TypeError",
+		"BigInt.asUintN(-1, 1n)":  "RangeError",
+		"BigInt.asIntN(8, 1)":     "TypeError",
+		"10n.toString(99)":        "RangeError",
+		"JSON.stringify({a: 1n})": "TypeError",
+		"new BigInt(1)":           "TypeError",
+	} {
+		checkScript(tst, ctx, `var r = '';
+                               try { `+src+`; } catch (e) { r = e.name; }
+                               r`, expected)
+	}
+}
+
+func TestBigIntHost(tst *testing.T) {
+	ctx := NewScriptContext()
+
+	// Go values beyond the int64 range map to BigInt
+	huge, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	ctx.SetGlobal("vals", types.NewFromInterface(map[string]interface{}{
+		"id":    uint64(math.MaxUint64),
+		"small": uint64(42),
+		"huge":  huge,
+	}))
+	checkScript(tst, ctx, "typeof vals.id + ':' + typeof vals.small",
+		"bigint:number")
+	checkScript(tst, ctx, "'' + vals.id + ':' + (vals.huge + 10n)",
+		"18446744073709551615:123456789012345678901234567900")
+
+	// And back again, through Native() and argument conversion
+	ctx.RegisterGoFunc("nextId", func(id uint64) uint64 {
+		return id - 1
+	})
+	ctx.RegisterGoFunc("double", func(val *big.Int) *big.Int {
+		return new(big.Int).Lsh(val, 1)
+	})
+	checkScript(tst, ctx, "'' + nextId(vals.id)", "18446744073709551614")
+	checkScript(tst, ctx, "'' + double(vals.huge) + ':' + double(21)",
+		"246913578024691357802469135780:42")
+
+	script, err := Parse("({id: vals.id, huge: vals.huge * 2n, big: 5n})")
+	if err != nil {
+		tst.Fatalf("Unexpected parse error: %v", err)
+	}
+	res, err := script.RunWithContext(ctx)
+	if err != nil {
+		tst.Fatalf("Unexpected run error: %v", err)
+	}
+	native := res.Native().(map[string]interface{})
+	if id, ok := native["id"].(*big.Int); !ok || !id.IsUint64() ||
+		id.Uint64() != math.MaxUint64 {
+		tst.Fatalf("Incorrect native BigInt: %#v", native["id"])
+	}
+
+	var out struct {
+		ID   uint64   `json:"id"`
+		Huge *big.Int `json:"huge"`
+		Big  int32    `json:"big"`
+	}
+	if err := types.ExportTo(res, &out); err != nil {
+		tst.Fatalf("Unexpected export error: %v", err)
+	}
+	if out.ID != math.MaxUint64 || out.Big != 5 ||
+		out.Huge.String() != "246913578024691357802469135780" {
+		tst.Fatalf("Incorrect exported BigInts: %+v", out)
+	}
+	var small int64
+	if err := types.ExportTo(types.NewFromInterface(huge), &small); err == nil {
+		tst.Fatalf("Expected overflow error exporting BigInt")
+	}
+	checkScript(tst, ctx, `var r = '';
+                           try { nextId(-1n); } catch (e) { r = e.name; }
+                           r`, "TypeError")
+}
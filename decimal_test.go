@@ -0,0 +1,150 @@
+/*
+ * Test methods for the arbitrary precision Decimal type.
+ *
+ * Copyright (C) 2005-2026 J.M. Heisz.  All Rights Reserved.
+ * See the LICENSE file accompanying the distribution your rights to use
+ * this software.
+ */
+
+package gescript
+
+import (
+	"testing"
+
+	"github.com/heisz/gescript/types"
+)
+
+func TestDecimalScript(tst *testing.T) {
+	ctx := NewScriptContext()
+
+	// Exact arithmetic through methods and operators
+	checkScript(tst, ctx, "(0.1 + 0.2) + ':' + (Decimal('0.1') + 0.2)",
+		"0.30000000000000004:0.3")
+	checkScript(tst, ctx, `var a = Decimal('19.99'), b = new Decimal(3);
+                           [a.add(b), a.sub('0.99'), a.mul(b), a.div(4),
+                            a.mod(b), a.neg(), a.neg().abs()].join(',')`,
+		"22.99,19.00,59.97,4.9975,1.99,-19.99,19.99")
+	checkScript(tst, ctx, `var a = Decimal('19.99');
+                           [a + 1, a - 0.99, a * 3, 2 * a / 4, -a].join(',')`,
+		"20.99,19.00,59.97,9.995,-19.99")
+	checkScript(tst, ctx, "'' + Decimal(1).div(3)",
+		"0.3333333333333333333333333333333333")
+	checkScript(tst, ctx, "'' + Decimal(2).div(3, 5)", "0.66667")
+	checkScript(tst, ctx, "'' + Decimal('1e3') + ':' + Decimal('1.50').scale",
+		"1000:2")
+
+	// Compound interest, exact for integral exponents
+	checkScript(tst, ctx, `var p = Decimal('1000.00'), rate = Decimal('0.05');
+                           [p * (1 + rate) ** 3, (p * (1 + rate / 12) ** 12)
+                               .round(2), Decimal(2) ** -2, 2 ** Decimal(3),
+                            Decimal('1.1') ** 0].join(',')`,
+		"1157.62500000,1051.16,0.25,8,1")
+
+	// Rounding modes
+	checkScript(tst, ctx, `var modes = ['half-even', 'half-up', 'half-down',
+                                        'up', 'down', 'ceiling', 'floor'];
+                           var res = [];
+                           for (var i = 0; i < modes.length; i++) {
+                               res.push(Decimal('2.5').round(0, modes[i]) +
+                                        '/' + Decimal('-2.5').round(0, modes[i]));
+                           }
+                           res.join(',')`,
+		"2/-2,3/-3,2/-2,3/-3,2/-2,3/-2,2/-3")
+	checkScript(tst, ctx, `var d = Decimal('1.005');
+                           d.toFixed(2) + ',' + d.toFixed(2, 'half-up') + ',' +
+                               d.toFixed(4) + ',' + d.round(-1, 'up')`,
+		"1.00,1.01,1.0050,10")
+
+	// Comparison and conversion
+	checkScript(tst, ctx, `var a = Decimal('1.10'), b = Decimal('1.1');
+                           [a == b, a != b, a < 2, 0.5 < a, a >= b, a.eq(b),
+                            a.lt('1.2'), a.cmp(2)].join(',')`,
+		"true,false,true,true,true,true,true,-1")
+	checkScript(tst, ctx, "Decimal('2.25').toNumber() * 2", float64(4.5))
+	checkScript(tst, ctx, `var d = Decimal('-0.5');
+                           typeof d + ':' + (d instanceof Decimal) + ':' +
+                               Decimal.isDecimal(d) + ':' + d.sign()`,
+		"object:true:true:-1")
+	checkScript(tst, ctx, "JSON.stringify({total: Decimal('10.50'), n: 1})",
+		`{"n":1,"total":"10.50"}`)
+
+	// Invalid values and arguments
+	for src, expected := range map[string]string{
+		"Decimal('abc')":                 "SyntaxError",
+		"Decimal(0 / 0)":                 "RangeError",
+		"Decimal({})":                    "TypeError",
+		"Decimal(1).div(0)":              "RangeError",
+		"Decimal(1) / Decimal(0)":        "RangeError",
+		"Decimal(1).toFixed(-1)":         "RangeError",
+		"Decimal(1).round(0, 'nearest')": "RangeError",
+		"Decimal(2) ** 0.5":              "RangeError",
+		"Decimal(0) ** -1":               "RangeError",
+		"Decimal(10) ** 1e9":             "RangeError",
+	} {
+		checkScript(tst, ctx, `var r = '';
+                               try { `+src+`; } catch (e) { r = e.name; }
+                               r`, expected)
+	}
+}
+
+type decimalInvoice struct {
+	Total types.Decimal `json:"total"`
+	Tax   types.Decimal `json:"tax"`
+}
+
+func TestDecimalHost(tst *testing.T) {
+	ctx := NewScriptContext()
+	ctx.SetDecimalContext(types.DecimalContext{Precision: 10,
+		Rounding: types.RoundHalfUp})
+	checkScript(tst, ctx, "'' + Decimal(2).div(3)", "0.6666666667")
+	checkScript(tst, ctx, "Decimal('0.125').toFixed(2)", "0.13")
+
+	// Go values cross the boundary intact
+	price, _ := types.ParseDecimal("12.345")
+	ctx.SetGlobal("order", types.NewFromInterface(map[string]interface{}{
+		"price": price,
+		"qty":   3,
+	}))
+	ctx.RegisterGoFunc("taxOf", func(amt types.Decimal) types.Decimal {
+		return amt.Mul(types.NewDecimal(5, 2))
+	})
+	checkScript(tst, ctx, "'' + order.price * order.qty", "37.035")
+	checkScript(tst, ctx, "'' + taxOf(order.price) + ':' + taxOf('100')",
+		"0.61725:5.00")
+
+	script, err := Parse("var t = order.price * order.qty; " +
+		"({total: t, tax: taxOf(t).round(2)})")
+	if err != nil {
+		tst.Fatalf("Unexpected parse error: %v", err)
+	}
+	res, err := script.RunWithContext(ctx)
+	if err != nil {
+		tst.Fatalf("Unexpected run error: %v", err)
+	}
+	var inv decimalInvoice
+	if err := types.ExportTo(res, &inv); err != nil {
+		tst.Fatalf("Unexpected export error: %v", err)
+	}
+	if inv.Total.String() != "37.035" || inv.Tax.String() != "1.85" {
+		tst.Fatalf("Incorrect exported invoice: %s %s", inv.Total, inv.Tax)
+	}
+	native := res.Native().(map[string]interface{})
+	if total, ok := native["total"].(types.Decimal); !ok ||
+		total.Cmp(inv.Total) != 0 {
+		tst.Fatalf("Native value should be the decimal: %#v", native)
+	}
+
+	// Strings export through the text unmarshaling
+	if err := types.ExportTo(types.NewFromInterface(map[string]interface{}{
+		"total": "1.5", "tax": "0.25"}), &inv); err != nil {
+		tst.Fatalf("Unexpected export error: %v", err)
+	}
+	if inv.Total.String() != "1.5" || inv.Tax.String() != "0.25" {
+		tst.Fatalf("Incorrect exported decimal strings: %s %s", inv.Total,
+			inv.Tax)
+	}
+	if err := types.ExportTo(types.NewFromInterface(map[string]interface{}{
+		"total": "1.5", "tax": "abc"}), &inv); err == nil {
+		tst.Fatalf("Expected error exporting invalid decimal")
+	}
+}
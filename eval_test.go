@@ -0,0 +1,124 @@
+/*
+ * Test methods for eval, the Function constructor and function source text.
+ *
+ * Copyright (C) 2005-2026 J.M. Heisz.  All Rights Reserved.
+ * See the LICENSE file accompanying the distribution your rights to use
+ * this software.
+ */
+
+package gescript
+
+import (
+	"testing"
+)
+
+func TestDirectEval(tst *testing.T) {
+	ctx := NewScriptContext()
+
+	// Direct eval sees (and modifies) the variables of the caller
+	checkScript(tst, ctx, `function f(a) { let b = 2; return eval('a + b'); }
+                           f(1)`, int64(3))
+	checkScript(tst, ctx, `function f(a) {
+                               var b; eval('b = a * 10'); return b;
+                           } f(3)`, int64(30))
+	checkScript(tst, ctx, `function o() {
+                               var v = 7;
+                               return function () { return eval('v'); };
+                           } o()()`, int64(7))
+	checkScript(tst, ctx, `var o = {k: 3, m() { return eval('this.k'); }};
+                           function f(x) { return eval('arguments[0] + x'); }
+                           [o.m(), f(4)].join()`, "3,8")
+	checkScript(tst, ctx, `function f(n) {
+                               return eval('n ? n * f(n - 1) : 1');
+                           } f(4)`, int64(24))
+	checkScript(tst, ctx, `var fs = [];
+                           for (let i = 0; i < 3; i++) {
+                               fs.push(eval('() => i'));
+                           } fs.map(f => f()).join()`, "0,1,2")
+	checkScript(tst, ctx, `function f() {
+                               var a = 1; return eval("eval('a + 1')");
+                           } f()`, int64(2))
+
+	// Declarations in the eval code are local to the code (functions are
+	// globals from the top level), strictness is inherited from the caller
+	checkScript(tst, ctx, `function f() { eval('var q = 1; let z = 2');
+                               return typeof q + typeof z; } f()`,
+		"undefinedundefined")
+	checkScript(tst, ctx, `eval('function fromEval() { return 9; }');
+                           fromEval()`, int64(9))
+	checkScript(tst, ctx, `function f() {
+                               'use strict';
+                               return eval('(function () { return this; })()');
+                           } typeof f()`, "undefined")
+
+	// Indirect eval is in the global scope (top-level script variables are
+	// local to the script, only the globals are visible)
+	checkScript(tst, ctx, `var x = 'g';
+                           function f() {
+                               var x = 'l', e = eval;
+                               return [eval('x'), (0, eval)('typeof x'),
+                                       e('typeof x')].join();
+                           } f()`, "l,undefined,undefined")
+	checkScript(tst, ctx, "Reflect.apply(eval, null, ['typeof Reflect'])",
+		"object")
+
+	// Completion values and exceptions from the eval code
+	checkScript(tst, ctx, "eval('1; 2 + 3')", int64(5))
+	checkScript(tst, ctx, "typeof eval('var w = 1')", "undefined")
+	checkScript(tst, ctx, "[eval(5), eval(...['1 + 1'])].join()", "5,2")
+	checkScript(tst, ctx, `var r;
+                           try { eval('throw 5'); } catch (e) { r = e; } r`,
+		int64(5))
+	checkScript(tst, ctx, catchName("eval('1 +')"), "SyntaxError")
+	checkScript(tst, ctx, catchName("(0, eval)('var')"), "SyntaxError")
+	checkScript(tst, ctx, `var r;
+                           try { eval('if (1') } catch (e) {
+                               r = typeof e.message;
+                           } r`, "string")
+}
+
+func TestFunctionConstructor(tst *testing.T) {
+	ctx := NewScriptContext()
+
+	checkScript(tst, ctx, `var add = new Function('a', 'b', 'return a + b');
+                           [add(1, 2), add.name, typeof add,
+                            add instanceof Function].join()`,
+		"3,anonymous,function,true")
+	checkScript(tst, ctx,
+		"new Function('a, b', 'c', 'return a + b + c')(1, 2, 3)", int64(6))
+	checkScript(tst, ctx, "Function('return 7')()", int64(7))
+	checkScript(tst, ctx, "String(new Function('a', 'return a'))",
+		"function anonymous(a\n) {\nreturn a\n}")
+
+	// Bound to the global scope, not the scope of the caller
+	checkScript(tst, ctx, `v = 'g';
+                           function f() {
+                               var v = 'l';
+                               return new Function('return v')();
+                           } f()`, "g")
+
+	// Syntax errors, including attempts to close the function early
+	checkScript(tst, ctx, catchName("new Function('return (')"),
+		"SyntaxError")
+	checkScript(tst, ctx, catchName("new Function('}, function() {')"),
+		"SyntaxError")
+	checkScript(tst, ctx,
+		catchName("new Function('a) { return 1 }; (function(', 'return 2')"),
+		"SyntaxError")
+}
+
+func TestFunctionSource(tst *testing.T) {
+	ctx := NewScriptContext()
+
+	checkScript(tst, ctx, `function g(x) { /* c */ return x }
+                           g.toString()`, "function g(x) { /* c */ return x }")
+	checkScript(tst, ctx, `var o = {m(a) { return 1; }, n: x => x * 2,
+                                    p: async () => 1};
+                           [o.m, o.n, o.p].join('|')`,
+		"m(a) { return 1; }|x => x * 2|async () => 1")
+	checkScript(tst, ctx, `[(a, b) => { return a; }, async function q() {}]
+                               .join('|')`,
+		"(a, b) => { return a; }|async function q() {}")
+	checkScript(tst, ctx, "String((function () {}).bind(null))",
+		"function bound () { [bound] }")
+}
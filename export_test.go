@@ -0,0 +1,201 @@
+/*
+ * Test methods for the export of script values into Go values.
+ *
+ * Copyright (C) 2005-2026 J.M. Heisz.  All Rights Reserved.
+ * See the LICENSE file accompanying the distribution your rights to use
+ * this software.
+ */
+
+package gescript
+
+import (
+	"errors"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/heisz/gescript/types"
+)
+
+type exportLine struct {
+	SKU      string  `json:"sku"`
+	Quantity int     `json:"qty"`
+	Price    float64 `json:"price"`
+}
+
+type exportOrder struct {
+	ID       int64             `json:"id"`
+	Customer string            `ges:"customer" json:"cust"`
+	Paid     bool              `json:"paid"`
+	Lines    []exportLine      `json:"lines"`
+	Totals   map[string]uint16 `json:"totals"`
+	Notes    *string           `json:"notes"`
+	Created  time.Time         `json:"created"`
+	Address  net.IP            `json:"address"`
+	Extra    interface{}       `json:"extra"`
+	Ignored  string            `json:"-"`
+	Codes    [2]int            `json:"codes"`
+}
+
+// Helper to run a script and return the result for export
+func exportResult(tst *testing.T, src string) types.DataType {
+	res, err := Run(src)
+	if err != nil {
+		tst.Fatalf("Unexpected error running '%s': %v", src, err)
+	}
+	return res
+}
+
+func TestExportStruct(tst *testing.T) {
+	val := exportResult(tst, `var o = {
+        id: 42, customer: 'acme', paid: true,
+        lines: [{sku: 'a1', qty: 2, price: 1.25},
+                {sku: 'b2', qty: 1, price: 10}],
+        totals: {net: 12, tax: 3},
+        notes: 'rush',
+        created: '2024-05-06T07:08:09Z',
+        address: '10.0.0.1',
+        extra: {deep: [1, 'x']},
+        codes: [7],
+        unknown: 'ignored'
+    };
+    o`)
+
+	ord := exportOrder{Ignored: "keep", Codes: [2]int{1, 2}}
+	if err := types.ExportTo(val, &ord); err != nil {
+		tst.Fatalf("Unexpected error exporting order: %v", err)
+	}
+	if ord.ID != 42 || ord.Customer != "acme" || !ord.Paid ||
+		ord.Ignored != "keep" {
+		tst.Fatalf("Incorrect scalar export: %+v", ord)
+	}
+	if len(ord.Lines) != 2 || ord.Lines[0].SKU != "a1" ||
+		ord.Lines[0].Quantity != 2 || ord.Lines[1].Price != 10 {
+		tst.Fatalf("Incorrect slice export: %+v", ord.Lines)
+	}
+	if ord.Totals["net"] != 12 || ord.Totals["tax"] != 3 {
+		tst.Fatalf("Incorrect map export: %+v", ord.Totals)
+	}
+	if ord.Notes == nil || *ord.Notes != "rush" {
+		tst.Fatalf("Incorrect pointer export")
+	}
+	if !ord.Created.Equal(time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)) {
+		tst.Fatalf("Incorrect time export: %v", ord.Created)
+	}
+	if ord.Address.String() != "10.0.0.1" {
+		tst.Fatalf("Incorrect text unmarshaler export: %v", ord.Address)
+	}
+	extra := ord.Extra.(map[string]interface{})["deep"].([]interface{})
+	if extra[0] != int64(1) || extra[1] != "x" {
+		tst.Fatalf("Incorrect interface export: %v", ord.Extra)
+	}
+	if ord.Codes != [2]int{7, 0} {
+		tst.Fatalf("Incorrect array export: %v", ord.Codes)
+	}
+
+	// Other top-level targets
+	var lines []*exportLine
+	if err := types.ExportTo(exportResult(tst, "[{sku: 'x'}, null]"),
+		&lines); err != nil || len(lines) != 2 || lines[0].SKU != "x" ||
+		lines[1] != nil {
+		tst.Fatalf("Incorrect pointer slice export: %v %v", lines, err)
+	}
+	var when time.Time
+	if err := types.ExportTo(types.IntegerType(86400000),
+		&when); err != nil || when.UTC().Day() != 2 {
+		tst.Fatalf("Incorrect epoch time export: %v %v", when, err)
+	}
+	var dt types.DataType
+	if err := types.ExportTo(types.StringType("s"), &dt); err != nil ||
+		dt != types.StringType("s") {
+		tst.Fatalf("Incorrect data type export: %v %v", dt, err)
+	}
+
+	// Map instances to maps, host objects to maps and structs
+	var counts map[int]string
+	if err := types.ExportTo(exportResult(tst,
+		"new Map([[1, 'a'], ['2', 'b']])"), &counts); err != nil ||
+		len(counts) != 2 || counts[1] != "a" || counts[2] != "b" {
+		tst.Fatalf("Incorrect Map export: %v %v", counts, err)
+	}
+	line := exportLine{SKU: "w1", Quantity: 3, Price: 2.5}
+	var copied exportLine
+	if err := types.ExportTo(types.Wrap(&line), &copied); err != nil ||
+		copied != line {
+		tst.Fatalf("Incorrect host object export: %+v %v", copied, err)
+	}
+	var fields map[string]interface{}
+	if err := types.ExportTo(types.Wrap(line), &fields); err != nil ||
+		fields["sku"] != "w1" || fields["qty"] != int64(3) {
+		tst.Fatalf("Incorrect host object map export: %v %v", fields, err)
+	}
+}
+
+func TestExportNumbers(tst *testing.T) {
+	var ival int
+	var fval float32
+	var small int8
+	var uval uint
+
+	if err := types.ExportTo(types.NumberType(3), &ival); err != nil ||
+		ival != 3 {
+		tst.Fatalf("Integral number should export to int: %v", err)
+	}
+	if err := types.ExportTo(types.IntegerType(3), &fval); err != nil ||
+		fval != 3 {
+		tst.Fatalf("Integer should export to float: %v", err)
+	}
+	if err := types.ExportTo(types.NumberType(2.75), &ival); err == nil {
+		tst.Fatalf("Expected strict error for fractional number")
+	}
+	err := types.ExportToWithOptions(types.NumberType(-2.75), &ival,
+		types.ExportOptions{LenientNumbers: true})
+	if err != nil || ival != -2 {
+		tst.Fatalf("Lenient number should truncate: %d %v", ival, err)
+	}
+	if err := types.ExportTo(types.IntegerType(300), &small); err == nil {
+		tst.Fatalf("Expected overflow error for int8")
+	}
+	if err := types.ExportTo(types.IntegerType(-1), &uval); err == nil {
+		tst.Fatalf("Expected error for negative unsigned")
+	}
+	if err := types.ExportToWithOptions(types.NaN, &ival,
+		types.ExportOptions{LenientNumbers: true}); err == nil {
+		tst.Fatalf("Expected error for NaN export to int")
+	}
+}
+
+func TestExportErrors(tst *testing.T) {
+	var ord exportOrder
+	for src, expected := range map[string]string{
+		"({lines: [{sku: 'a'}, {qty: 'x'}]})": "lines[1].qty: cannot convert string to int",
+		"({totals: {net: -1}})":               "totals.net: cannot convert number to uint16",
+		"({created: 'yesterday'})":            "created: invalid time 'yesterday'",
+		"({address: 'not-ip'})":               "address: invalid IP address",
+		"({codes: [1, 2, 3]})":                "codes: array length 3 exceeds [2]int",
+		"({paid: 'yes'})":                     "paid: cannot convert string to bool",
+		"[1, 2]":                              "cannot convert array to",
+		"new Map([['id', 1]])":                "cannot convert Map to",
+	} {
+		err := types.ExportTo(exportResult(tst, src), &ord)
+		if err == nil || !strings.Contains(err.Error(), expected) {
+			tst.Fatalf("Export of '%s': expected error '%s', got '%v'",
+				src, expected, err)
+		}
+		var exportErr *types.ExportError
+		if !errors.As(err, &exportErr) {
+			tst.Fatalf("Export error should be an ExportError: %v", err)
+		}
+	}
+
+	var counts map[string]int
+	err := types.ExportTo(exportResult(tst, "new Map([[{}, 1]])"), &counts)
+	if err == nil || !strings.Contains(err.Error(), "cannot export Map key") {
+		tst.Fatalf("Expected error for object Map key, got '%v'", err)
+	}
+
+	if err := types.ExportTo(types.IntegerType(1), ord); err == nil {
+		tst.Fatalf("Expected error for non-pointer target")
+	}
+}
@@ -9,6 +9,9 @@
 package gescript
 
 import (
+	"context"
+	"errors"
+
 	"github.com/heisz/gescript/internal/engine"
 	"github.com/heisz/gescript/internal/native"
 	"github.com/heisz/gescript/internal/parser"
@@ -18,6 +21,9 @@ import (
 // Exposed container for a parsed script instance
 type Script struct {
 	body *engine.Function
+
+	// Import/export details for a script parsed as a module
+	module *engine.ModuleCode
 }
 
 /*
@@ -34,6 +40,23 @@ type ScriptContext struct {
 
 	// Registered constructors with instance method support
 	constructors []*types.NativeConstructor
+
+	// Pending promise jobs, drained by the host (see RunJobs)
+	jobs *types.JobQueue
+
+	// Go context passed to native Go functions (see RegisterGoFunc)
+	goCtx context.Context
+
+	// Precision and rounding for Decimal operations (zero for the default)
+	decimalCtx types.DecimalContext
+
+	// Parsing rules for modules and eval() code in the context
+	parseOpts types.ParseOptions
+
+	// Module loader, host-defined modules and cache of loaded modules
+	loader      ModuleLoader
+	hostModules map[string]*engine.ModuleInstance
+	modules     map[string]*engine.ModuleInstance
 }
 
 // NewScriptContext creates a new execution context with builtin native fns
@@ -42,6 +65,7 @@ func NewScriptContext() *ScriptContext {
 		natives:      make(map[string]types.DataType),
 		globals:      make(map[string]types.DataType),
 		constructors: native.NativeConstructors,
+		jobs:         types.NewJobQueue(),
 	}
 
 	// Copy native functions (includes constructor functions)
@@ -52,6 +76,10 @@ func NewScriptContext() *ScriptContext {
 	// Register eval separately (here to avoid circular import in native)
 	ctx.natives["eval"] = &types.NativeFunction{Name: "eval", Fn: evalFunc}
 
+	// Likewise Proxy and Reflect, which depend on the engine operations
+	ctx.natives["Proxy"] = engine.NewProxyConstructor()
+	ctx.natives["Reflect"] = engine.NewReflectObject()
+
 	return ctx
 }
 
@@ -69,18 +97,36 @@ func evalFunc(prc types.Process, args []types.DataType) (types.DataType, error)
 		return scriptArg, nil
 	}
 
-	// Parse and execute the script
-	body, errs := parser.Parse(string(scriptStr))
-	if len(errs) > 0 {
-		return types.Undefined, errs[0]
+	// Direct eval code can reference the variables of the caller (and is
+	// strict if the caller is), indirect eval code is in the global scope
+	engPrc := prc.(*engine.Process)
+	opts := engPrc.ParseOptions()
+	scope := engPrc.TakeEvalScope()
+	var names []string
+	global := false
+	if scope != nil {
+		names, global = scope.Names, scope.Global
+		opts.Strict = opts.Strict || scope.Strict
 	}
-	evalPrc := prc.(*engine.Process).Replica(256)
-	result, err := body.Exec(evalPrc)
-	if err != nil {
-		return types.Undefined, err
+
+	// Parse and execute the script, where syntax errors are catchable.  Note
+	// that top-level script variables are not globals (they are local to the
+	// script), so they are not visible to indirect eval code.
+	fn, errs := parser.ParseEval(string(scriptStr), names, global, opts)
+	if len(errs) > 0 {
+		return nil, types.ThrowError(prc, "SyntaxError", errs[0].Error())
 	}
+	return engPrc.CallEval(fn, scope)
+}
 
-	return result, nil
+// Compile the source of the Function constructor for the engine
+func compileFunction(params, body string,
+	opts types.ParseOptions) (*engine.ScriptFunction, error) {
+	fn, errs := parser.ParseFunction(params, body, opts)
+	if len(errs) > 0 {
+		return nil, errs[0]
+	}
+	return fn, nil
 }
 
 // Clone allows the creation of a core script library and then using it in
@@ -90,6 +136,12 @@ func (ctx *ScriptContext) Clone() *ScriptContext {
 		natives:      make(map[string]types.DataType, len(ctx.natives)),
 		globals:      make(map[string]types.DataType, len(ctx.globals)),
 		constructors: make([]*types.NativeConstructor, len(ctx.constructors)),
+		jobs:         types.NewJobQueue(),
+		goCtx:        ctx.goCtx,
+		decimalCtx:   ctx.decimalCtx,
+		parseOpts:    ctx.parseOpts,
+		loader:       ctx.loader,
+		hostModules:  make(map[string]*engine.ModuleInstance),
 	}
 	for key, val := range ctx.natives {
 		res.natives[key] = val
@@ -98,9 +150,27 @@ func (ctx *ScriptContext) Clone() *ScriptContext {
 		res.globals[key] = val
 	}
 	copy(res.constructors, ctx.constructors)
+	for key, val := range ctx.hostModules {
+		res.hostModules[key] = val
+	}
+
+	// Loaded modules carry state, the clone evaluates its own instances
+	res.modules = make(map[string]*engine.ModuleInstance)
 	return res
 }
 
+// Define the precision (significant digits of division results) and default
+// rounding mode for Decimal operations in the context's scripts
+func (ctx *ScriptContext) SetDecimalContext(dctx types.DecimalContext) {
+	ctx.decimalCtx = dctx
+}
+
+// Define the parsing rules (e.g. no automatic semicolon insertion) for the
+// modules loaded and the code evaluated in the context's scripts
+func (ctx *ScriptContext) SetParseOptions(opts types.ParseOptions) {
+	ctx.parseOpts = opts
+}
+
 // Register a native function in the context for script usage
 func (ctx *ScriptContext) RegisterFunction(name string, fn types.NativeFn) {
 	nativeFunc := &types.NativeFunction{
@@ -110,6 +180,17 @@ func (ctx *ScriptContext) RegisterFunction(name string, fn types.NativeFn) {
 	ctx.natives[name] = nativeFunc
 }
 
+// Register an arbitrary Go function in the context for script usage, with
+// automatic conversion of arguments and results (see types.NewGoFunction)
+func (ctx *ScriptContext) RegisterGoFunc(name string, fn any) {
+	ctx.natives[name] = types.NewGoFunction(name, fn)
+}
+
+// Define the Go context provided to native Go functions that accept one
+func (ctx *ScriptContext) SetGoContext(goCtx context.Context) {
+	ctx.goCtx = goCtx
+}
+
 // Register a native constructor with method support in the context
 func (ctx *ScriptContext) RegisterConstructor(nc *types.NativeConstructor) {
 	ctx.natives[nc.Name] = nc
@@ -118,7 +199,13 @@ func (ctx *ScriptContext) RegisterConstructor(nc *types.NativeConstructor) {
 
 // Parse the source script into an executable Script instance (or error)
 func Parse(source string) (prg *Script, err error) {
-	prsBody, errs := parser.Parse(source)
+	return ParseWithOptions(source, types.ParseOptions{})
+}
+
+// Parse the source script with the specified parsing rules
+func ParseWithOptions(source string, opts types.ParseOptions) (prg *Script,
+	err error) {
+	prsBody, errs := parser.ParseWithOptions(source, opts)
 	if len(errs) > 0 {
 		return nil, errs[0]
 	}
@@ -128,18 +215,119 @@ func Parse(source string) (prg *Script, err error) {
 	}, nil
 }
 
-// Run executes the script with a 'standard' context (no additions)
+// Run executes the script with a 'standard' context (no additions), also
+// running any promise jobs queued by the script
 func (prg *Script) Run() (retval types.DataType, err error) {
-	return prg.RunWithContext(NewScriptContext())
+	ctx := NewScriptContext()
+	retval, err = prg.RunWithContext(ctx)
+	if err != nil {
+		return retval, err
+	}
+	return retval, ctx.RunJobs()
 }
 
 // Run the script with the provided context (native/custom extensions)
+// Note that promise jobs are queued to the context but not executed
 func (prg *Script) RunWithContext(ctx *ScriptContext) (retval types.DataType,
 	err error) {
-	prc := engine.NewProcess(256, ctx.natives, ctx.globals, ctx.constructors)
+	if prg.module != nil {
+		return ctx.runModule(prg)
+	}
+	return prg.body.Exec(ctx.newProcess())
+}
+
+// Run the script with an implicit scope object (as though the script were
+// wrapped in a with statement), where undeclared identifiers are resolved
+// against the properties of the scope (e.g. a HostObject) ahead of globals
+func (prg *Script) RunWithScope(ctx *ScriptContext,
+	scope types.DataType) (retval types.DataType, err error) {
+	if prg.module != nil {
+		return types.Undefined, errors.New("Cannot run a module with a scope")
+	}
+	prc := ctx.newProcess()
+	prc.SetScope(scope)
 	return prg.body.Exec(prc)
 }
 
+// Common method to create an execution process against the context
+func (ctx *ScriptContext) newProcess() *engine.Process {
+	prc := engine.NewProcess(256, ctx.natives, ctx.globals, ctx.constructors)
+	prc.SetJobQueue(ctx.jobs)
+	prc.SetContext(ctx.goCtx)
+	prc.SetDecimalContext(ctx.decimalCtx)
+	prc.SetParseOptions(ctx.parseOpts)
+	prc.SetFunctionCompiler(compileFunction)
+	return prc
+}
+
+// Execute the pending promise jobs (microtasks) of the context, including any
+// queued during the run, until the queue is empty or a job fails
+func (ctx *ScriptContext) RunJobs() error {
+	prc := ctx.newProcess()
+	for job := ctx.jobs.Dequeue(); job != nil; job = ctx.jobs.Dequeue() {
+		if err := job(prc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Like RunJobs, but also wait for the settlement of outstanding host promise
+// handles (from other goroutines) until none remain or the context is done
+func (ctx *ScriptContext) WaitJobs(goCtx context.Context) error {
+	for {
+		if err := ctx.RunJobs(); err != nil {
+			return err
+		}
+		more, err := ctx.jobs.Wait(goCtx)
+		if err != nil || !more {
+			return err
+		}
+	}
+}
+
+// Resolve the value (normally a promise returned from the script) by running
+// jobs until it settles, returning the result or a RejectionError
+func (ctx *ScriptContext) Await(goCtx context.Context,
+	val types.DataType) (types.DataType, error) {
+	prm, ok := val.(*types.PromiseType)
+	if !ok {
+		return val, nil
+	}
+	for {
+		if err := ctx.RunJobs(); err != nil {
+			return types.Undefined, err
+		}
+		switch prm.State() {
+		case types.PromiseFulfilled:
+			return prm.Result(), nil
+		case types.PromiseRejected:
+			return types.Undefined, &RejectionError{Reason: prm.Result()}
+		}
+
+		more, err := ctx.jobs.Wait(goCtx)
+		if err != nil {
+			return types.Undefined, err
+		}
+		if !more {
+			return types.Undefined, errors.New("Promise will never settle")
+		}
+	}
+}
+
+// Error instance for a rejected promise that was awaited by the host
+type RejectionError struct {
+	Reason types.DataType
+}
+
+func (err *RejectionError) Error() string {
+	if obj, ok := err.Reason.(*types.ObjectType); ok && obj.Has("message") {
+		return "Uncaught (in promise) " + types.ToString(obj.Get("name")) +
+			": " + types.ToString(obj.Get("message"))
+	}
+	return "Uncaught (in promise) " + types.ToString(err.Reason)
+}
+
 // Convenience method to parse/execute the script source in one shot (no ctx)
 func Run(source string) (retval types.DataType, err error) {
 	script, err := Parse(source)
@@ -149,6 +337,16 @@ func Run(source string) (retval types.DataType, err error) {
 	return script.Run()
 }
 
+// Wrap the target object in a proxy with the traps of the handler object (as
+// new Proxy() in a script), where the traps can also be native functions
+func NewProxy(target, handler types.DataType) (types.DataType, error) {
+	prx, err := engine.NewProxy(nil, target, handler)
+	if err != nil {
+		return nil, err
+	}
+	return prx, nil
+}
+
 // Retrieve a defined function from the context to call() directly
 func (ctx *ScriptContext) GetFunction(name string) types.FunctionType {
 	if ctx.globals == nil {
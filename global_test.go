@@ -0,0 +1,76 @@
+/*
+ * Test methods for the numeric literal forms and the global object.
+ *
+ * Copyright (C) 2005-2026 J.M. Heisz.  All Rights Reserved.
+ * See the LICENSE file accompanying the distribution your rights to use
+ * this software.
+ */
+
+package gescript
+
+import (
+	"testing"
+
+	"github.com/heisz/gescript/types"
+)
+
+func TestNumericLiterals(tst *testing.T) {
+	ctx := NewScriptContext()
+
+	checkScript(tst, ctx, `[0b1010, 0O17, 0xff_ff, 1_000_000, 0.5_5, 1e1_0,
+                            0b11n, 1_000n, 017, 019, 123456789012345680000,
+                            0xFFFFFFFFFFFFFFFF].join(' ')`,
+		"10 15 65535 1000000 0.55 10000000000 3 1000 15 19 "+
+			"123456789012345680000 18446744073709552000")
+	checkScript(tst, ctx, `[Infinity, -Infinity, NaN, typeof NaN, NaN === NaN,
+                            1 / 0 === Infinity, 1e400 === Infinity]
+                               .join(' ')`,
+		"Infinity -Infinity NaN number false true true")
+
+	for _, src := range []string{"1__0", "1_", "0_1", "01_2", "0x_1", "0b",
+		"0b2", "1._5", "1e_5", "017n"} {
+		if _, err := Parse(src); err == nil {
+			tst.Errorf("Expected parse error for %s", src)
+		}
+	}
+}
+
+func TestGlobalObject(tst *testing.T) {
+	ctx := NewScriptContext()
+	ctx.SetGlobal("limit", types.IntegerType(10))
+
+	// Reflects the natives and globals, writes through to the globals
+	checkScript(tst, ctx, `['myHelper' in globalThis, 'parseInt' in globalThis,
+                            'limit' in globalThis, globalThis.limit,
+                            typeof globalThis, globalThis === globalThis,
+                            globalThis.globalThis === globalThis].join(' ')`,
+		"false true true 10 object true true")
+	checkScript(tst, ctx, `globalThis.myHelper = function(x) { return x * 2; };
+                           globalThis['other'] = 1;
+                           myHelper(4) + ':' + ('myHelper' in globalThis)`,
+		"8:true")
+	if _, ok := ctx.GetGlobal("myHelper").(types.FunctionType); !ok {
+		tst.Fatalf("Helper not registered as a global function")
+	}
+
+	// Helpers persist in the context, only script globals can be deleted
+	checkScript(tst, ctx, `var keys = [];
+                           for (var k in globalThis) keys.push(k);
+                           [typeof myHelper, myHelper(2), keys.join(),
+                            delete globalThis.other, typeof other,
+                            delete globalThis.parseInt, typeof parseInt]
+                               .join(' ')`,
+		"function 4 limit,myHelper,other true undefined false function")
+
+	// The constant globals are read only (an error for strict code)
+	checkScript(tst, ctx, `globalThis.NaN = 3; globalThis['undefined'] = 1;
+                           globalThis.Infinity = 0;
+                           [NaN, undefined, Infinity, globalThis.NaN,
+                            delete globalThis.NaN].join()`,
+		"NaN,,Infinity,NaN,false")
+	for _, src := range []string{"globalThis.NaN = 3",
+		"globalThis['undefined'] = 1", "globalThis.Infinity++"} {
+		checkScript(tst, ctx, "'use strict'; "+catchName(src), "TypeError")
+	}
+	checkScript(tst, ctx, "typeof NaN + typeof undefined", "numberundefined")
+}
@@ -0,0 +1,150 @@
+/*
+ * Test methods for the registration/calling of arbitrary Go functions.
+ *
+ * Copyright (C) 2005-2026 J.M. Heisz.  All Rights Reserved.
+ * See the LICENSE file accompanying the distribution your rights to use
+ * this software.
+ */
+
+package gescript
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/heisz/gescript/types"
+)
+
+type goFuncPoint struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+}
+
+type goFuncKey struct{}
+
+func TestGoFuncConversions(tst *testing.T) {
+	ctx := NewScriptContext()
+	ctx.RegisterGoFunc("add", func(a, b int) int { return a + b })
+	ctx.RegisterGoFunc("scale", func(v float64, f float32) float64 {
+		return v * float64(f)
+	})
+	ctx.RegisterGoFunc("shout", func(s string, loud bool) string {
+		if loud {
+			return strings.ToUpper(s) + "!"
+		}
+		return s
+	})
+	ctx.RegisterGoFunc("sum", func(prefix string, vals ...int) string {
+		total := 0
+		for _, val := range vals {
+			total += val
+		}
+		return prefix + ":" + types.ToString(types.IntegerType(total))
+	})
+	ctx.RegisterGoFunc("join", func(parts []string, sep string) string {
+		return strings.Join(parts, sep)
+	})
+	ctx.RegisterGoFunc("count", func(vals map[string]int) int {
+		total := 0
+		for _, val := range vals {
+			total += val
+		}
+		return total
+	})
+	ctx.RegisterGoFunc("dist", func(pt goFuncPoint) int {
+		return pt.X*pt.X + pt.Y*pt.Y
+	})
+	ctx.RegisterGoFunc("origin", func() *goFuncPoint {
+		return &goFuncPoint{X: 1, Y: 2}
+	})
+	ctx.RegisterGoFunc("year", func(tm time.Time) int {
+		return tm.UTC().Year()
+	})
+	ctx.RegisterGoFunc("divmod", func(a, b int) (int, int) {
+		return a / b, a % b
+	})
+	ctx.RegisterGoFunc("noop", func() {})
+	ctx.RegisterGoFunc("native", func(val interface{}) string {
+		switch val.(type) {
+		case map[string]interface{}:
+			return "map"
+		case []interface{}:
+			return "slice"
+		}
+		return "other"
+	})
+
+	checkScript(tst, ctx, "add(2, 3)", int64(5))
+	checkScript(tst, ctx, "add(2.0, 3)", int64(5))
+	checkScript(tst, ctx, "scale(1.5, 2)", float64(3))
+	checkScript(tst, ctx, "shout('hi', true)", "HI!")
+	checkScript(tst, ctx, "sum('t') + ',' + sum('t', 1, 2, 3)", "t:0,t:6")
+	checkScript(tst, ctx, "join(['a', 'b', 'c'], '-')", "a-b-c")
+	checkScript(tst, ctx, "count({a: 1, b: 2})", int64(3))
+	checkScript(tst, ctx, "dist({x: 3, y: 4})", int64(25))
+	checkScript(tst, ctx, "var o = origin(); o.x = 3; dist(o)", int64(13))
+	checkScript(tst, ctx, "year('2024-03-01T10:00:00Z')", int64(2024))
+	checkScript(tst, ctx, "year(0)", int64(1970))
+	checkScript(tst, ctx, "divmod(7, 2).join(',')", "3,1")
+	checkScript(tst, ctx, "noop()", nil)
+	checkScript(tst, ctx, "native({}) + native([]) + native(1)",
+		"mapsliceother")
+
+	// Invalid argument types are TypeErrors with the argument position
+	for _, src := range []string{"add(1, 'x')", "add(1.5, 1)", "add(1)",
+		"shout(1, true)", "sum('t', 1, 'x')", "join([1], '')",
+		"dist({x: 'a'})", "year('yesterday')"} {
+		checkScript(tst, ctx, `var r = '';
+                               try { `+src+`; } catch (e) { r = e.name; }
+                               r`, "TypeError")
+	}
+	checkScript(tst, ctx, `var r = '';
+                           try { add(1, 'x'); } catch (e) { r = e.message; }
+                           r`,
+		"Invalid argument 2 to add: cannot convert string to int")
+}
+
+func TestGoFuncInjection(tst *testing.T) {
+	ctx := NewScriptContext()
+	ctx.SetGoContext(context.WithValue(context.Background(),
+		goFuncKey{}, "tenant"))
+	ctx.RegisterGoFunc("tenant", func(goCtx context.Context,
+		suffix string) string {
+		return goCtx.Value(goFuncKey{}).(string) + suffix
+	})
+	ctx.RegisterGoFunc("lookup", func(prc types.Process, name string) int {
+		return types.ToInt(prc.GetGlobal(name)) * 2
+	})
+	ctx.RegisterGoFunc("check", func(val int) (string, error) {
+		if val < 0 {
+			return "", errors.New("Value must not be negative")
+		}
+		return "ok", nil
+	})
+
+	checkScript(tst, ctx, "tenant('-a')", "tenant-a")
+	ctx.SetGlobal("limit", types.IntegerType(21))
+	checkScript(tst, ctx, "lookup('limit')", int64(42))
+	checkScript(tst, ctx, "check(1)", "ok")
+	checkScript(tst, ctx, `var r = '';
+                           try { check(-1); } catch (e) {
+                               r = e.name + ': ' + e.message;
+                           }
+                           r`, "Error: Value must not be negative")
+
+	// Also available through an async context (replica process)
+	checkScript(tst, ctx, `var r = '';
+                           async function f() { r = tenant('!'); }
+                           f(); r`, "tenant!")
+
+	// Not a function is a programming error
+	defer func() {
+		if recover() == nil {
+			tst.Fatalf("Expected panic for non-function registration")
+		}
+	}()
+	ctx.RegisterGoFunc("bad", 12)
+}
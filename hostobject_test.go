@@ -0,0 +1,190 @@
+/*
+ * Test methods for host-defined (Go) objects.
+ *
+ * Copyright (C) 2005-2026 J.M. Heisz.  All Rights Reserved.
+ * See the LICENSE file accompanying the distribution your rights to use
+ * this software.
+ */
+
+package gescript
+
+import (
+	"errors"
+	"sort"
+	"testing"
+
+	"github.com/heisz/gescript/types"
+)
+
+// Lazily loaded record, emulating a database row that is only fetched when
+// first accessed and tracks the modified columns
+type hostRecord struct {
+	table   string
+	columns map[string]types.DataType
+	loads   int
+	dirty   map[string]bool
+}
+
+func (rec *hostRecord) load() error {
+	if rec.table == "broken" {
+		return errors.New("Connection lost")
+	}
+	if rec.columns == nil {
+		rec.loads++
+		rec.columns = map[string]types.DataType{
+			"id":   types.IntegerType(7),
+			"name": types.StringType("widget"),
+		}
+		rec.dirty = make(map[string]bool)
+	}
+	return nil
+}
+
+func (rec *hostRecord) Native() interface{} { return rec.table }
+
+func (rec *hostRecord) ToPrimitive(hint any) types.DataType {
+	return types.StringType("[record " + rec.table + "]")
+}
+
+func (rec *hostRecord) Get(name string) (types.DataType, error) {
+	if name == "save" {
+		return &types.NativeFunction{Name: "save",
+			Fn: func(prc types.Process,
+				args []types.DataType) (types.DataType, error) {
+				return types.IntegerType(len(rec.dirty)), nil
+			}}, nil
+	}
+	if err := rec.load(); err != nil {
+		return nil, err
+	}
+	if val, ok := rec.columns[name]; ok {
+		return val, nil
+	}
+	return types.Undefined, nil
+}
+
+func (rec *hostRecord) Set(name string, val types.DataType) error {
+	if err := rec.load(); err != nil {
+		return err
+	}
+	if name == "id" {
+		return errors.New("TypeError: Column 'id' is read only")
+	}
+	rec.columns[name] = val
+	rec.dirty[name] = true
+	return nil
+}
+
+func (rec *hostRecord) Delete(name string) (bool, error) {
+	if err := rec.load(); err != nil {
+		return false, err
+	}
+	if name == "id" {
+		return false, nil
+	}
+	delete(rec.columns, name)
+	rec.dirty[name] = true
+	return true, nil
+}
+
+func (rec *hostRecord) Has(name string) bool {
+	if rec.load() != nil {
+		return false
+	}
+	_, ok := rec.columns[name]
+	return ok
+}
+
+// Column names come from the schema if the record cannot be loaded
+func (rec *hostRecord) Keys() []string {
+	if rec.load() != nil {
+		return []string{"id", "name"}
+	}
+	keys := make([]string, 0, len(rec.columns))
+	for key := range rec.columns {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Callable host object with a custom typeof
+type hostFinder struct {
+	hostRecord
+}
+
+func (fnd *hostFinder) Call(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	return &hostRecord{table: types.ToString(types.Arg(args, 0))}, nil
+}
+
+func (fnd *hostFinder) TypeOf() string { return "function" }
+
+func TestHostObject(tst *testing.T) {
+	rec := &hostRecord{table: "items"}
+	ctx := NewScriptContext()
+	ctx.SetGlobal("rec", rec)
+
+	// Property access is lazy and only loads once
+	checkScript(tst, ctx, "typeof rec", "object")
+	if rec.loads != 0 {
+		tst.Fatalf("Record should not be loaded by typeof")
+	}
+	checkScript(tst, ctx, "rec.id + ':' + rec['name'] + ':' + rec.missing",
+		"7:widget:undefined")
+	checkScript(tst, ctx, "'name' in rec && !('other' in rec)", true)
+	checkScript(tst, ctx, "Object.hasOwn(rec, 'id')", true)
+
+	// Writes, deletes and enumeration go through the host
+	checkScript(tst, ctx, "rec.price = 12; rec['qty'] = 3; rec.qty++; rec.qty",
+		int64(4))
+	checkScript(tst, ctx, "(delete rec.qty) + ':' + (delete rec.id)",
+		"true:false")
+	checkScript(tst, ctx, `var k = '';
+                           for (var p in rec) k = k + p + ',';
+                           k`, "id,name,price,")
+	checkScript(tst, ctx, "Object.keys(rec).join(',')", "id,name,price")
+	checkScript(tst, ctx, "Object.values(rec).join(',')", "7,widget,12")
+	checkScript(tst, ctx, "Object.entries(rec)[1].join('=')", "name=widget")
+	checkScript(tst, ctx, "var o = {...rec, id: 8}; [o.id, o.name, o.price]+''",
+		"8,widget,12")
+	checkScript(tst, ctx, `var o = Object.assign({id: 1}, rec);
+                           [o.id, o.name, o.price].join()`, "7,widget,12")
+	checkScript(tst, ctx, "JSON.stringify({rec: rec})",
+		`{"rec":{"id":7,"name":"widget","price":12}}`)
+	checkScript(tst, ctx, "rec.save()", int64(2))
+	if rec.loads != 1 || rec.columns["price"] != types.IntegerType(12) {
+		tst.Fatalf("Incorrect lazy record state: %d %v", rec.loads,
+			rec.columns)
+	}
+	checkScript(tst, ctx, `Object.assign(rec, {size: 'L'}) === rec &&
+                               rec.size`, "L")
+	checkScript(tst, ctx, `var r = '';
+                           try { Object.assign(rec, {id: 9}); } catch (e) {
+                               r = e.message;
+                           }
+                           r + rec.id`, "Column 'id' is read only7")
+
+	// Host errors are catchable, with the error type from the prefix
+	checkScript(tst, ctx, `var r = '';
+                           try { rec.id = 2; } catch (e) {
+                               r = e.name + ': ' + e.message;
+                           }
+                           r`, "TypeError: Column 'id' is read only")
+	ctx.SetGlobal("bad", &hostRecord{table: "broken"})
+	for _, src := range []string{"bad.name", "bad['x'] = 1", "bad.x++",
+		"delete bad.x", "JSON.stringify([bad])", "Object.values(bad)",
+		"({...bad})", "Object.assign({}, bad)"} {
+		checkScript(tst, ctx, `var r = '';
+                               try { `+src+`; } catch (e) {
+                                   r = e.name + ': ' + e.message;
+                               }
+                               r`, "Error: Connection lost")
+	}
+
+	// Callable host objects
+	ctx.SetGlobal("find", &hostFinder{})
+	checkScript(tst, ctx, "typeof find", "function")
+	checkScript(tst, ctx, "find('parts').name", "widget")
+	checkScript(tst, ctx, "'' + find('parts')", "[record parts]")
+}
@@ -0,0 +1,129 @@
+/*
+ * Test methods for instanceof/typeof of native, host and script types.
+ *
+ * Copyright (C) 2005-2026 J.M. Heisz.  All Rights Reserved.
+ * See the LICENSE file accompanying the distribution your rights to use
+ * this software.
+ */
+
+package gescript
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/heisz/gescript/types"
+)
+
+// Simple host value type (not an object or host object)
+type instMoney struct {
+	cents int64
+}
+
+func (mny *instMoney) Native() interface{} { return mny.cents }
+
+func (mny *instMoney) ToPrimitive(pref any) types.DataType {
+	return types.StringType(fmt.Sprintf("$%d.%02d", mny.cents/100,
+		mny.cents%100))
+}
+
+func newMoneyConstructor() *types.NativeConstructor {
+	ctor := types.NewNativeConstructor("Money",
+		func(prc types.Process, args []types.DataType) (types.DataType, error) {
+			cents := types.ToInt(types.Arg(args, 0))
+			return &instMoney{cents: int64(cents)}, nil
+		})
+	ctor.IsInstance = func(val types.DataType) bool {
+		_, ok := val.(*instMoney)
+		return ok
+	}
+	ctor.TypeOf = "money"
+	return ctor
+}
+
+func TestInstanceofNative(tst *testing.T) {
+	ctx := NewScriptContext()
+	ctx.RegisterConstructor(newMoneyConstructor())
+
+	// Host constructor brand check and typeof
+	checkScript(tst, ctx, "var m = new Money(1250); m instanceof Money", true)
+	checkScript(tst, ctx, "typeof Money(5) + ':' + Money(5)", "money:$0.05")
+	checkScript(tst, ctx, "({}) instanceof Money || [] instanceof Money",
+		false)
+	checkScript(tst, ctx, "typeof Money + ':' + typeof Array",
+		"function:function")
+
+	// Host constructor with an explicit Symbol.hasInstance
+	even := types.NewNativeConstructor("Even", nil)
+	even.AddStaticMethod("@@hasInstance",
+		func(prc types.Process, args []types.DataType) (types.DataType, error) {
+			return types.BooleanType(types.ToInt(types.Arg(args, 0))%2 == 0),
+				nil
+		})
+	ctx.RegisterConstructor(even)
+	checkScript(tst, ctx, "(4 instanceof Even) + ':' + (3 instanceof Even)",
+		"true:false")
+	checkScript(tst, ctx, "typeof Even[Symbol.hasInstance]", "function")
+
+	// Builtin brand checks
+	checkScript(tst, ctx, "[] instanceof Array && [] instanceof Object", true)
+	checkScript(tst, ctx, "({}) instanceof Array", false)
+	checkScript(tst, ctx, "(function() {}) instanceof Function", true)
+	checkScript(tst, ctx,
+		"Money instanceof Function && Money(1) instanceof Object", true)
+	checkScript(tst, ctx, "Promise.resolve(1) instanceof Promise", true)
+	checkScript(tst, ctx, "Symbol() instanceof Symbol || null instanceof Object",
+		false)
+
+	// Objects with Symbol.hasInstance, and non-callable targets
+	checkScript(tst, ctx, `var Small = {};
+                           Small[Symbol.hasInstance] = function(v) {
+                               return v < 10;
+                           };
+                           (5 instanceof Small) + ':' + (50 instanceof Small)`,
+		"true:false")
+	checkScript(tst, ctx, `var r = '';
+                           try { 1 instanceof {}; } catch (e) { r = e.name; }
+                           r`, "TypeError")
+}
+
+func TestInstanceofScript(tst *testing.T) {
+	ctx := NewScriptContext()
+
+	// Constructed instances inherit from the function prototype
+	checkScript(tst, ctx, `function Point(x, y) { this.x = x; this.y = y; }
+                           Point.prototype.sum = function() {
+                               return this.x + this.y;
+                           };
+                           var p = new Point(2, 3);
+                           p.sum() + ':' + (p instanceof Point) + ':' +
+                               (p.constructor === Point)`, "5:true:true")
+	checkScript(tst, ctx, `function A() {}
+                           function B() {}
+                           var a = new A();
+                           (a instanceof A) + ':' + (a instanceof B) + ':' +
+                               (a instanceof Object) + ':' + ({} instanceof A)`,
+		"true:false:true:false")
+
+	// Replaced prototypes and prototype chains
+	checkScript(tst, ctx, `function Animal() {}
+                           Animal.prototype.kind = 'animal';
+                           function Dog() {}
+                           Dog.prototype = new Animal();
+                           var d = new Dog();
+                           d.kind + ':' + (d instanceof Dog) + ':' +
+                               (d instanceof Animal) + ':' +
+                               Object.keys(d).length`, "animal:true:true:0")
+
+	// Bound functions use the target, arrow functions never match
+	checkScript(tst, ctx, `function C() {}
+                           var BC = C.bind(null);
+                           (new C() instanceof BC) + ':' +
+                               ({} instanceof (() => 1))`, "true:false")
+
+	// Each evaluation of a function is a distinct object (prototype)
+	checkScript(tst, ctx, `function make() { return function() {}; }
+                           var F = make(), G = make();
+                           (new F() instanceof F) + ':' + (new F() instanceof G)`,
+		"true:false")
+}
@@ -9,7 +9,9 @@
 package engine
 
 import (
+	"context"
 	"errors"
+	"strings"
 
 	"github.com/heisz/gescript/types"
 )
@@ -18,6 +20,9 @@ import (
 var ErrException = errors.New("exception thrown")
 var ErrStackUnderflow = errors.New("stack underflow")
 
+// Internal signal from await to suspend the execution of an async task
+var errSuspend = errors.New("execution suspended")
+
 // Exception handler context for try blocks, -1 means no target/variable
 type ExceptionContext struct {
 	previous      *ExceptionContext
@@ -26,6 +31,9 @@ type ExceptionContext struct {
 	EndTarget     int
 	StackDepth    int
 	CatchVarSlot  int
+
+	// Call frame active when the context was pushed (for unwinding)
+	frame *CallFrame
 }
 
 // Storage structure for execution context at a function call boundary
@@ -37,6 +45,9 @@ type CallFrame struct {
 	locals   []types.DataType
 	cells    []*Cell
 	closure  []*Cell
+
+	// Marks a call from native code (exit of the nested CallWithThis loop)
+	native bool
 }
 
 // Like that other project, process is the execution context of the opcodes
@@ -77,8 +88,41 @@ type Process struct {
 	// Script globals - functions defined by script
 	globals map[string]types.DataType
 
+	// The globalThis view of the above (lazily allocated)
+	global *globalObject
+
 	// Registered constructors with instance method resolution
 	constructors []*types.NativeConstructor
+
+	// Queue of pending promise jobs (shared, drained by the host)
+	jobs *types.JobQueue
+
+	// For async function execution, the promise for the function result
+	asyncResult *types.PromiseType
+
+	// Go context provided to native Go functions (nil for background)
+	goCtx context.Context
+
+	// Precision and rounding for decimal operations (zero for the default)
+	decimalCtx types.DecimalContext
+
+	// Parsing rules for code compiled during execution (eval)
+	parseOpts types.ParseOptions
+
+	// Compiler for the Function constructor (nil if not available)
+	compiler FunctionCompiler
+
+	// Caller scope of a pending direct eval (taken by the eval function)
+	evalScope *EvalScope
+
+	// Implicit scope object, checked ahead of the globals (nil for none)
+	scope types.DataType
+
+	// Native constructor being invoked through new (nil for a plain call)
+	constructing *types.NativeConstructor
+
+	// Code unit details of the long strings recently indexed by the script
+	units types.UnitCache
 }
 
 // A cell wraps a value by reference for closure sharing
@@ -138,6 +182,9 @@ func (prc *Process) GetGlobal(name string) types.DataType {
 	if val, ok := prc.natives[name]; ok {
 		return val
 	}
+	if name == "globalThis" {
+		return prc.globalThis()
+	}
 	return types.Undefined
 }
 
@@ -155,9 +202,102 @@ func (prc *Process) Throw(exception types.DataType) error {
 	return ErrException
 }
 
+// Externally exposed, convert an error returned from a script call into the
+// associated exception value (consuming it).  Non-exception errors become
+// error objects, using the 'TypeError: ...' style prefix if present.
+func (prc *Process) Catch(err error) types.DataType {
+	if err == ErrException && prc.exception != nil {
+		val := *prc.exception
+		prc.exception = nil
+		return val
+	}
+
+	name, msg := "Error", err.Error()
+	if idx := strings.Index(msg, ": "); idx > 0 {
+		if prefix := msg[:idx]; strings.HasSuffix(prefix, "Error") &&
+			!strings.Contains(prefix, " ") {
+			name, msg = prefix, msg[idx+2:]
+		}
+	}
+	return types.NewError(name, msg)
+}
+
+// Externally exposed, the job queue for promise reactions (created on demand)
+func (prc *Process) Jobs() *types.JobQueue {
+	if prc.jobs == nil {
+		prc.jobs = types.NewJobQueue()
+	}
+	return prc.jobs
+}
+
+// Externally exposed, the code unit cache for string access in the process
+func (prc *Process) UnitCache() *types.UnitCache {
+	return &prc.units
+}
+
+// Externally exposed, the native constructor being invoked through new
+func (prc *Process) Constructing() *types.NativeConstructor {
+	return prc.constructing
+}
+
+// Assign the job queue to use, typically shared from the script context
+func (prc *Process) SetJobQueue(jobs *types.JobQueue) {
+	prc.jobs = jobs
+}
+
+// Go context for the execution, passed to native Go functions
+func (prc *Process) Context() context.Context {
+	if prc.goCtx == nil {
+		return context.Background()
+	}
+	return prc.goCtx
+}
+
+// Assign the Go context, typically from the script context
+func (prc *Process) SetContext(goCtx context.Context) {
+	prc.goCtx = goCtx
+}
+
+// Settings for decimal operations, the default if not assigned
+func (prc *Process) DecimalContext() types.DecimalContext {
+	if prc.decimalCtx.Precision <= 0 {
+		return types.DefaultDecimalContext
+	}
+	return prc.decimalCtx
+}
+
+// Assign the decimal settings, typically from the script context
+func (prc *Process) SetDecimalContext(dctx types.DecimalContext) {
+	prc.decimalCtx = dctx
+}
+
+// Parsing rules for code compiled during the execution (eval)
+func (prc *Process) ParseOptions() types.ParseOptions {
+	return prc.parseOpts
+}
+
+// Assign the parsing rules, typically from the script context
+func (prc *Process) SetParseOptions(opts types.ParseOptions) {
+	prc.parseOpts = opts
+}
+
+// Assign the implicit scope object, where the undeclared identifiers of the
+// script resolve against its properties ahead of the globals
+func (prc *Process) SetScope(scope types.DataType) {
+	prc.scope = scope
+}
+
 // Method used by eval() to replicate the globals of the process
 func (prc *Process) Replica(stackDepth int) *Process {
-	return NewProcess(stackDepth, prc.natives, prc.globals, prc.constructors)
+	rep := NewProcess(stackDepth, prc.natives, prc.globals, prc.constructors)
+	rep.jobs = prc.Jobs()
+	rep.goCtx = prc.goCtx
+	rep.decimalCtx = prc.decimalCtx
+	rep.parseOpts = prc.parseOpts
+	rep.compiler = prc.compiler
+	rep.global = prc.global
+	rep.scope = prc.scope
+	return rep
 }
 
 // The fundamental model in this implementation is that every set of code is
@@ -167,6 +307,9 @@ type Function struct {
 	Name     string
 	Code     []*OpCode
 	VarCount int
+
+	// Strict mode code (errors for undeclared assignments, read-only writes)
+	Strict bool
 }
 
 func NewFunction(nm string) *Function {
@@ -178,6 +321,12 @@ func NewFunction(nm string) *Function {
 
 // Execution 'loop' to run the given function in the associated process
 func (body *Function) Exec(prc *Process) (ret types.DataType, err error) {
+	return body.execWithCells(prc, nil)
+}
+
+// Common execution with (optional) preassigned variable cells (modules)
+func (body *Function) execWithCells(prc *Process,
+	cells []*Cell) (ret types.DataType, err error) {
 	// For now, just ram this in
 	prc.body = body
 	prc.pc = 0
@@ -185,6 +334,8 @@ func (body *Function) Exec(prc *Process) (ret types.DataType, err error) {
 	prc.sp = 0
 	prc.exceptionCtx = nil
 	prc.exception = nil
+	prc.callStack = nil
+	prc.cells = cells
 
 	// Allocate locals array for variable storage (all undefined)
 	if body.VarCount > 0 {
@@ -195,41 +346,74 @@ func (body *Function) Exec(prc *Process) (ret types.DataType, err error) {
 	}
 
 	// Loop until we run out of runway
+	if err := prc.execLoop(); err != nil {
+		if err == ErrException {
+			// No handler in stack for exception instance
+			return nil, errors.New("Uncaught exception")
+		}
+		return nil, err
+	}
+
+	// The last item on the stack is the outer return value
+	if prc.sp > 0 {
+		return prc.stack[prc.sp-1], nil
+	}
+	return types.Undefined, nil
+}
+
+// Core execution loop, runs until the body completes or an unhandled error
+func (prc *Process) execLoop() error {
 	for {
 		pc := prc.pc
 		if pc < 0 || pc >= len(prc.body.Code) {
 			// The first shouldn't happen but just in case...
-			break
+			return nil
 		}
 		op := prc.body.Code[pc]
 		opErr := op.ExecFn(prc, op)
 		if opErr != nil {
-			if opErr == ErrException {
-				if !prc.handleException() {
-					// No handler in stack for exception instance
-					return nil, errors.New("Uncaught exception")
-				}
-				// Handler found, frame updated, continue execution
-			} else {
-				return nil, opErr
+			if opErr == errSuspend {
+				// Awaiting in async task, resume at next opcode
+				prc.pc++
+				return opErr
 			}
+			if opErr != ErrException || !prc.handleException() {
+				return opErr
+			}
+			// Handler found, frame updated, continue execution
 		}
 		prc.pc++
 	}
+}
 
-	// The last item on the stack is the outer return value
-	if prc.sp > 0 {
-		return prc.stack[prc.sp-1], nil
-	}
-	return types.Undefined, nil
+// Restore the execution context from the topmost call frame (return/unwind)
+func (prc *Process) popFrame() {
+	frame := prc.callStack
+	prc.callStack = frame.previous
+	prc.body = frame.body
+	prc.pc = frame.pc
+	prc.sp = frame.sp
+	prc.locals = frame.locals
+	prc.cells = frame.cells
+	prc.closure = frame.closure
 }
 
 // Handle an exception by unwinding to context, returns true if 'handled'
 func (prc *Process) handleException() bool {
 	for prc.exceptionCtx != nil {
-		// Pop the topmost try context
+		// Handlers beyond a native call boundary are for the outer loop
 		ctx := prc.exceptionCtx
+		for frame := prc.callStack; frame != ctx.frame; frame = frame.previous {
+			if frame == nil || frame.native {
+				return false
+			}
+		}
+
+		// Pop the topmost try context and unwind the calls within it
 		prc.exceptionCtx = ctx.previous
+		for prc.callStack != ctx.frame {
+			prc.popFrame()
+		}
 
 		// Restore stack depth
 		prc.sp = ctx.StackDepth
@@ -273,12 +457,19 @@ type ScriptFunction struct {
 	ArgumentsSlot int
 	ThisSlot      int
 	IsArrowFunc   bool
+	IsAsync       bool
 
 	// Lists of variables from enclosing scopes to capture
 	Captures []CaptureInfo
 
+	// Original source text of the definition (for toString)
+	Source string
+
 	// Populated during runtime, set of cells from enclosing scopes for closures
 	Closure []*Cell
+
+	// Prototype for constructed instances (created on demand)
+	prototype *types.ObjectType
 }
 
 // Tracking data for a function call with spread arguments
@@ -293,12 +484,24 @@ type ArraySpreadInfo struct {
 	SpreadMask []bool
 }
 
+// Tracking data for object literals with computed keys or spread entries,
+// where the key value of a computed entry precedes the entry value on the
+// stack (the Keys entry is only used for the static keys)
+type ObjectSpreadInfo struct {
+	Keys       []string
+	Computed   []bool
+	SpreadMask []bool
+}
+
 // Implementations for the DataType and FunctionType interfaces
 func (sf *ScriptFunction) Native() interface{} {
 	return sf
 }
 
 func (sf *ScriptFunction) ToPrimitive(pref any) types.DataType {
+	if sf.Source != "" {
+		return types.StringType(sf.Source)
+	}
 	return types.StringType("function " + sf.Name + "() { [script code] }")
 }
 
@@ -306,6 +509,26 @@ func (sf *ScriptFunction) GetName() string {
 	return sf.Name
 }
 
+// Arrow and async functions cannot be used with new
+func (sf *ScriptFunction) IsConstructor() bool {
+	return !sf.IsArrowFunc && !sf.IsAsync
+}
+
+// The prototype object for instances constructed by the function, created on
+// first use with the constructor reference (Section 10.2.5)
+func (sf *ScriptFunction) Prototype() *types.ObjectType {
+	if sf.prototype == nil {
+		sf.prototype = types.NewObject()
+		sf.prototype.Set("constructor", sf)
+	}
+	return sf.prototype
+}
+
+// Replace the prototype object (assignment to F.prototype)
+func (sf *ScriptFunction) SetPrototype(proto *types.ObjectType) {
+	sf.prototype = proto
+}
+
 // Note that the standard 'call' method is just an undefined this
 func (sf *ScriptFunction) Call(prc types.Process,
 	args []types.DataType) (types.DataType, error) {
@@ -323,15 +546,23 @@ func (sf *ScriptFunction) CallWithThis(prc types.Process,
 		execPrc = prc.(*Process)
 	}
 
-	// Push a native call frame to capture exit condition (null body)
-	execPrc.callStack = &CallFrame{
+	// Async functions run as a distinct task, result is the promise
+	if sf.IsAsync {
+		return sf.callAsync(execPrc, thisVal, args), nil
+	}
+
+	// Push a native call frame to capture exit condition (return through it)
+	frame := &CallFrame{
 		previous: execPrc.callStack,
-		body:     nil,
+		body:     execPrc.body,
+		pc:       execPrc.pc,
 		sp:       execPrc.sp,
 		locals:   execPrc.locals,
 		cells:    execPrc.cells,
 		closure:  execPrc.closure,
+		native:   true,
 	}
+	execPrc.callStack = frame
 
 	// Set up execution context for the function
 	execPrc.body = sf.Body
@@ -349,10 +580,9 @@ func (sf *ScriptFunction) CallWithThis(prc types.Process,
 		execPrc.locals = nil
 	}
 
-	bindFunctionParams(execPrc.locals, sf, thisVal, args)
+	execPrc.bindFunctionParams(execPrc.locals, sf, thisVal, args)
 
-	// Run the execution loop
-	var execErr error
+	// Run the execution loop until the return through the native frame
 	for {
 		pc := execPrc.pc
 		if pc < 0 || pc >= len(execPrc.body.Code) {
@@ -361,31 +591,84 @@ func (sf *ScriptFunction) CallWithThis(prc types.Process,
 		op := execPrc.body.Code[pc]
 		opErr := op.ExecFn(execPrc, op)
 		if opErr != nil {
-			if opErr == ErrException {
-				if !execPrc.handleException() {
-					execErr = errors.New("Uncaught exception")
-					break
+			if opErr != ErrException || !execPrc.handleException() {
+				// Unwind to the caller, exception remains pending for it
+				for execPrc.callStack != frame.previous {
+					execPrc.popFrame()
 				}
-			} else {
-				execErr = opErr
-				break
+				return nil, opErr
 			}
 		}
+		if execPrc.callStack == frame.previous {
+			// Returned to native caller, result is on the stack
+			return execPrc.pop()
+		}
 		execPrc.pc++
 	}
 
-	// Return value is on stack
-	var result types.DataType = types.Undefined
-	if execPrc.sp > 0 {
-		result = execPrc.stack[execPrc.sp-1]
+	// Should never get here (functions always return) but just in case...
+	for execPrc.callStack != frame.previous {
+		execPrc.popFrame()
+	}
+	return types.Undefined, nil
+}
+
+// Start the execution of an async function, as a task in a dedicated process
+// that suspends on await, returning the promise for the eventual result
+func (sf *ScriptFunction) callAsync(prc *Process, thisVal types.DataType,
+	args []types.DataType) *types.PromiseType {
+	task := prc.Replica(32)
+	task.asyncResult = types.NewPromise()
+	task.body = sf.Body
+	task.pc = 0
+	task.closure = sf.Closure
+	if sf.Body.VarCount > 0 {
+		task.locals = make([]types.DataType, sf.Body.VarCount)
+		for idx := 0; idx < sf.Body.VarCount; idx++ {
+			task.locals[idx] = types.Undefined
+		}
 	}
+	task.bindFunctionParams(task.locals, sf, thisVal, args)
+
+	task.resumeTask()
+	return task.asyncResult
+}
+
+// (Re)enter the execution loop of an async task, settling the result promise
+// on completion (await will suspend and resume through here)
+func (prc *Process) resumeTask() {
+	err := prc.execLoop()
+	switch {
+	case err == errSuspend:
+		return
+	case err != nil:
+		prc.asyncResult.Reject(prc, prc.Catch(err))
+	case prc.sp > 0:
+		prc.asyncResult.Resolve(prc, prc.stack[prc.sp-1])
+	default:
+		prc.asyncResult.Resolve(prc, types.Undefined)
+	}
+}
 
-	return result, execErr
+// Continuation of an await, pushes the value or throws the rejection
+func (prc *Process) continueTask(val types.DataType, rejected bool) {
+	if rejected {
+		prc.exception = &val
+		if !prc.handleException() {
+			prc.exception = nil
+			prc.asyncResult.Reject(prc, val)
+			return
+		}
+		prc.pc++
+	} else {
+		prc.push(val)
+	}
+	prc.resumeTask()
 }
 
 // Common method to handle this/param/args binding to script variables
-func bindFunctionParams(locals []types.DataType, sf *ScriptFunction,
-	thisVal types.DataType, args []types.DataType) {
+func (prc *Process) bindFunctionParams(locals []types.DataType,
+	sf *ScriptFunction, thisVal types.DataType, args []types.DataType) {
 	paramCount := len(sf.ParamNames)
 
 	// Handle rest parameter if applicable
@@ -418,8 +701,15 @@ func bindFunctionParams(locals []types.DataType, sf *ScriptFunction,
 		locals[sf.ArgumentsSlot] = argsArr
 	}
 
-	// Likewise for the this variable
+	// Likewise for the this variable, which is the global object for
+	// non-strict functions called without one
 	if sf.ThisSlot >= 0 {
+		switch thisVal.(type) {
+		case types.UndefinedType, types.NullType:
+			if !sf.Body.Strict {
+				thisVal = prc.globalThis()
+			}
+		}
 		locals[sf.ThisSlot] = thisVal
 	}
 }
@@ -0,0 +1,137 @@
+/*
+ * Code compiled during execution, for eval and the Function constructor.
+ *
+ * Copyright (C) 2005-2026 J.M. Heisz.  All Rights Reserved.
+ * See the LICENSE file accompanying the distribution your rights to use
+ * this software.
+ */
+
+package engine
+
+import (
+	"github.com/heisz/gescript/types"
+)
+
+// Compiler for the parameter and body source of the Function constructor,
+// provided by the host as the parser is not accessible to the engine
+type FunctionCompiler func(params, body string,
+	opts types.ParseOptions) (*ScriptFunction, error)
+
+// Section 19.2.1.1, a call to eval by name is a direct eval (if it is the
+// intrinsic eval function) where the code can reference the variables of the
+// caller.  Arguments are as for CallSpreadInfo, the scope is the visible
+// variables of the caller (local slots or closure captures) and global is set
+// for a caller in the top level of a script.  Within a with statement, the
+// 'this' for a function found on the object is beneath the function.
+type DirectEvalInfo struct {
+	ArgCount   int
+	SpreadMask []bool
+	Scope      []CaptureInfo
+	Strict     bool
+	Global     bool
+	HasThis    bool
+}
+
+// Caller scope for the code of a direct eval, the names of the variables
+// visible to the code with the associated (shared) cells
+type EvalScope struct {
+	Names  []string
+	Strict bool
+	Global bool
+	cells  []*Cell
+}
+
+// Assign the compiler for the Function constructor, typically from the host
+func (prc *Process) SetFunctionCompiler(compiler FunctionCompiler) {
+	prc.compiler = compiler
+}
+
+// Compile the source of the Function constructor into a function in the
+// global scope, using the parsing rules of the process
+func (prc *Process) CompileFunction(params,
+	body string) (types.DataType, error) {
+	if prc.compiler == nil {
+		return nil, types.ThrowError(prc, "EvalError",
+			"Code generation from strings is not available")
+	}
+	fn, err := prc.compiler(params, body, prc.parseOpts)
+	if err != nil {
+		return nil, types.ThrowError(prc, "SyntaxError", err.Error())
+	}
+	return fn, nil
+}
+
+// Obtain (and clear) the caller scope of the direct eval being called, nil
+// if the eval function was called indirectly
+func (prc *Process) TakeEvalScope() *EvalScope {
+	scope := prc.evalScope
+	prc.evalScope = nil
+	return scope
+}
+
+// Execute the compiled eval code as a nested call, binding the captured
+// variables to the cells of the caller scope (nil for an indirect eval)
+func (prc *Process) CallEval(fn *ScriptFunction,
+	scope *EvalScope) (types.DataType, error) {
+	if scope != nil && len(fn.Captures) != 0 {
+		fn.Closure = make([]*Cell, len(fn.Captures))
+		for idx, cap := range fn.Captures {
+			fn.Closure[idx] = scope.cells[cap.SlotIndex]
+		}
+	}
+	return fn.CallWithThis(prc, types.Undefined, nil)
+}
+
+// Call the eval function, where the intrinsic eval is provided the scope of
+// the caller (taken by the function), otherwise this is a regular call
+func DirectEvalOperation(prc *Process, op *OpCode) (err error) {
+	args, err := extractCallArgs(prc, op)
+	if err != nil {
+		return err
+	}
+
+	fnVal, err := prc.pop()
+	if err != nil {
+		return err
+	}
+
+	info := op.OpData.(DirectEvalInfo)
+	thisVal := types.DataType(types.Undefined)
+	if info.HasThis {
+		if thisVal, err = prc.pop(); err != nil {
+			return err
+		}
+	}
+	if prc.natives != nil && fnVal == prc.natives["eval"] && len(args) != 0 {
+		if _, ok := args[0].(types.StringType); ok {
+			names := make([]string, len(info.Scope))
+			for idx, cap := range info.Scope {
+				names[idx] = cap.Name
+			}
+			prc.evalScope = &EvalScope{
+				Names:  names,
+				Strict: info.Strict,
+				Global: info.Global,
+				cells:  prc.captureCells(info.Scope),
+			}
+			defer func() { prc.evalScope = nil }()
+		}
+	}
+
+	return callFunctionWithThis(prc, fnVal, thisVal, args)
+}
+
+// Return from the eval code with the completion value, the last (top-level)
+// expression result left on the stack or undefined if there are none
+func EvalReturnOperation(prc *Process, op *OpCode) (err error) {
+	base := 0
+	if prc.callStack != nil {
+		base = prc.callStack.sp
+	}
+	if prc.sp <= base {
+		if err = prc.push(types.Undefined); err != nil {
+			return err
+		}
+	}
+	return ReturnOperation(prc, op)
+}
@@ -0,0 +1,81 @@
+/*
+ * The global object (globalThis), a view of the process globals/natives.
+ *
+ * Copyright (C) 2005-2026 J.M. Heisz.  All Rights Reserved.
+ * See the LICENSE file accompanying the distribution your rights to use
+ * this software.
+ */
+
+package engine
+
+import (
+	"sort"
+
+	"github.com/heisz/gescript/types"
+)
+
+// There is no actual global object in the engine, global references resolve
+// directly against the script globals and then the natives maps.  The
+// globalThis value is a host object that reflects those same maps, where
+// assignments are written through as script globals (the natives are shared
+// across processes and are never modified).
+type globalObject struct {
+	prc *Process
+}
+
+// Obtain the global object for the process (same instance for identity)
+func (prc *Process) globalThis() *globalObject {
+	if prc.global == nil {
+		prc.global = &globalObject{prc: prc}
+	}
+	return prc.global
+}
+
+func (glb *globalObject) Native() interface{} {
+	return glb
+}
+
+func (glb *globalObject) ToPrimitive(pref any) types.DataType {
+	return types.StringType("[object global]")
+}
+
+func (glb *globalObject) Get(name string) (types.DataType, error) {
+	return glb.prc.GetGlobal(name), nil
+}
+
+// The constant globals are read only, as for an identifier assignment
+func (glb *globalObject) Set(name string, val types.DataType) error {
+	if readOnlyGlobals[name] {
+		return glb.prc.readOnlyWrite("Cannot assign to read only " +
+			"property '" + name + "' of object")
+	}
+	glb.prc.SetGlobal(name, val)
+	return nil
+}
+
+// Only script globals can be removed, the natives are not configurable
+func (glb *globalObject) Delete(name string) (bool, error) {
+	if _, ok := glb.prc.natives[name]; ok || name == "globalThis" {
+		return false, nil
+	}
+	delete(glb.prc.globals, name)
+	return true, nil
+}
+
+func (glb *globalObject) Has(name string) bool {
+	if _, ok := glb.prc.globals[name]; ok {
+		return true
+	}
+	_, ok := glb.prc.natives[name]
+	return ok || name == "globalThis"
+}
+
+// Only the script globals are enumerable (sorted, as there is no order)
+func (glb *globalObject) Keys() []string {
+	keys := make([]string, 0, len(glb.prc.globals))
+	for name := range glb.prc.globals {
+		keys = append(keys, name)
+	}
+	sort.Strings(keys)
+	return keys
+}
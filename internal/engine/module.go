@@ -0,0 +1,309 @@
+/*
+ * Structures and linkage for the execution of ES modules.
+ *
+ * Copyright (C) 2005-2026 J.M. Heisz.  All Rights Reserved.
+ * See the LICENSE file accompanying the distribution your rights to use
+ * this software.
+ */
+
+package engine
+
+import (
+	"fmt"
+
+	"github.com/heisz/gescript/types"
+)
+
+// Modules are compiled like any other script (a function body) but with the
+// additional import/export records.  At runtime, the exported local variables
+// and the imported bindings are held in shared cells (like closures), which
+// provides the live binding semantics across modules (and cycles).
+
+// Import binding (Section 16.2.1.6.1), Name is "*" for a namespace import
+type ImportEntry struct {
+	Specifier string
+	Name      string
+	Slot      int
+}
+
+// Export binding, either a local variable (Slot) or an indirect (re)export
+// from another module (Specifier).  For 'export * from', the Name is "*"
+// while ImportName of "*" is a namespace re-export (export * as ns).  Lexical
+// (let/const/default) exports are uninitialized until evaluated.
+type ExportEntry struct {
+	Name       string
+	Slot       int
+	Specifier  string
+	ImportName string
+	Lexical    bool
+}
+
+// The compiled module code, as generated by the parser
+type ModuleCode struct {
+	Body *Function
+
+	// Module specifiers requested by the module, in source order
+	Requests []string
+
+	Imports []ImportEntry
+	Exports []ExportEntry
+
+	// Local variable slot for import.meta, -1 if unused
+	MetaSlot int
+}
+
+// Lifecycle of a module instance, evaluation occurs once
+type ModuleStatus int
+
+const (
+	ModuleLinked ModuleStatus = iota
+	ModuleEvaluating
+	ModuleEvaluated
+	ModuleErrored
+)
+
+// Runtime instance of a module within a context (cached by resolved name)
+type ModuleInstance struct {
+	Name string
+	Code *ModuleCode
+
+	// Resolved module instances for each of the requested specifiers
+	Dependencies map[string]*ModuleInstance
+
+	// Host-defined object for import.meta, created with the url (name)
+	Meta *types.ObjectType
+
+	status ModuleStatus
+	err    error
+	result types.DataType
+
+	// Cells for the local (exported and imported) variable slots
+	cells []*Cell
+
+	// Cells for synthetic exports and namespace re-exports, by name
+	named map[string]*Cell
+
+	namespace *types.ModuleNamespace
+}
+
+// Create the instance for the compiled module, allocating the cells for the
+// local exports so that importers can bind before evaluation (cycles)
+func NewModuleInstance(name string, code *ModuleCode) *ModuleInstance {
+	mod := &ModuleInstance{
+		Name:         name,
+		Code:         code,
+		Dependencies: make(map[string]*ModuleInstance),
+		Meta:         types.NewObject(),
+		cells:        make([]*Cell, code.Body.VarCount),
+		named:        make(map[string]*Cell),
+	}
+	mod.Meta.Set("url", types.StringType(name))
+
+	for _, exp := range code.Exports {
+		if exp.Specifier != "" || mod.cells[exp.Slot] != nil {
+			continue
+		}
+		if exp.Lexical {
+			var val types.DataType = &uninitialized{name: exp.Name}
+			mod.cells[exp.Slot] = &Cell{Value: &val}
+		} else {
+			mod.cells[exp.Slot] = &Cell{Value: newUndefinedRef()}
+		}
+	}
+	return mod
+}
+
+// Create a synthetic (host-defined) module from the set of export values
+func NewSyntheticModule(name string,
+	exports map[string]types.DataType) *ModuleInstance {
+	mod := &ModuleInstance{
+		Name:         name,
+		Dependencies: make(map[string]*ModuleInstance),
+		Meta:         types.NewObject(),
+		status:       ModuleEvaluated,
+		result:       types.Undefined,
+		named:        make(map[string]*Cell),
+	}
+	mod.Meta.Set("url", types.StringType(name))
+	for key, val := range exports {
+		val := val
+		mod.named[key] = &Cell{Value: &val}
+	}
+	return mod
+}
+
+func newUndefinedRef() *types.DataType {
+	val := types.Undefined
+	return &val
+}
+
+// Resolve the binding cell for the export name (Section 16.2.1.6.3), nil if
+// not found (or ambiguous).  Visited tracks the resolution set for cycles.
+func (mod *ModuleInstance) resolveExport(name string,
+	visited map[*ModuleInstance]map[string]bool) *Cell {
+	if visited[mod] == nil {
+		visited[mod] = make(map[string]bool)
+	}
+	if visited[mod][name] {
+		return nil
+	}
+	visited[mod][name] = true
+
+	// Synthetic modules only have named bindings
+	if mod.Code == nil {
+		return mod.named[name]
+	}
+
+	for _, exp := range mod.Code.Exports {
+		if exp.Name != name {
+			continue
+		}
+		if exp.Specifier == "" {
+			return mod.cells[exp.Slot]
+		}
+		dep := mod.Dependencies[exp.Specifier]
+		if exp.ImportName == "*" {
+			if mod.named[name] == nil {
+				ns := types.DataType(dep.Namespace())
+				mod.named[name] = &Cell{Value: &ns}
+			}
+			return mod.named[name]
+		}
+		return dep.resolveExport(exp.ImportName, visited)
+	}
+
+	// Star exports never provide the default export
+	if name == "default" {
+		return nil
+	}
+	var found *Cell
+	for _, exp := range mod.Code.Exports {
+		if exp.Name != "*" {
+			continue
+		}
+		cell := mod.Dependencies[exp.Specifier].resolveExport(name, visited)
+		if cell != nil {
+			if found != nil && found != cell {
+				// Ambiguous across star exports, not exported
+				return nil
+			}
+			found = cell
+		}
+	}
+	return found
+}
+
+// Determine the set of exported names (including star exports)
+func (mod *ModuleInstance) exportedNames(visited map[*ModuleInstance]bool,
+	names map[string]bool) {
+	if visited[mod] {
+		return
+	}
+	visited[mod] = true
+	if mod.Code == nil {
+		for name := range mod.named {
+			names[name] = true
+		}
+		return
+	}
+	for _, exp := range mod.Code.Exports {
+		if exp.Name != "*" {
+			names[exp.Name] = true
+		} else {
+			mod.Dependencies[exp.Specifier].exportedNames(visited, names)
+		}
+	}
+}
+
+// Retrieve (creating on first use) the namespace object for the module
+func (mod *ModuleInstance) Namespace() *types.ModuleNamespace {
+	if mod.namespace != nil {
+		return mod.namespace
+	}
+	names := make(map[string]bool)
+	mod.exportedNames(make(map[*ModuleInstance]bool), names)
+
+	bindings := make(map[string]*types.DataType)
+	for name := range names {
+		cell := mod.resolveExport(name,
+			make(map[*ModuleInstance]map[string]bool))
+		if cell != nil {
+			bindings[name] = cell.Value
+		}
+	}
+	mod.namespace = types.NewModuleNamespace(bindings)
+	return mod.namespace
+}
+
+// Bind the imported variables to the cells of the exporting modules
+func (mod *ModuleInstance) link() error {
+	for _, imp := range mod.Code.Imports {
+		dep := mod.Dependencies[imp.Specifier]
+		if imp.Name == "*" {
+			ns := types.DataType(dep.Namespace())
+			mod.cells[imp.Slot] = &Cell{Value: &ns}
+			continue
+		}
+		cell := dep.resolveExport(imp.Name,
+			make(map[*ModuleInstance]map[string]bool))
+		if cell == nil {
+			return fmt.Errorf("SyntaxError: The requested module '%s' "+
+				"does not provide an export named '%s'", imp.Specifier,
+				imp.Name)
+		}
+		mod.cells[imp.Slot] = cell
+	}
+	for _, exp := range mod.Code.Exports {
+		if exp.Specifier != "" && exp.Name != "*" &&
+			mod.resolveExport(exp.Name,
+				make(map[*ModuleInstance]map[string]bool)) == nil {
+			return fmt.Errorf("SyntaxError: The requested module '%s' "+
+				"does not provide an export named '%s'", exp.Specifier,
+				exp.ImportName)
+		}
+	}
+	return nil
+}
+
+// Link and evaluate the module, after its dependencies (in request order).
+// The process factory provides a fresh process for each module body.  Per
+// the specification, modules in a cycle see the current state of bindings
+// of the modules still being evaluated (undefined if not yet assigned).
+func (mod *ModuleInstance) Evaluate(newProcess func() *Process) (
+	types.DataType, error) {
+	switch mod.status {
+	case ModuleEvaluating, ModuleEvaluated:
+		return mod.result, nil
+	case ModuleErrored:
+		return nil, mod.err
+	}
+	mod.status = ModuleEvaluating
+	mod.result = types.Undefined
+
+	fail := func(err error) (types.DataType, error) {
+		mod.status = ModuleErrored
+		mod.err = err
+		return nil, err
+	}
+
+	if err := mod.link(); err != nil {
+		return fail(err)
+	}
+	for _, spec := range mod.Code.Requests {
+		if _, err := mod.Dependencies[spec].Evaluate(newProcess); err != nil {
+			return fail(err)
+		}
+	}
+
+	if mod.Code.MetaSlot >= 0 {
+		meta := types.DataType(mod.Meta)
+		mod.cells[mod.Code.MetaSlot] = &Cell{Value: &meta}
+	}
+	res, err := mod.Code.Body.execWithCells(newProcess(), mod.cells)
+	if err != nil {
+		return fail(err)
+	}
+	mod.status = ModuleEvaluated
+	mod.result = res
+	return res, nil
+}
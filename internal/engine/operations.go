@@ -11,6 +11,7 @@ package engine
 import (
 	"fmt"
 	"math"
+	"math/bits"
 	"strconv"
 
 	"github.com/heisz/gescript/types"
@@ -35,6 +36,317 @@ type OpCode struct {
 	OpData     interface{}
 }
 
+// Common method to pull the operands for the binary (arithmetic/relational)
+// operations, converting objects to primitives (Symbol.toPrimitive) and
+// rejecting symbols which cannot be implicitly converted (TypeError)
+func (prc *Process) popOperands(hint string) (left, right types.DataType,
+	err error) {
+	if right, err = prc.pop(); err != nil {
+		return
+	}
+	if left, err = prc.pop(); err != nil {
+		return
+	}
+	if left, err = types.ToPrimitiveChecked(prc, left, hint); err != nil {
+		return
+	}
+	if right, err = types.ToPrimitiveChecked(prc, right, hint); err != nil {
+		return
+	}
+
+	_, lissym := left.(*types.SymbolType)
+	_, rissym := right.(*types.SymbolType)
+	if lissym || rissym {
+		target := "number"
+		_, lisstr := left.(types.StringType)
+		_, risstr := right.(types.StringType)
+		if hint == "default" && (lisstr || risstr) {
+			target = "string"
+		}
+		err = types.ThrowError(prc, "TypeError",
+			"Cannot convert a Symbol value to a "+target)
+	}
+	return
+}
+
+// Host operand types apply their own binary operators ahead of the primitive
+// conversion of the operands (left operand first), false if not handled
+func (prc *Process) hostBinaryOperation(op types.Operator) (bool, error) {
+	if prc.sp < 2 {
+		return false, nil
+	}
+	left, right := prc.stack[prc.sp-2], prc.stack[prc.sp-1]
+
+	var res types.DataType
+	err := types.ErrNoOperator
+	if lop, ok := left.(types.OperandType); ok {
+		res, err = lop.BinaryOp(prc, op, right, false)
+	}
+	if rop, ok := right.(types.OperandType); ok && err == types.ErrNoOperator {
+		res, err = rop.BinaryOp(prc, op, left, true)
+	}
+	if err == types.ErrNoOperator {
+		return false, nil
+	}
+
+	prc.sp -= 2
+	if err != nil {
+		return true, types.HostError(prc, err)
+	}
+	return true, prc.push(res)
+}
+
+// Ditto for the relational operators, where the test determines the result
+// from the comparison of the left operand to the right
+func (prc *Process) hostRelational(test func(cmp int) bool) (bool, error) {
+	if prc.sp < 2 {
+		return false, nil
+	}
+	left, right := prc.stack[prc.sp-2], prc.stack[prc.sp-1]
+
+	cmp, err := 0, types.ErrNoOperator
+	if lop, ok := left.(types.OperandType); ok {
+		cmp, err = lop.Compare(prc, right)
+	}
+	if rop, ok := right.(types.OperandType); ok && err == types.ErrNoOperator {
+		cmp, err = rop.Compare(prc, left)
+		cmp = -cmp
+	}
+	if err == types.ErrNoOperator {
+		return false, nil
+	}
+
+	prc.sp -= 2
+	if err != nil {
+		return true, types.HostError(prc, err)
+	}
+	return true, prc.push(types.BooleanType(test(cmp)))
+}
+
+// And for the (loose) equality operators, inverted for inequality
+func (prc *Process) hostEquality(invert bool) (bool, error) {
+	if prc.sp < 2 {
+		return false, nil
+	}
+	left, right := prc.stack[prc.sp-2], prc.stack[prc.sp-1]
+
+	eq, err := false, types.ErrNoOperator
+	if lop, ok := left.(types.OperandType); ok {
+		eq, err = lop.Equals(prc, right)
+	}
+	if rop, ok := right.(types.OperandType); ok && err == types.ErrNoOperator {
+		eq, err = rop.Equals(prc, left)
+	}
+	if err == types.ErrNoOperator {
+		return false, nil
+	}
+
+	prc.sp -= 2
+	if err != nil {
+		return true, types.HostError(prc, err)
+	}
+	return true, prc.push(types.BooleanType(eq != invert))
+}
+
+// BigInt operands (Section 6.1.6.2) only combine with other BigInts, mixing
+// with other (numeric) values is a TypeError.  Not handled (false) if neither
+// operand is a BigInt.
+func (prc *Process) bigIntOperands(left, right types.DataType) (lval,
+	rval types.BigIntType, ok bool, err error) {
+	lval, lok := left.(types.BigIntType)
+	rval, rok := right.(types.BigIntType)
+	if !lok && !rok {
+		return
+	}
+	if !lok || !rok {
+		err = types.ThrowError(prc, "TypeError",
+			"Cannot mix BigInt and other types, use explicit conversions")
+	}
+	return lval, rval, true, err
+}
+
+// Push the result of a BigInt operation, errors are thrown into the script
+func (prc *Process) pushBigInt(res types.BigIntType, err error) error {
+	if err != nil {
+		return types.HostError(prc, err)
+	}
+	return prc.push(res)
+}
+
+// Relational comparison involving a BigInt (Section 7.2.13), which can be
+// compared to numbers and (parsed) strings.  Valid is false for undefined
+// comparisons (NaN or unparseable strings), ok is false if neither operand
+// is a BigInt.
+func bigIntCompare(left, right types.DataType) (cmp int, valid, ok bool) {
+	bval, isBig := left.(types.BigIntType)
+	other, invert := right, false
+	if !isBig {
+		if bval, isBig = right.(types.BigIntType); !isBig {
+			return 0, false, false
+		}
+		other, invert = left, true
+	}
+
+	switch oval := other.(type) {
+	case types.BigIntType:
+		cmp, valid = bval.Cmp(oval), true
+	case types.StringType:
+		parsed, err := types.ParseBigInt(string(oval))
+		if err != nil {
+			return 0, false, true
+		}
+		cmp, valid = bval.Cmp(parsed), true
+	default:
+		cmp, valid = bval.CmpFloat(types.ToNumber(other))
+	}
+	if invert {
+		cmp = -cmp
+	}
+	return cmp, valid, true
+}
+
+// Ditto for the (loose) equality comparison, where only primitive numeric
+// and string values can be equal to a BigInt
+func bigIntEquals(left, right types.DataType) (eq, ok bool) {
+	_, lisbig := left.(types.BigIntType)
+	_, risbig := right.(types.BigIntType)
+	if !lisbig && !risbig {
+		return false, false
+	}
+	for _, val := range []types.DataType{left, right} {
+		switch val.(type) {
+		case types.BigIntType, types.IntegerType, types.NumberType,
+			types.StringType, types.BooleanType:
+		default:
+			return false, true
+		}
+	}
+	cmp, valid, _ := bigIntCompare(left, right)
+	return valid && cmp == 0, true
+}
+
+// Determine the element index for a typed array (or string) access, false
+// if the index is not a (canonical) numeric value (a member reference)
+func elementIndex(index types.DataType) (int, bool) {
+	switch ix := index.(type) {
+	case types.IntegerType:
+		return int(ix), true
+	case types.NumberType:
+		if float64(ix) != math.Trunc(float64(ix)) {
+			return -1, true
+		}
+		return int(ix), true
+	case types.StringType:
+		if idx, err := strconv.Atoi(string(ix)); err == nil &&
+			strconv.Itoa(idx) == string(ix) {
+			return idx, true
+		}
+	}
+	return 0, false
+}
+
+// Copy the own enumerable properties of the source into the object for the
+// object spread (Section 7.3.25), null/undefined and other primitives have
+// no properties to copy (other than the indexed characters of strings)
+func (prc *Process) copyDataProperties(obj *types.ObjectType,
+	src types.DataType) error {
+	switch tsrc := src.(type) {
+	case *types.ObjectType:
+		for key, val := range tsrc.Properties {
+			obj.Set(key, val)
+		}
+		for sym, val := range tsrc.Symbols {
+			obj.SetSymbol(sym, val)
+		}
+	case *types.ArrayType:
+		for idx, val := range tsrc.Elements {
+			obj.Set(strconv.Itoa(idx), val)
+		}
+	case *types.TypedArrayType:
+		for idx := 0; idx < tsrc.Length(); idx++ {
+			obj.Set(strconv.Itoa(idx), tsrc.Get(idx))
+		}
+	case types.StringType:
+		for idx, unit := range types.UTF16(string(tsrc)) {
+			obj.Set(strconv.Itoa(idx),
+				types.StringType(types.FromUTF16([]uint16{unit})))
+		}
+	case types.HostObject:
+		keys, err := types.HostKeys(prc, tsrc)
+		if err != nil {
+			return types.HostError(prc, err)
+		}
+		for _, key := range keys {
+			val, err := types.HostGet(prc, tsrc, key)
+			if err != nil {
+				return types.HostError(prc, err)
+			}
+			obj.Set(key, val)
+		}
+	}
+	return nil
+}
+
+// Extract the elements of an array (or typed array) for spread expansion,
+// strings are expanded by code point and other values through the iterator
+// protocol (false if not iterable)
+func (prc *Process) spreadValues(
+	val types.DataType) ([]types.DataType, bool, error) {
+	switch tval := val.(type) {
+	case *types.ArrayType:
+		return tval.Elements, true, nil
+	case types.StringType:
+		vals := []types.DataType{}
+		for offset := 0; offset < len(tval); {
+			cp, size := types.NextCodePoint(string(tval), offset)
+			vals = append(vals, types.StringType(cp))
+			offset += size
+		}
+		return vals, true, nil
+	case *types.TypedArrayType:
+		vals := make([]types.DataType, tval.Length())
+		for idx := range vals {
+			vals[idx] = tval.Get(idx)
+		}
+		return vals, true, nil
+	}
+
+	method, ok := prc.getSymbolMember(val,
+		types.SymbolIterator).(types.FunctionType)
+	if !ok {
+		return nil, false, nil
+	}
+	iter, err := types.CallMethod(prc, method, val, nil)
+	if err != nil {
+		return nil, false, err
+	}
+	var next types.DataType = types.Undefined
+	if obj, ok := iter.(*types.ObjectType); ok {
+		next = obj.Get("next")
+	}
+	nextFn, ok := next.(types.FunctionType)
+	if !ok {
+		return nil, false, types.ThrowError(prc, "TypeError",
+			"Result of the Symbol.iterator method is not an iterator")
+	}
+	vals := []types.DataType{}
+	for {
+		res, err := types.CallMethod(prc, nextFn, iter, nil)
+		if err != nil {
+			return nil, false, err
+		}
+		resObj, ok := res.(*types.ObjectType)
+		if !ok {
+			return nil, false, types.ThrowError(prc, "TypeError",
+				"Iterator result "+types.ToString(res)+" is not an object")
+		}
+		if types.IsTruthy(resObj.Get("done")) {
+			return vals, true, nil
+		}
+		vals = append(vals, resObj.Get("value"))
+	}
+}
+
 // All of the various opcode functions appear below
 
 func PushLiteralValue(prc *Process, op *OpCode) (err error) {
@@ -43,12 +355,12 @@ func PushLiteralValue(prc *Process, op *OpCode) (err error) {
 }
 
 func AdditionOperation(prc *Process, op *OpCode) (err error) {
-	// Pull the operands
-	right, err := prc.pop()
-	if err != nil {
+	if done, err := prc.hostBinaryOperation(types.OpAdd); done || err != nil {
 		return err
 	}
-	left, err := prc.pop()
+
+	// Pull the operands (as primitive values)
+	left, right, err := prc.popOperands("default")
 	if err != nil {
 		return err
 	}
@@ -59,7 +371,16 @@ func AdditionOperation(prc *Process, op *OpCode) (err error) {
 	if lisstr || risstr {
 		leftStr := types.ToString(left)
 		rightStr := types.ToString(right)
-		return prc.push(types.StringType(leftStr + rightStr))
+		return prc.push(types.StringType(types.ConcatStrings(leftStr,
+			rightStr)))
+	}
+
+	// BigInts only combine with BigInts
+	if lval, rval, ok, err := prc.bigIntOperands(left, right); ok {
+		if err != nil {
+			return err
+		}
+		return prc.push(lval.Add(rval))
 	}
 
 	// Big sets of switch statements to handle all of the mixes
@@ -68,8 +389,7 @@ func AdditionOperation(prc *Process, op *OpCode) (err error) {
 	case types.IntegerType:
 		switch right.(type) {
 		case types.IntegerType:
-			res = types.IntegerType(left.Native().(int64) +
-				right.Native().(int64))
+			res = integerAdd(left.Native().(int64), right.Native().(int64))
 		case types.NumberType:
 			res = types.NumberType(float64(left.Native().(int64)) +
 				right.Native().(float64))
@@ -90,24 +410,31 @@ func AdditionOperation(prc *Process, op *OpCode) (err error) {
 }
 
 func SubtractionOperation(prc *Process, op *OpCode) (err error) {
-	// Pull the operands
-	right, err := prc.pop()
-	if err != nil {
+	if done, err := prc.hostBinaryOperation(types.OpSubtract); done || err != nil {
 		return err
 	}
-	left, err := prc.pop()
+
+	// Pull the operands (as primitive values)
+	left, right, err := prc.popOperands("number")
 	if err != nil {
 		return err
 	}
 
+	// BigInts only combine with BigInts
+	if lval, rval, ok, err := prc.bigIntOperands(left, right); ok {
+		if err != nil {
+			return err
+		}
+		return prc.push(lval.Sub(rval))
+	}
+
 	// Big sets of switch statements to handle all of the mixes
 	var res types.DataType = types.Undefined
 	switch left.(type) {
 	case types.IntegerType:
 		switch right.(type) {
 		case types.IntegerType:
-			res = types.IntegerType(left.Native().(int64) -
-				right.Native().(int64))
+			res = integerSub(left.Native().(int64), right.Native().(int64))
 		case types.NumberType:
 			res = types.NumberType(float64(left.Native().(int64)) -
 				right.Native().(float64))
@@ -128,24 +455,31 @@ func SubtractionOperation(prc *Process, op *OpCode) (err error) {
 }
 
 func MultiplicationOperation(prc *Process, op *OpCode) (err error) {
-	// Pull the operands
-	right, err := prc.pop()
-	if err != nil {
+	if done, err := prc.hostBinaryOperation(types.OpMultiply); done || err != nil {
 		return err
 	}
-	left, err := prc.pop()
+
+	// Pull the operands (as primitive values)
+	left, right, err := prc.popOperands("number")
 	if err != nil {
 		return err
 	}
 
+	// BigInts only combine with BigInts
+	if lval, rval, ok, err := prc.bigIntOperands(left, right); ok {
+		if err != nil {
+			return err
+		}
+		return prc.pushBigInt(lval.Mul(rval))
+	}
+
 	// Big sets of switch statements to handle all of the mixes
 	var res types.DataType = types.Undefined
 	switch left.(type) {
 	case types.IntegerType:
 		switch right.(type) {
 		case types.IntegerType:
-			res = types.IntegerType(left.Native().(int64) *
-				right.Native().(int64))
+			res = integerMul(left.Native().(int64), right.Native().(int64))
 		case types.NumberType:
 			res = types.NumberType(float64(left.Native().(int64)) *
 				right.Native().(float64))
@@ -166,16 +500,24 @@ func MultiplicationOperation(prc *Process, op *OpCode) (err error) {
 }
 
 func DivisionOperation(prc *Process, op *OpCode) (err error) {
-	// Pull the operands
-	right, err := prc.pop()
-	if err != nil {
+	if done, err := prc.hostBinaryOperation(types.OpDivide); done || err != nil {
 		return err
 	}
-	left, err := prc.pop()
+
+	// Pull the operands (as primitive values)
+	left, right, err := prc.popOperands("number")
 	if err != nil {
 		return err
 	}
 
+	// BigInts only combine with BigInts
+	if lval, rval, ok, err := prc.bigIntOperands(left, right); ok {
+		if err != nil {
+			return err
+		}
+		return prc.pushBigInt(lval.Quo(rval))
+	}
+
 	// Slightly different, division always produces number per specification
 	var res types.DataType = types.Undefined
 	var lval, rval float64
@@ -200,16 +542,24 @@ func DivisionOperation(prc *Process, op *OpCode) (err error) {
 }
 
 func ModulusOperation(prc *Process, op *OpCode) (err error) {
-	// Pull the operands
-	right, err := prc.pop()
-	if err != nil {
+	if done, err := prc.hostBinaryOperation(types.OpModulus); done || err != nil {
 		return err
 	}
-	left, err := prc.pop()
+
+	// Pull the operands (as primitive values)
+	left, right, err := prc.popOperands("number")
 	if err != nil {
 		return err
 	}
 
+	// BigInts only combine with BigInts
+	if lval, rval, ok, err := prc.bigIntOperands(left, right); ok {
+		if err != nil {
+			return err
+		}
+		return prc.pushBigInt(lval.Rem(rval))
+	}
+
 	// Per specification, int results in int but float has special rules
 	var res types.DataType = types.Undefined
 	switch left.(type) {
@@ -248,16 +598,145 @@ func ModulusOperation(prc *Process, op *OpCode) (err error) {
 	return
 }
 
-func LeftShiftOperation(prc *Process, op *OpCode) (err error) {
-	// Pull the operands
-	right, err := prc.pop()
+// Integer arithmetic results are retained as integers unless the int64
+// result overflows, where the (inexact) floating point result is used
+func integerAdd(lval, rval int64) types.DataType {
+	res := lval + rval
+	if (lval^res)&(rval^res) < 0 {
+		return types.NumberType(float64(lval) + float64(rval))
+	}
+	return types.IntegerType(res)
+}
+
+func integerSub(lval, rval int64) types.DataType {
+	res := lval - rval
+	if (lval^rval)&(lval^res) < 0 {
+		return types.NumberType(float64(lval) - float64(rval))
+	}
+	return types.IntegerType(res)
+}
+
+func integerMul(lval, rval int64) types.DataType {
+	absL, absR := uint64(lval), uint64(rval)
+	if lval < 0 {
+		absL = -absL
+	}
+	if rval < 0 {
+		absR = -absR
+	}
+	limit := uint64(math.MaxInt64)
+	if (lval < 0) != (rval < 0) {
+		limit++
+	}
+	if hi, lo := bits.Mul64(absL, absR); hi != 0 || lo > limit {
+		return types.NumberType(float64(lval) * float64(rval))
+	}
+	return types.IntegerType(lval * rval)
+}
+
+// Largest integer result retained as an integer for exponentiation (beyond
+// which the floating point result is not exact, Number.MAX_SAFE_INTEGER)
+const maxExactInteger = 1<<53 - 1
+
+// Integer exponentiation by squaring, false if the result is not exact
+func integerPow(base, exp int64) (int64, bool) {
+	if exp == 0 {
+		return 1, true
+	}
+	if base > maxExactInteger || base < -maxExactInteger {
+		return 0, false
+	}
+	mul := func(a, b int64) (int64, bool) {
+		absA, absB := a, b
+		if absA < 0 {
+			absA = -absA
+		}
+		if absB < 0 {
+			absB = -absB
+		}
+		if absA != 0 && absB > maxExactInteger/absA {
+			return 0, false
+		}
+		return a * b, true
+	}
+
+	res, ok := int64(1), true
+	for ok {
+		if exp&1 != 0 {
+			if res, ok = mul(res, base); !ok {
+				break
+			}
+		}
+		if exp >>= 1; exp == 0 {
+			break
+		}
+		base, ok = mul(base, base)
+	}
+	return res, ok
+}
+
+// Section 6.1.6.1.3, which differs from math.Pow for NaN exponents and for
+// an infinite exponent of a unit base (always NaN)
+func numberPow(base, exp float64) float64 {
+	if math.IsNaN(exp) || (math.IsInf(exp, 0) && math.Abs(base) == 1) {
+		return math.NaN()
+	}
+	return math.Pow(base, exp)
+}
+
+func ExponentOperation(prc *Process, op *OpCode) (err error) {
+	if done, err := prc.hostBinaryOperation(types.OpExponent); done || err != nil {
+		return err
+	}
+
+	// Pull the operands (as primitive values)
+	left, right, err := prc.popOperands("number")
 	if err != nil {
 		return err
 	}
-	left, err := prc.pop()
+
+	// BigInts only combine with BigInts
+	if lval, rval, ok, err := prc.bigIntOperands(left, right); ok {
+		if err != nil {
+			return err
+		}
+		return prc.pushBigInt(lval.Exp(rval))
+	}
+
+	// Integer results are retained where exact (non-negative exponent)
+	lint, lok := left.(types.IntegerType)
+	rint, rok := right.(types.IntegerType)
+	if lok && rok && rint >= 0 {
+		if res, exact := integerPow(int64(lint), int64(rint)); exact {
+			return prc.push(types.IntegerType(res))
+		}
+	}
+
+	lval, err := types.ToNumberChecked(prc, left)
+	if err != nil {
+		return err
+	}
+	rval, err := types.ToNumberChecked(prc, right)
 	if err != nil {
 		return err
 	}
+	return prc.push(types.NumberType(numberPow(lval, rval)))
+}
+
+func LeftShiftOperation(prc *Process, op *OpCode) (err error) {
+	// Pull the operands (as primitive values)
+	left, right, err := prc.popOperands("number")
+	if err != nil {
+		return err
+	}
+
+	// BigInts only combine with BigInts
+	if lval, rval, ok, err := prc.bigIntOperands(left, right); ok {
+		if err != nil {
+			return err
+		}
+		return prc.pushBigInt(lval.Lsh(rval))
+	}
 
 	// Per specification, convert to int for shift operations
 	var lval, rval int64
@@ -281,14 +760,18 @@ func LeftShiftOperation(prc *Process, op *OpCode) (err error) {
 }
 
 func RightShiftOperation(prc *Process, op *OpCode) (err error) {
-	// Pull the operands
-	right, err := prc.pop()
+	// Pull the operands (as primitive values)
+	left, right, err := prc.popOperands("number")
 	if err != nil {
 		return err
 	}
-	left, err := prc.pop()
-	if err != nil {
-		return err
+
+	// BigInts only combine with BigInts
+	if lval, rval, ok, err := prc.bigIntOperands(left, right); ok {
+		if err != nil {
+			return err
+		}
+		return prc.pushBigInt(lval.Rsh(rval))
 	}
 
 	// Per specification, convert to int for shift operations
@@ -313,14 +796,19 @@ func RightShiftOperation(prc *Process, op *OpCode) (err error) {
 }
 
 func UnsignedRightShiftOperation(prc *Process, op *OpCode) (err error) {
-	// Pull the operands
-	right, err := prc.pop()
+	// Pull the operands (as primitive values)
+	left, right, err := prc.popOperands("number")
 	if err != nil {
 		return err
 	}
-	left, err := prc.pop()
-	if err != nil {
-		return err
+
+	// BigInts have no unsigned right shift (Section 6.1.6.2.11)
+	if _, _, ok, err := prc.bigIntOperands(left, right); ok {
+		if err != nil {
+			return err
+		}
+		return types.ThrowError(prc, "TypeError",
+			"BigInts have no unsigned right shift, use >> instead")
 	}
 
 	// Per specification, convert to int for shift operations
@@ -345,16 +833,21 @@ func UnsignedRightShiftOperation(prc *Process, op *OpCode) (err error) {
 }
 
 func LessThanOperation(prc *Process, op *OpCode) (err error) {
-	// Pull the operands
-	right, err := prc.pop()
-	if err != nil {
+	done, err := prc.hostRelational(func(cmp int) bool { return cmp < 0 })
+	if done || err != nil {
 		return err
 	}
-	left, err := prc.pop()
+
+	// Pull the operands (as primitive values)
+	left, right, err := prc.popOperands("number")
 	if err != nil {
 		return err
 	}
 
+	if cmp, valid, ok := bigIntCompare(left, right); ok {
+		return prc.push(types.BooleanType(valid && cmp < 0))
+	}
+
 	var res types.DataType = types.BooleanType(false)
 	switch left.(type) {
 	case types.IntegerType:
@@ -388,16 +881,21 @@ func LessThanOperation(prc *Process, op *OpCode) (err error) {
 }
 
 func GreaterThanOperation(prc *Process, op *OpCode) (err error) {
-	// Pull the operands
-	right, err := prc.pop()
-	if err != nil {
+	done, err := prc.hostRelational(func(cmp int) bool { return cmp > 0 })
+	if done || err != nil {
 		return err
 	}
-	left, err := prc.pop()
+
+	// Pull the operands (as primitive values)
+	left, right, err := prc.popOperands("number")
 	if err != nil {
 		return err
 	}
 
+	if cmp, valid, ok := bigIntCompare(left, right); ok {
+		return prc.push(types.BooleanType(valid && cmp > 0))
+	}
+
 	var res types.DataType = types.BooleanType(false)
 	switch left.(type) {
 	case types.IntegerType:
@@ -431,16 +929,21 @@ func GreaterThanOperation(prc *Process, op *OpCode) (err error) {
 }
 
 func LessThanEqualOperation(prc *Process, op *OpCode) (err error) {
-	// Pull the operands
-	right, err := prc.pop()
-	if err != nil {
+	done, err := prc.hostRelational(func(cmp int) bool { return cmp <= 0 })
+	if done || err != nil {
 		return err
 	}
-	left, err := prc.pop()
+
+	// Pull the operands (as primitive values)
+	left, right, err := prc.popOperands("number")
 	if err != nil {
 		return err
 	}
 
+	if cmp, valid, ok := bigIntCompare(left, right); ok {
+		return prc.push(types.BooleanType(valid && cmp <= 0))
+	}
+
 	var res types.DataType = types.BooleanType(false)
 	switch left.(type) {
 	case types.IntegerType:
@@ -474,16 +977,21 @@ func LessThanEqualOperation(prc *Process, op *OpCode) (err error) {
 }
 
 func GreaterThanEqualOperation(prc *Process, op *OpCode) (err error) {
-	// Pull the operands
-	right, err := prc.pop()
-	if err != nil {
+	done, err := prc.hostRelational(func(cmp int) bool { return cmp >= 0 })
+	if done || err != nil {
 		return err
 	}
-	left, err := prc.pop()
+
+	// Pull the operands (as primitive values)
+	left, right, err := prc.popOperands("number")
 	if err != nil {
 		return err
 	}
 
+	if cmp, valid, ok := bigIntCompare(left, right); ok {
+		return prc.push(types.BooleanType(valid && cmp >= 0))
+	}
+
 	var res types.DataType = types.BooleanType(false)
 	switch left.(type) {
 	case types.IntegerType:
@@ -516,67 +1024,78 @@ func GreaterThanEqualOperation(prc *Process, op *OpCode) (err error) {
 	return
 }
 
-func EqualOperation(prc *Process, op *OpCode) (err error) {
-	// Pull the operands
-	right, err := prc.pop()
-	if err != nil {
-		return err
+// Abstract (loose) equality comparison (Section 7.2.14), where objects are
+// converted through ToPrimitive (honouring Symbol.toPrimitive) when compared
+// to a primitive and booleans/strings are compared numerically when mixed
+func looseEquals(prc *Process, left, right types.DataType) (bool, error) {
+	if left == nil {
+		left = types.Undefined
 	}
-	left, err := prc.pop()
-	if err != nil {
-		return err
+	if right == nil {
+		right = types.Undefined
 	}
 
-	// Abstract equality comparison (with type coercion)
-	var res types.DataType = types.BooleanType(false)
-	switch left.(type) {
-	case types.UndefinedType:
-		switch right.(type) {
-		case types.UndefinedType, types.NullType:
-			res = types.BooleanType(true)
+	var err error
+	lobj, robj := !types.IsPrimitive(left), !types.IsPrimitive(right)
+	switch {
+	case lobj && robj:
+		return types.StrictEquals(left, right), nil
+	case lobj:
+		if isNullish(right) {
+			return false, nil
 		}
-	case types.NullType:
-		switch right.(type) {
-		case types.UndefinedType, types.NullType:
-			res = types.BooleanType(true)
+		if left, err = types.ToPrimitiveChecked(prc, left,
+			"default"); err != nil {
+			return false, err
 		}
-	case types.BooleanType:
-		switch right.(type) {
-		case types.BooleanType:
-			res = types.BooleanType(left.Native().(bool) ==
-				right.Native().(bool))
+	case robj:
+		if isNullish(left) {
+			return false, nil
 		}
-	case types.IntegerType:
-		switch right.(type) {
-		case types.IntegerType:
-			res = types.BooleanType(left.Native().(int64) ==
-				right.Native().(int64))
-		case types.NumberType:
-			res = types.BooleanType(float64(left.Native().(int64)) ==
-				right.Native().(float64))
-		}
-	case types.NumberType:
-		switch right.(type) {
-		case types.IntegerType:
-			res = types.BooleanType(left.Native().(float64) ==
-				float64(right.Native().(int64)))
-		case types.NumberType:
-			res = types.BooleanType(left.Native().(float64) ==
-				right.Native().(float64))
-		}
-	case types.StringType:
-		switch right.(type) {
-		case types.StringType:
-			res = types.BooleanType(left.Native().(string) ==
-				right.Native().(string))
+		if right, err = types.ToPrimitiveChecked(prc, right,
+			"default"); err != nil {
+			return false, err
 		}
 	}
 
-	err = prc.push(res)
-	return
+	if eq, ok := bigIntEquals(left, right); ok {
+		return eq, nil
+	}
+	if isNullish(left) || isNullish(right) {
+		return isNullish(left) && isNullish(right), nil
+	}
+
+	// Remaining mixed (non-symbol) primitives are compared as numbers
+	_, lstr := left.(types.StringType)
+	_, rstr := right.(types.StringType)
+	_, lsym := left.(*types.SymbolType)
+	_, rsym := right.(*types.SymbolType)
+	if lstr != rstr && !lsym && !rsym {
+		return types.ToNumber(left) == types.ToNumber(right), nil
+	}
+	if _, ok := left.(types.BooleanType); ok && !rsym {
+		return types.ToNumber(left) == types.ToNumber(right), nil
+	}
+	if _, ok := right.(types.BooleanType); ok && !lsym {
+		return types.ToNumber(left) == types.ToNumber(right), nil
+	}
+	return types.StrictEquals(left, right), nil
 }
 
-func NotEqualOperation(prc *Process, op *OpCode) (err error) {
+// Determine if the value is undefined or null
+func isNullish(val types.DataType) bool {
+	switch val.(type) {
+	case nil, types.UndefinedType, types.NullType:
+		return true
+	}
+	return false
+}
+
+func EqualOperation(prc *Process, op *OpCode) (err error) {
+	if done, err := prc.hostEquality(false); done || err != nil {
+		return err
+	}
+
 	// Pull the operands
 	right, err := prc.pop()
 	if err != nil {
@@ -587,53 +1106,33 @@ func NotEqualOperation(prc *Process, op *OpCode) (err error) {
 		return err
 	}
 
-	// Abstract inequality comparison (with type coercion, opposite of ==)
-	var res types.DataType = types.BooleanType(true)
-	switch left.(type) {
-	case types.UndefinedType:
-		switch right.(type) {
-		case types.UndefinedType, types.NullType:
-			res = types.BooleanType(false)
-		}
-	case types.NullType:
-		switch right.(type) {
-		case types.UndefinedType, types.NullType:
-			res = types.BooleanType(false)
-		}
-	case types.BooleanType:
-		switch right.(type) {
-		case types.BooleanType:
-			res = types.BooleanType(left.Native().(bool) !=
-				right.Native().(bool))
-		}
-	case types.IntegerType:
-		switch right.(type) {
-		case types.IntegerType:
-			res = types.BooleanType(left.Native().(int64) !=
-				right.Native().(int64))
-		case types.NumberType:
-			res = types.BooleanType(float64(left.Native().(int64)) !=
-				right.Native().(float64))
-		}
-	case types.NumberType:
-		switch right.(type) {
-		case types.IntegerType:
-			res = types.BooleanType(left.Native().(float64) !=
-				float64(right.Native().(int64)))
-		case types.NumberType:
-			res = types.BooleanType(left.Native().(float64) !=
-				right.Native().(float64))
-		}
-	case types.StringType:
-		switch right.(type) {
-		case types.StringType:
-			res = types.BooleanType(left.Native().(string) !=
-				right.Native().(string))
-		}
+	eq, err := looseEquals(prc, left, right)
+	if err != nil {
+		return err
+	}
+	return prc.push(types.BooleanType(eq))
+}
+
+func NotEqualOperation(prc *Process, op *OpCode) (err error) {
+	if done, err := prc.hostEquality(true); done || err != nil {
+		return err
+	}
+
+	// Pull the operands
+	right, err := prc.pop()
+	if err != nil {
+		return err
+	}
+	left, err := prc.pop()
+	if err != nil {
+		return err
 	}
 
-	err = prc.push(res)
-	return
+	eq, err := looseEquals(prc, left, right)
+	if err != nil {
+		return err
+	}
+	return prc.push(types.BooleanType(!eq))
 }
 
 func StrictEqualOperation(prc *Process, op *OpCode) (err error) {
@@ -663,14 +1162,18 @@ func StrictNotEqualOperation(prc *Process, op *OpCode) (err error) {
 }
 
 func BitwiseAndOperation(prc *Process, op *OpCode) (err error) {
-	// Pull the operands
-	right, err := prc.pop()
+	// Pull the operands (as primitive values)
+	left, right, err := prc.popOperands("number")
 	if err != nil {
 		return err
 	}
-	left, err := prc.pop()
-	if err != nil {
-		return err
+
+	// BigInts only combine with BigInts
+	if lval, rval, ok, err := prc.bigIntOperands(left, right); ok {
+		if err != nil {
+			return err
+		}
+		return prc.push(lval.And(rval))
 	}
 
 	// Need integers for bit operations
@@ -695,14 +1198,18 @@ func BitwiseAndOperation(prc *Process, op *OpCode) (err error) {
 }
 
 func BitwiseOrOperation(prc *Process, op *OpCode) (err error) {
-	// Pull the operands
-	right, err := prc.pop()
+	// Pull the operands (as primitive values)
+	left, right, err := prc.popOperands("number")
 	if err != nil {
 		return err
 	}
-	left, err := prc.pop()
-	if err != nil {
-		return err
+
+	// BigInts only combine with BigInts
+	if lval, rval, ok, err := prc.bigIntOperands(left, right); ok {
+		if err != nil {
+			return err
+		}
+		return prc.push(lval.Or(rval))
 	}
 
 	// Need integers for bit operations
@@ -727,14 +1234,18 @@ func BitwiseOrOperation(prc *Process, op *OpCode) (err error) {
 }
 
 func BitwiseXorOperation(prc *Process, op *OpCode) (err error) {
-	// Pull the operands
-	right, err := prc.pop()
+	// Pull the operands (as primitive values)
+	left, right, err := prc.popOperands("number")
 	if err != nil {
 		return err
 	}
-	left, err := prc.pop()
-	if err != nil {
-		return err
+
+	// BigInts only combine with BigInts
+	if lval, rval, ok, err := prc.bigIntOperands(left, right); ok {
+		if err != nil {
+			return err
+		}
+		return prc.push(lval.Xor(rval))
 	}
 
 	// Need integers for bit operations
@@ -765,6 +1276,7 @@ func UnaryPlusOperation(prc *Process, op *OpCode) (err error) {
 	}
 
 	// ToNumber conversion per specification, preserving numeric types
+	// (BigInts are rejected by the checked conversion below)
 	var res types.DataType
 	switch val := srcval.(type) {
 	case types.IntegerType:
@@ -778,7 +1290,11 @@ func UnaryPlusOperation(prc *Process, op *OpCode) (err error) {
 			res = types.IntegerType(0)
 		}
 	default:
-		res = types.NumberType(types.ToNumber(srcval))
+		num, err := types.ToNumberChecked(prc, srcval)
+		if err != nil {
+			return err
+		}
+		res = types.NumberType(num)
 	}
 
 	err = prc.push(res)
@@ -793,11 +1309,22 @@ func UnaryMinusOperation(prc *Process, op *OpCode) (err error) {
 
 	// Negate the numeric value, preserving integer type for ints/bools
 	var res types.DataType
+	if hop, ok := srcval.(types.OperandType); ok {
+		res, err = hop.Negate(prc)
+		if err != types.ErrNoOperator {
+			if err != nil {
+				return types.HostError(prc, err)
+			}
+			return prc.push(res)
+		}
+	}
 	switch val := srcval.(type) {
 	case types.IntegerType:
-		res = types.IntegerType(-val)
+		res = integerSub(0, int64(val))
 	case types.NumberType:
 		res = types.NumberType(-val)
+	case types.BigIntType:
+		res = val.Neg()
 	case types.BooleanType:
 		if val {
 			res = types.IntegerType(-1)
@@ -805,7 +1332,11 @@ func UnaryMinusOperation(prc *Process, op *OpCode) (err error) {
 			res = types.IntegerType(0)
 		}
 	default:
-		res = types.NumberType(-types.ToNumber(srcval))
+		num, err := types.ToNumberChecked(prc, srcval)
+		if err != nil {
+			return err
+		}
+		res = types.NumberType(-num)
 	}
 
 	err = prc.push(res)
@@ -835,6 +1366,11 @@ func BitwiseNotOperation(prc *Process, op *OpCode) (err error) {
 		ival = val.Native().(int64)
 	case types.NumberType:
 		ival = int64(val.Native().(float64))
+	case types.BigIntType:
+		return prc.push(val.(types.BigIntType).Not())
+	case *types.SymbolType:
+		return types.ThrowError(prc, "TypeError",
+			"Cannot convert a Symbol value to a number")
 	}
 
 	res := types.DataType(types.IntegerType(^int32(ival)))
@@ -928,22 +1464,105 @@ func DupOperation(prc *Process, op *OpCode) (err error) {
 	return
 }
 
-func LoadVariableOperation(prc *Process, op *OpCode) (err error) {
-	slotIndex := op.OpData.(int)
-	if slotIndex < 0 || slotIndex >= len(prc.locals) {
+// Duplicate the top two stack entries (target/index for compound assignment)
+func DupPairOperation(prc *Process, op *OpCode) (err error) {
+	if prc.sp < 2 {
+		return ErrStackUnderflow
+	}
+	left, right := prc.stack[prc.sp-2], prc.stack[prc.sp-1]
+	if err = prc.push(left); err != nil {
+		return err
+	}
+	return prc.push(right)
+}
+
+// Lexical (let/const) bindings are uninitialized until the declaration is
+// evaluated, access within this temporal dead zone is a ReferenceError
+type uninitialized struct {
+	name string
+}
+
+func (uninit *uninitialized) Native() interface{} {
+	return nil
+}
+
+func (uninit *uninitialized) ToPrimitive(pref any) types.DataType {
+	return types.Undefined
+}
+
+// Common error for access to a binding in the dead zone (if applicable)
+func (prc *Process) checkInitialized(val types.DataType) error {
+	if uninit, ok := val.(*uninitialized); ok {
+		return types.ThrowError(prc, "ReferenceError",
+			"Cannot access '"+uninit.name+"' before initialization")
+	}
+	return nil
+}
+
+// Lexical bindings of a scope, reset on (each) entry of the scope
+type LexicalScope struct {
+	Names []string
+	Slots []int
+	// Blocks can be re-entered (loops), bindings are fresh for each entry
+	Block bool
+}
+
+// Enter a scope, placing the lexical bindings in the temporal dead zone
+func EnterScopeOperation(prc *Process, op *OpCode) (err error) {
+	scope := op.OpData.(LexicalScope)
+	for idx, slot := range scope.Slots {
+		if slot >= len(prc.locals) {
+			continue
+		}
+		if scope.Block && slot < len(prc.cells) {
+			// Detach from closures of the prior entry of the block
+			prc.cells[slot] = nil
+		}
+		storeVariable(prc, slot, &uninitialized{name: scope.Names[idx]})
+	}
+	return nil
+}
+
+// Section 14.7.4.4, create the bindings for the next iteration of the loop,
+// where closures of the prior iteration retain the prior (captured) cells
+func RenewBindingsOperation(prc *Process, op *OpCode) (err error) {
+	for _, slot := range op.OpData.([]int) {
+		if slot >= len(prc.locals) || slot >= len(prc.cells) ||
+			prc.cells[slot] == nil {
+			continue
+		}
+
+		// Value carries forward to the local, until captured again
+		prc.locals[slot] = *prc.cells[slot].Value
+		prc.cells[slot] = nil
+	}
+	return nil
+}
+
+// Read the local variable (or the associated closure capture cell)
+func (prc *Process) loadVariable(slot int) (types.DataType, error) {
+	if slot < 0 || slot >= len(prc.locals) {
 		// Invalid slot returns undefined to prevent stack issues
-		return prc.push(types.Undefined)
+		return types.Undefined, nil
 	}
 
 	// Handle closure capture of variable (in capture cell)
-	if prc.cells != nil && slotIndex < len(prc.cells) &&
-		prc.cells[slotIndex] != nil {
-		err = prc.push(*prc.cells[slotIndex].Value)
-		return
+	val := prc.locals[slot]
+	if prc.cells != nil && slot < len(prc.cells) && prc.cells[slot] != nil {
+		val = *prc.cells[slot].Value
+	}
+	if err := prc.checkInitialized(val); err != nil {
+		return nil, err
 	}
+	return val, nil
+}
 
-	err = prc.push(prc.locals[slotIndex])
-	return
+func LoadVariableOperation(prc *Process, op *OpCode) (err error) {
+	val, err := prc.loadVariable(op.OpData.(int))
+	if err != nil {
+		return err
+	}
+	return prc.push(val)
 }
 
 func storeVariable(prc *Process, slot int, val types.DataType) (err error) {
@@ -973,13 +1592,17 @@ func StoreVariableOperation(prc *Process, op *OpCode) (err error) {
 	return storeVariable(prc, slotIndex, val)
 }
 
-// Like Store above but leave value on stack for assignment chaining
+// Like Store above but leave value on stack for assignment chaining (which
+// is not a declaration, so cannot assign in the temporal dead zone)
 func StoreVariableKeepOperation(prc *Process, op *OpCode) (err error) {
 	slotIndex := op.OpData.(int)
 	val, err := prc.peek()
 	if err != nil {
 		return err
 	}
+	if _, err := prc.loadVariable(slotIndex); err != nil {
+		return err
+	}
 
 	return storeVariable(prc, slotIndex, val)
 }
@@ -994,6 +1617,7 @@ func PushExceptionContextOperation(prc *Process, op *OpCode) (err error) {
 		EndTarget:     src.EndTarget,
 		StackDepth:    prc.sp,
 		CatchVarSlot:  src.CatchVarSlot,
+		frame:         prc.callStack,
 	}
 	prc.exceptionCtx = ctx
 	return
@@ -1029,9 +1653,11 @@ func FinallyCompleteOperation(prc *Process, op *OpCode) (err error) {
 func incrementValue(val types.DataType) types.DataType {
 	switch val.(type) {
 	case types.IntegerType:
-		return types.IntegerType(val.Native().(int64) + 1)
+		return integerAdd(val.Native().(int64), 1)
 	case types.NumberType:
 		return types.NumberType(val.Native().(float64) + 1)
+	case types.BigIntType:
+		return val.(types.BigIntType).Add(types.NewBigInt(1))
 	}
 
 	// For everything else, result is NaN
@@ -1041,57 +1667,75 @@ func incrementValue(val types.DataType) types.DataType {
 func decrementValue(val types.DataType) types.DataType {
 	switch val.(type) {
 	case types.IntegerType:
-		return types.IntegerType(val.Native().(int64) - 1)
+		return integerSub(val.Native().(int64), 1)
 	case types.NumberType:
 		return types.NumberType(val.Native().(float64) - 1)
+	case types.BigIntType:
+		return val.(types.BigIntType).Sub(types.NewBigInt(1))
 	}
 
 	// For everything else, result is NaN
 	return types.NaN
 }
 
+// Increment/decrement the value on the stack, for references that are not
+// simple local variables (the parser generates the load and store)
+func IncrementValueOperation(prc *Process, op *OpCode) (err error) {
+	val, err := prc.pop()
+	if err != nil {
+		return err
+	}
+	return prc.push(incrementValue(val))
+}
+
+func DecrementValueOperation(prc *Process, op *OpCode) (err error) {
+	val, err := prc.pop()
+	if err != nil {
+		return err
+	}
+	return prc.push(decrementValue(val))
+}
+
 func PreIncrementOperation(prc *Process, op *OpCode) (err error) {
 	slotIndex := op.OpData.(int)
-	if slotIndex < 0 || slotIndex >= len(prc.locals) {
-		return nil
+	orig, err := prc.loadVariable(slotIndex)
+	if err != nil {
+		return err
 	}
-	val := incrementValue(prc.locals[slotIndex])
-	prc.locals[slotIndex] = val
-	err = prc.push(val)
-	return
+	val := incrementValue(orig)
+	storeVariable(prc, slotIndex, val)
+	return prc.push(val)
 }
 
 func PreDecrementOperation(prc *Process, op *OpCode) (err error) {
 	slotIndex := op.OpData.(int)
-	if slotIndex < 0 || slotIndex >= len(prc.locals) {
-		return nil
+	orig, err := prc.loadVariable(slotIndex)
+	if err != nil {
+		return err
 	}
-	val := decrementValue(prc.locals[slotIndex])
-	prc.locals[slotIndex] = val
-	err = prc.push(val)
-	return
+	val := decrementValue(orig)
+	storeVariable(prc, slotIndex, val)
+	return prc.push(val)
 }
 
 func PostIncrementOperation(prc *Process, op *OpCode) (err error) {
 	slotIndex := op.OpData.(int)
-	if slotIndex < 0 || slotIndex >= len(prc.locals) {
-		return nil
+	orig, err := prc.loadVariable(slotIndex)
+	if err != nil {
+		return err
 	}
-	orig := prc.locals[slotIndex]
-	prc.locals[slotIndex] = incrementValue(orig)
-	err = prc.push(orig)
-	return
+	storeVariable(prc, slotIndex, incrementValue(orig))
+	return prc.push(orig)
 }
 
 func PostDecrementOperation(prc *Process, op *OpCode) (err error) {
 	slotIndex := op.OpData.(int)
-	if slotIndex < 0 || slotIndex >= len(prc.locals) {
-		return nil
+	orig, err := prc.loadVariable(slotIndex)
+	if err != nil {
+		return err
 	}
-	orig := prc.locals[slotIndex]
-	prc.locals[slotIndex] = decrementValue(orig)
-	err = prc.push(orig)
-	return
+	storeVariable(prc, slotIndex, decrementValue(orig))
+	return prc.push(orig)
 }
 
 // These are much more complicated because of the different reference types
@@ -1278,6 +1922,15 @@ func PreIncrementPropertyOperation(prc *Process, op *OpCode) (err error) {
 		orig := tgt.Get(propName)
 		val = incrementValue(orig)
 		tgt.Set(propName, val)
+	case types.HostObject:
+		orig, err := tgt.Get(propName)
+		if err != nil {
+			return types.HostError(prc, err)
+		}
+		val = incrementValue(orig)
+		if err := tgt.Set(propName, val); err != nil {
+			return types.HostError(prc, err)
+		}
 	default:
 		val = types.NaN
 	}
@@ -1299,6 +1952,13 @@ func PostIncrementPropertyOperation(prc *Process, op *OpCode) (err error) {
 		orig = tgt.Get(propName)
 		val := incrementValue(orig)
 		tgt.Set(propName, val)
+	case types.HostObject:
+		if orig, err = tgt.Get(propName); err != nil {
+			return types.HostError(prc, err)
+		}
+		if err := tgt.Set(propName, incrementValue(orig)); err != nil {
+			return types.HostError(prc, err)
+		}
 	default:
 		orig = types.NaN
 	}
@@ -1320,6 +1980,15 @@ func PreDecrementPropertyOperation(prc *Process, op *OpCode) (err error) {
 		orig := tgt.Get(propName)
 		val = decrementValue(orig)
 		tgt.Set(propName, val)
+	case types.HostObject:
+		orig, err := tgt.Get(propName)
+		if err != nil {
+			return types.HostError(prc, err)
+		}
+		val = decrementValue(orig)
+		if err := tgt.Set(propName, val); err != nil {
+			return types.HostError(prc, err)
+		}
 	default:
 		val = types.NaN
 	}
@@ -1341,6 +2010,13 @@ func PostDecrementPropertyOperation(prc *Process, op *OpCode) (err error) {
 		orig = tgt.Get(propName)
 		val := decrementValue(orig)
 		tgt.Set(propName, val)
+	case types.HostObject:
+		if orig, err = tgt.Get(propName); err != nil {
+			return types.HostError(prc, err)
+		}
+		if err := tgt.Set(propName, decrementValue(orig)); err != nil {
+			return types.HostError(prc, err)
+		}
 	default:
 		orig = types.NaN
 	}
@@ -1366,19 +2042,36 @@ func TypeofOperation(prc *Process, op *OpCode) (err error) {
 		result = "boolean"
 	case types.IntegerType, types.NumberType:
 		result = "number"
+	case types.BigIntType:
+		result = "bigint"
 	case types.StringType:
 		result = "string"
-	case *ScriptFunction, *types.NativeFunction:
-		result = "function"
-	case *types.ArrayType, *types.ObjectType:
+	case *types.SymbolType:
+		result = "symbol"
+	case *types.ArrayType, *types.ObjectType, *types.PromiseType:
 		result = "object"
+	case types.HostObject:
+		result = types.HostTypeName(val.(types.HostObject))
+	case types.FunctionType:
+		result = "function"
 	default:
-		result = "undefined"
+		// Other (host) types, as defined by the registered constructor
+		result = prc.hostTypeOf(val)
 	}
 
 	return prc.push(types.StringType(result))
 }
 
+// Determine the typeof result for a non-builtin value from the constructors
+func (prc *Process) hostTypeOf(val types.DataType) string {
+	for _, nc := range prc.constructors {
+		if nc.TypeOf != "" && nc.IsInstance != nil && nc.IsInstance(val) {
+			return nc.TypeOf
+		}
+	}
+	return "object"
+}
+
 func InstanceofOperation(prc *Process, op *OpCode) (err error) {
 	constructor, err := prc.pop()
 	if err != nil {
@@ -1389,44 +2082,54 @@ func InstanceofOperation(prc *Process, op *OpCode) (err error) {
 		return err
 	}
 
-	// Get the constructor name for comparison
-	var constructorName string
-	switch c := constructor.(type) {
-	case *types.NativeConstructor:
-		constructorName = c.Name
-	case *types.NativeFunction:
-		constructorName = c.Name
-	case *ScriptFunction:
-		constructorName = c.Name
-	default:
-		// Not a function, instanceof is false
-		return prc.push(types.BooleanType(false))
+	// Per Section 13.10.2, Symbol.hasInstance overrides the default check
+	hasInstance := prc.getSymbolMember(constructor, types.SymbolHasInstance)
+	if fn, ok := hasInstance.(types.FunctionType); ok {
+		res, err := types.CallMethod(prc, fn, constructor,
+			[]types.DataType{obj})
+		if err != nil {
+			return err
+		}
+		return prc.push(types.BooleanType(types.IsTruthy(res)))
 	}
 
-	// Check object type against constructor name
-	var result bool
-	switch constructorName {
-	case "Function":
-		_, isScript := obj.(*ScriptFunction)
-		_, isNative := obj.(*types.NativeFunction)
-		result = isScript || isNative
-	case "Array":
-		_, result = obj.(*types.ArrayType)
-	case "Object":
-		_, result = obj.(*types.ObjectType)
-	case "Number":
-		_, isInt := obj.(types.IntegerType)
-		_, isNum := obj.(types.NumberType)
-		result = isInt || isNum
-	case "String":
-		_, result = obj.(types.StringType)
-	case "Boolean":
-		_, result = obj.(types.BooleanType)
-	default:
-		result = false
+	if !isCallable(constructor) {
+		return types.ThrowError(prc, "TypeError",
+			"Right-hand side of 'instanceof' is not callable")
+	}
+
+	// Proxies follow the (trapped) prototype chain of the proxy
+	if pxy, ok := obj.(*Proxy); ok {
+		proto, err := prc.proxyGetPrototypeOf(pxy)
+		if err != nil {
+			return err
+		}
+		inst, ok := proto.(*types.ObjectType)
+		if sfn, isFn := constructor.(*ScriptFunction); ok && isFn &&
+			sfn.IsConstructor() {
+			return prc.push(types.BooleanType(inst == sfn.Prototype() ||
+				inst.InheritsFrom(sfn.Prototype())))
+		}
 	}
+	return prc.push(types.BooleanType(isInstance(obj, constructor)))
+}
 
-	return prc.push(types.BooleanType(result))
+// The default instanceof check (OrdinaryHasInstance), native constructors use
+// their brand check and script functions the prototype chain
+func isInstance(obj types.DataType, constructor types.DataType) bool {
+	switch ctor := constructor.(type) {
+	case *types.NativeConstructor:
+		return ctor.IsInstance != nil && ctor.IsInstance(obj)
+	case *BoundFunction:
+		return isInstance(obj, ctor.Target)
+	case *Proxy:
+		return isInstance(obj, ctor.target)
+	case *ScriptFunction:
+		inst, ok := obj.(*types.ObjectType)
+		return ok && ctor.IsConstructor() &&
+			inst.InheritsFrom(ctor.Prototype())
+	}
+	return false
 }
 
 func NewArrayOperation(prc *Process, op *OpCode) (err error) {
@@ -1456,9 +2159,13 @@ func NewArrayOperation(prc *Process, op *OpCode) (err error) {
 		elements = make([]types.DataType, 0, count)
 		for idx, entry := range rawElmnts {
 			if idx < len(spreadMask) && spreadMask[idx] {
-				if arr, ok := entry.(*types.ArrayType); ok {
+				vals, ok, err := prc.spreadValues(entry)
+				if err != nil {
+					return err
+				}
+				if ok {
 					// If an array, expand the elements into arguments
-					elements = append(elements, arr.Elements...)
+					elements = append(elements, vals...)
 				} else {
 					elements = append(elements, entry)
 				}
@@ -1478,21 +2185,66 @@ func NewArrayOperation(prc *Process, op *OpCode) (err error) {
 }
 
 func NewObjectOperation(prc *Process, op *OpCode) (err error) {
-	keys := op.OpData.([]string)
-	obj := types.NewObject()
+	info, ok := op.OpData.(ObjectSpreadInfo)
+	if !ok {
+		keys := op.OpData.([]string)
+		if prc.sp < len(keys) {
+			return ErrStackUnderflow
+		}
 
-	// Elements are on stack in reverse order
-	for idx := len(keys) - 1; idx >= 0; idx-- {
-		val, err := prc.pop()
-		if err != nil {
+		// Elements are on stack in definition order, later duplicates win
+		base := prc.sp - len(keys)
+		obj := types.NewObject()
+		for idx, key := range keys {
+			obj.Set(key, prc.stack[base+idx])
+		}
+		prc.sp = base
+
+		res := types.DataType(obj)
+		err = prc.push(res)
+		return
+	}
+
+	// Later entries replace earlier ones, so pop all (with computed keys)
+	count := len(info.Keys)
+	for _, computed := range info.Computed {
+		if computed {
+			count++
+		}
+	}
+	vals := make([]types.DataType, count)
+	for idx := count - 1; idx >= 0; idx-- {
+		if vals[idx], err = prc.pop(); err != nil {
 			return err
 		}
-		obj.Set(keys[idx], val)
 	}
 
-	res := types.DataType(obj)
-	err = prc.push(res)
-	return
+	obj := types.NewObject()
+	pos := 0
+	for idx, key := range info.Keys {
+		switch {
+		case info.SpreadMask[idx]:
+			if err := prc.copyDataProperties(obj, vals[pos]); err != nil {
+				return err
+			}
+		case info.Computed[idx]:
+			pkey, err := types.ToPropertyKey(prc, vals[pos])
+			if err != nil {
+				return err
+			}
+			pos++
+			if sym, ok := pkey.(*types.SymbolType); ok {
+				obj.SetSymbol(sym, vals[pos])
+			} else {
+				obj.Set(string(pkey.(types.StringType)), vals[pos])
+			}
+		default:
+			obj.Set(key, vals[pos])
+		}
+		pos++
+	}
+
+	return prc.push(obj)
 }
 
 func GetElementOperation(prc *Process, op *OpCode) (err error) {
@@ -1505,13 +2257,58 @@ func GetElementOperation(prc *Process, op *OpCode) (err error) {
 		return err
 	}
 
+	res, err := prc.getElement(target, index)
+	if err != nil {
+		return err
+	}
+	return prc.push(res)
+}
+
+// Retrieve the element for a computed method call (o[k]()), retaining the
+// target on the stack for the 'this' binding
+func GetMethodElementOperation(prc *Process, op *OpCode) (err error) {
+	index, err := prc.pop()
+	if err != nil {
+		return err
+	}
+	target, err := prc.peek()
+	if err != nil {
+		return err
+	}
+
+	res, err := prc.getElement(target, index)
+	if err != nil {
+		return err
+	}
+	return prc.push(res)
+}
+
+// Retrieve the element/property of the target (for the operations and the
+// Reflect/Proxy defaults)
+func (prc *Process) getElement(target,
+	index types.DataType) (res types.DataType, err error) {
+	// Symbol-keyed access is distinct from the string/index handling below
+	if sym, ok := index.(*types.SymbolType); ok {
+		if pxy, ok := target.(*Proxy); ok {
+			return prc.proxyGet(pxy, sym)
+		}
+		return prc.getSymbolMember(target, sym), nil
+	}
+
 	// Handle element/property access depending on target type
-	var res types.DataType
 	switch tgt := target.(type) {
+	case *Proxy:
+		return prc.proxyGet(tgt, types.StringType(types.ToString(index)))
 	case *types.NativeConstructor:
 		// Access static methods/properties on the constructor
 		propName := types.ToString(index)
 		res = tgt.Get(propName)
+	case types.HostObject:
+		if res, err = tgt.Get(types.ToString(index)); err != nil {
+			return nil, types.HostError(prc, err)
+		}
+	case *ScriptFunction:
+		res = prc.functionMember(tgt, types.ToString(index))
 	case *types.ArrayType:
 		switch ix := index.(type) {
 		case types.IntegerType:
@@ -1544,9 +2341,7 @@ func GetElementOperation(prc *Process, op *OpCode) (err error) {
 		case types.IntegerType:
 			propName = fmt.Sprintf("%d", ix)
 		default:
-			res = types.Undefined
-			err = prc.push(res)
-			return
+			return types.Undefined, nil
 		}
 		// Get the property directly or fall back to instance members
 		res = tgt.Get(propName)
@@ -1556,21 +2351,22 @@ func GetElementOperation(prc *Process, op *OpCode) (err error) {
 				res = member
 			}
 		}
+	case *types.TypedArrayType:
+		if idx, ok := elementIndex(index); ok {
+			res = tgt.Get(idx)
+		} else if member := prc.resolveInstanceMember(tgt,
+			types.ToString(index)); member != nil {
+			res = member
+		} else {
+			res = types.Undefined
+		}
 	case types.StringType:
 		switch ix := index.(type) {
-		case types.IntegerType:
-			str := string(tgt)
-			idx := int(ix)
-			if idx >= 0 && idx < len(str) {
-				res = types.StringType(str[idx : idx+1])
-			} else {
-				res = types.Undefined
-			}
-		case types.NumberType:
-			str := string(tgt)
-			idx := int(ix)
-			if idx >= 0 && idx < len(str) {
-				res = types.StringType(str[idx : idx+1])
+		case types.IntegerType, types.NumberType:
+			// Strings are indexed by UTF-16 code unit
+			idx, _ := elementIndex(ix)
+			if unit, ok := prc.units.CodeUnitAt(string(tgt), idx); ok {
+				res = types.StringType(types.FromUTF16([]uint16{unit}))
 			} else {
 				res = types.Undefined
 			}
@@ -1596,9 +2392,7 @@ func GetElementOperation(prc *Process, op *OpCode) (err error) {
 			res = types.Undefined
 		}
 	}
-
-	err = prc.push(res)
-	return
+	return res, nil
 }
 
 func SetElementOperation(prc *Process, op *OpCode) (err error) {
@@ -1615,6 +2409,42 @@ func SetElementOperation(prc *Process, op *OpCode) (err error) {
 		return err
 	}
 
+	if _, err := prc.setElement(target, index, val); err != nil {
+		return err
+	}
+
+	// Push the value back onto the stack (residual from assignment)
+	return prc.push(val)
+}
+
+// Assign the element/property of the target, false if the assignment was
+// rejected (frozen, or by a proxy)
+func (prc *Process) setElement(target, index,
+	val types.DataType) (bool, error) {
+	if pxy, ok := target.(*Proxy); ok {
+		key := propertyKey(index)
+		done, err := prc.proxySet(pxy, key, val)
+		if err == nil && !done {
+			err = prc.proxyRejected("set", key)
+		}
+		return done, err
+	}
+
+	// Symbol-keyed properties are only supported on objects
+	if sym, ok := index.(*types.SymbolType); ok {
+		obj, ok := target.(*types.ObjectType)
+		if !ok {
+			return false, nil
+		}
+		if obj.Frozen {
+			err := prc.readOnlyWrite("Cannot assign to symbol " +
+				"property of frozen object")
+			return false, err
+		}
+		obj.SetSymbol(sym, val)
+		return true, nil
+	}
+
 	switch tgt := target.(type) {
 	case *types.ArrayType:
 		var idx int
@@ -1624,6 +2454,10 @@ func SetElementOperation(prc *Process, op *OpCode) (err error) {
 		case types.NumberType:
 			idx = int(ix)
 		}
+		if tgt.Frozen {
+			return false, prc.readOnlyWrite("Cannot assign to read only " +
+				"property '" + strconv.Itoa(idx) + "' of array")
+		}
 		tgt.Set(idx, val)
 	case *types.ObjectType:
 		var propName string
@@ -1633,12 +2467,24 @@ func SetElementOperation(prc *Process, op *OpCode) (err error) {
 		case types.IntegerType:
 			propName = fmt.Sprintf("%d", ix)
 		}
+		if tgt.Frozen {
+			return false, prc.frozenWrite(tgt, propName)
+		}
 		tgt.Set(propName, val)
+	case *types.TypedArrayType:
+		if idx, ok := elementIndex(index); ok {
+			if err := tgt.Set(idx, val); err != nil {
+				return false, types.HostError(prc, err)
+			}
+		}
+	case types.HostObject:
+		if err := tgt.Set(types.ToString(index), val); err != nil {
+			return false, types.HostError(prc, err)
+		}
+	default:
+		return false, nil
 	}
-
-	// Push the value back onto the stack (residual from assignment)
-	err = prc.push(val)
-	return
+	return true, nil
 }
 
 func DeleteElementOperation(prc *Process, op *OpCode) (err error) {
@@ -1646,12 +2492,28 @@ func DeleteElementOperation(prc *Process, op *OpCode) (err error) {
 	if err != nil {
 		return err
 	}
-	target, err := prc.pop()
+	target, err := prc.pop()
+	if err != nil {
+		return err
+	}
+
+	deleted, err := prc.deleteElement(target, index)
 	if err != nil {
 		return err
 	}
+	return prc.push(types.BooleanType(deleted))
+}
 
+// Remove the element/property of the target, false if it cannot be deleted
+func (prc *Process) deleteElement(target, index types.DataType) (bool, error) {
 	switch tgt := target.(type) {
+	case *Proxy:
+		key := propertyKey(index)
+		deleted, err := prc.proxyDelete(tgt, key)
+		if err == nil && !deleted {
+			err = prc.proxyRejected("deleteProperty", key)
+		}
+		return deleted, err
 	case *types.ArrayType:
 		var idx int
 		switch ix := index.(type) {
@@ -1660,19 +2522,37 @@ func DeleteElementOperation(prc *Process, op *OpCode) (err error) {
 		case types.NumberType:
 			idx = int(ix)
 		default:
-			return prc.push(types.BooleanType(false))
+			return false, nil
 		}
 		if idx >= 0 && idx < len(tgt.Elements) {
+			if tgt.Frozen {
+				return false, prc.frozenDelete(strconv.Itoa(idx))
+			}
 			tgt.Elements[idx] = types.Undefined
-			return prc.push(types.BooleanType(true))
+			return true, nil
 		}
 	case *types.ObjectType:
+		if sym, ok := index.(*types.SymbolType); ok {
+			if tgt.Frozen && tgt.HasSymbol(sym) {
+				return false, prc.frozenDelete(sym.String())
+			}
+			tgt.DeleteSymbol(sym)
+			return true, nil
+		}
 		propName := types.ToString(index)
+		if tgt.Frozen && tgt.Has(propName) {
+			return false, prc.frozenDelete(propName)
+		}
 		delete(tgt.Properties, propName)
-		return prc.push(types.BooleanType(true))
+		return true, nil
+	case types.HostObject:
+		deleted, err := tgt.Delete(types.ToString(index))
+		if err != nil {
+			return false, types.HostError(prc, err)
+		}
+		return deleted, nil
 	}
-
-	return prc.push(types.BooleanType(false))
+	return false, nil
 }
 
 func InOperation(prc *Process, op *OpCode) (err error) {
@@ -1685,12 +2565,28 @@ func InOperation(prc *Process, op *OpCode) (err error) {
 		return err
 	}
 
+	exists, err := prc.hasProperty(obj, prop)
+	if err != nil {
+		return err
+	}
+	return prc.push(types.BooleanType(exists))
+}
+
+// Determine if the target has the property (or element)
+func (prc *Process) hasProperty(obj, prop types.DataType) (bool, error) {
 	propName := types.ToString(prop)
 
 	switch tgt := obj.(type) {
+	case *Proxy:
+		return prc.proxyHas(tgt, propertyKey(prop))
 	case *types.ObjectType:
+		if sym, ok := prop.(*types.SymbolType); ok {
+			return tgt.HasSymbol(sym), nil
+		}
 		_, exists := tgt.Properties[propName]
-		return prc.push(types.BooleanType(exists))
+		return exists, nil
+	case types.HostObject:
+		return tgt.Has(propName), nil
 	case *types.ArrayType:
 		// For arrays, check if index exists
 		var idx int
@@ -1700,13 +2596,14 @@ func InOperation(prc *Process, op *OpCode) (err error) {
 		case types.NumberType:
 			idx = int(ix)
 		default:
-			return prc.push(types.BooleanType(false))
+			return false, nil
 		}
-		exists := idx >= 0 && idx < len(tgt.Elements)
-		return prc.push(types.BooleanType(exists))
+		return idx >= 0 && idx < len(tgt.Elements), nil
+	case *types.TypedArrayType:
+		idx, ok := elementIndex(prop)
+		return ok && idx >= 0 && idx < tgt.Length(), nil
 	}
-
-	return prc.push(types.BooleanType(false))
+	return false, nil
 }
 
 func GetPropertyOperation(prc *Process, op *OpCode) (err error) {
@@ -1718,9 +2615,29 @@ func GetPropertyOperation(prc *Process, op *OpCode) (err error) {
 
 	var res types.DataType
 	switch tgt := target.(type) {
+	case *Proxy:
+		res, err = prc.proxyGet(tgt, types.StringType(propName))
+		if err != nil {
+			return err
+		}
 	case *types.NativeConstructor:
 		// Access static methods/properties on the constructor
 		res = tgt.Get(propName)
+	case types.HostObject:
+		// Host objects define their own properties (no member resolution)
+		if res, err = tgt.Get(propName); err != nil {
+			return types.HostError(prc, err)
+		}
+	case *ScriptFunction:
+		res = prc.functionMember(tgt, propName)
+	case types.StringType:
+		// Length is cached for repeated (loop) access, as for indexing
+		if propName == "length" {
+			res = types.IntegerType(prc.units.UTF16Length(string(tgt)))
+		} else if res = prc.resolveInstanceMember(tgt,
+			propName); res == nil {
+			res = types.Undefined
+		}
 	case *types.ObjectType:
 		// First check object's own properties
 		res = tgt.Get(propName)
@@ -1745,6 +2662,19 @@ func GetPropertyOperation(prc *Process, op *OpCode) (err error) {
 	return
 }
 
+// Script functions have the prototype property (if constructible), other
+// members are the standard function members
+func (prc *Process) functionMember(fn *ScriptFunction,
+	propName string) types.DataType {
+	if propName == "prototype" && fn.IsConstructor() {
+		return fn.Prototype()
+	}
+	if member := prc.resolveInstanceMember(fn, propName); member != nil {
+		return member
+	}
+	return types.Undefined
+}
+
 // Shared method to resolve instance property/methods by property name
 func (prc *Process) resolveInstanceMember(target types.DataType,
 	propName string) types.DataType {
@@ -1761,6 +2691,35 @@ func (prc *Process) resolveInstanceMember(target types.DataType,
 	return nil
 }
 
+// Retrieve a symbol-keyed member, the well-known symbols for the native types
+// are resolved against the instance members by spec name (e.g. "@@iterator")
+func (prc *Process) getSymbolMember(target types.DataType,
+	sym *types.SymbolType) types.DataType {
+	if obj, ok := target.(*types.ObjectType); ok {
+		for ; obj != nil; obj = obj.Proto {
+			if val, ok := obj.Symbols[sym]; ok {
+				return val
+			}
+		}
+	}
+	if host, ok := target.(types.HostSymbolObject); ok {
+		if val := host.GetSymbol(sym); val != types.Undefined {
+			return val
+		}
+	}
+	if name := types.WellKnownName(sym); name != "" {
+		if nc, ok := target.(*types.NativeConstructor); ok {
+			if val := nc.Get(name); val != types.Undefined {
+				return val
+			}
+		}
+		if member := prc.resolveInstanceMember(target, name); member != nil {
+			return member
+		}
+	}
+	return types.Undefined
+}
+
 func SetPropertyOperation(prc *Process, op *OpCode) (err error) {
 	propName := op.OpData.(string)
 	val, err := prc.pop()
@@ -1773,8 +2732,35 @@ func SetPropertyOperation(prc *Process, op *OpCode) (err error) {
 	}
 
 	switch tgt := target.(type) {
+	case *Proxy:
+		if _, err := prc.setElement(tgt, types.StringType(propName),
+			val); err != nil {
+			return err
+		}
 	case *types.ObjectType:
+		if tgt.Frozen {
+			if err := prc.frozenWrite(tgt, propName); err != nil {
+				return err
+			}
+		}
 		tgt.Set(propName, val)
+	case *types.ArrayType:
+		if tgt.Frozen {
+			if err := prc.readOnlyWrite("Cannot assign to read only " +
+				"property '" + propName + "' of array"); err != nil {
+				return err
+			}
+		}
+	case types.HostObject:
+		if err := tgt.Set(propName, val); err != nil {
+			return types.HostError(prc, err)
+		}
+	case *ScriptFunction:
+		// Only the prototype can be replaced (no other function properties)
+		if proto, ok := val.(*types.ObjectType); ok &&
+			propName == "prototype" && tgt.IsConstructor() {
+			tgt.SetPrototype(proto)
+		}
 	}
 
 	// Push the value back onto the stack (residual from assignment)
@@ -1789,11 +2775,31 @@ func DeletePropertyOperation(prc *Process, op *OpCode) (err error) {
 		return err
 	}
 
-	// Property delete only works on objects
+	// Property delete only works on objects (and host objects)
+	if pxy, ok := obj.(*Proxy); ok {
+		deleted, err := prc.deleteElement(pxy, types.StringType(propName))
+		if err != nil {
+			return err
+		}
+		return prc.push(types.BooleanType(deleted))
+	}
 	if objVal, ok := obj.(*types.ObjectType); ok {
+		if objVal.Frozen && objVal.Has(propName) {
+			if err := prc.frozenDelete(propName); err != nil {
+				return err
+			}
+			return prc.push(types.BooleanType(false))
+		}
 		delete(objVal.Properties, propName)
 		return prc.push(types.BooleanType(true))
 	}
+	if hostObj, ok := obj.(types.HostObject); ok {
+		deleted, err := hostObj.Delete(propName)
+		if err != nil {
+			return types.HostError(prc, err)
+		}
+		return prc.push(types.BooleanType(deleted))
+	}
 
 	// Non-object delete returns true (no-op)
 	return prc.push(types.BooleanType(true))
@@ -1802,6 +2808,17 @@ func DeletePropertyOperation(prc *Process, op *OpCode) (err error) {
 func LoadGlobalOperation(prc *Process, op *OpCode) (err error) {
 	name := op.OpData.(string)
 
+	// Implicit scope object (from the host) takes precedence
+	if prc.scope != nil {
+		val, found, err := prc.scopeLookup(prc.scope, name)
+		if err != nil {
+			return err
+		}
+		if found {
+			return prc.push(val)
+		}
+	}
+
 	// Check script globals first (script-defined functions)
 	if prc.globals != nil {
 		if val, ok := prc.globals[name]; ok {
@@ -1816,7 +2833,10 @@ func LoadGlobalOperation(prc *Process, op *OpCode) (err error) {
 		}
 	}
 
-	// Not found in either, clearly undefined...
+	// Not found in either, clearly undefined (other than the global object)
+	if name == "globalThis" {
+		return prc.push(prc.globalThis())
+	}
 	return prc.push(types.Undefined)
 }
 
@@ -1835,6 +2855,70 @@ func StoreGlobalOperation(prc *Process, op *OpCode) (err error) {
 	return
 }
 
+// Global properties that cannot be assigned (silently ignored unless strict)
+var readOnlyGlobals = map[string]bool{
+	"NaN": true, "Infinity": true, "undefined": true,
+}
+
+// Assignment to an undeclared (global) identifier, which creates the global
+// for non-strict code but requires an existing binding for strict code
+func AssignGlobalOperation(prc *Process, op *OpCode) (err error) {
+	name := op.OpData.(string)
+	val, err := prc.peek()
+	if err != nil {
+		return err
+	}
+
+	if prc.scope != nil {
+		found, err := prc.scopeAssign(prc.scope, name, val)
+		if found || err != nil {
+			return err
+		}
+	}
+	if readOnlyGlobals[name] {
+		return prc.readOnlyWrite("Cannot assign to read only property '" +
+			name + "' of object")
+	}
+	if prc.body.Strict {
+		_, isGlobal := prc.globals[name]
+		_, isNative := prc.natives[name]
+		if !isGlobal && !isNative && name != "globalThis" {
+			return types.ThrowError(prc, "ReferenceError",
+				name+" is not defined")
+		}
+	}
+
+	if prc.globals == nil {
+		prc.globals = make(map[string]types.DataType)
+	}
+	prc.globals[name] = val
+	return
+}
+
+// Writes to read-only (frozen) values are ignored, but fail for strict code
+func (prc *Process) readOnlyWrite(msg string) error {
+	if prc.body != nil && prc.body.Strict {
+		return types.ThrowError(prc, "TypeError", msg)
+	}
+	return nil
+}
+
+// Likewise for the deletion of a frozen property (false result if sloppy)
+func (prc *Process) frozenDelete(propName string) error {
+	return prc.readOnlyWrite("Cannot delete property '" + propName +
+		"' of frozen object")
+}
+
+// Common message for the rejected write to a frozen object
+func (prc *Process) frozenWrite(obj *types.ObjectType, propName string) error {
+	if obj.Has(propName) {
+		return prc.readOnlyWrite("Cannot assign to read only property '" +
+			propName + "' of object")
+	}
+	return prc.readOnlyWrite("Cannot add property '" + propName +
+		"', object is not extensible")
+}
+
 // Common method to extract call arguments from stack, handling spread
 func extractCallArgs(prc *Process, op *OpCode) ([]types.DataType, error) {
 	var count int
@@ -1845,6 +2929,9 @@ func extractCallArgs(prc *Process, op *OpCode) ([]types.DataType, error) {
 	case CallSpreadInfo:
 		count = info.ArgCount
 		spreadMask = info.SpreadMask
+	case DirectEvalInfo:
+		count = info.ArgCount
+		spreadMask = info.SpreadMask
 	}
 
 	// Pop elements from stack in reverse order
@@ -1864,9 +2951,13 @@ func extractCallArgs(prc *Process, op *OpCode) ([]types.DataType, error) {
 	args := make([]types.DataType, 0, count)
 	for idx, arg := range rawArgs {
 		if idx < len(spreadMask) && spreadMask[idx] {
-			if arr, ok := arg.(*types.ArrayType); ok {
+			vals, ok, err := prc.spreadValues(arg)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
 				// If an array, expand the elements into arguments
-				args = append(args, arr.Elements...)
+				args = append(args, vals...)
 			} else {
 				args = append(args, arg)
 			}
@@ -1922,7 +3013,7 @@ func setupScriptCall(prc *Process, fn *ScriptFunction,
 	}
 
 	// Use the shared function to handle argument binding
-	bindFunctionParams(prc.locals, fn, thisVal, args)
+	prc.bindFunctionParams(prc.locals, fn, thisVal, args)
 }
 
 func CallOperation(prc *Process, op *OpCode) (err error) {
@@ -1985,15 +3076,112 @@ func callFunctionWithThis(prc *Process, fnVal types.DataType,
 			append(fn.BoundArgs, args...))
 
 	case *ScriptFunction:
+		// Async functions run independently, the result is a promise
+		if fn.IsAsync {
+			return prc.push(fn.callAsync(prc, thisVal, args))
+		}
+
 		// Split out for tidiness, setup and execute new frame in current proc
 		setupScriptCall(prc, fn, thisVal, args)
 		return nil
 
+	case *Proxy:
+		res, err := prc.proxyApply(fn, thisVal, args)
+		return pushCallResult(prc, res, err)
+
+	case types.HostCallable:
+		res, err := fn.Call(prc, args)
+		if err != nil {
+			return types.HostError(prc, err)
+		}
+		return pushCallResult(prc, res, nil)
+
 	default:
 		return fmt.Errorf("TypeError: %v is not a function", fnVal)
 	}
 }
 
+// Construct a new instance from the constructor and arguments on the stack
+func NewOperation(prc *Process, op *OpCode) (err error) {
+	args, err := extractCallArgs(prc, op)
+	if err != nil {
+		return err
+	}
+
+	ctorVal, err := prc.pop()
+	if err != nil {
+		return err
+	}
+
+	return prc.construct(ctorVal, args)
+}
+
+// Split out to allow for bound function recursion
+func (prc *Process) construct(ctorVal types.DataType,
+	args []types.DataType) error {
+	res, err := prc.newInstance(ctorVal, args)
+	return pushCallResult(prc, res, err)
+}
+
+// Create the instance from the constructor (also Reflect.construct)
+func (prc *Process) newInstance(ctorVal types.DataType,
+	args []types.DataType) (types.DataType, error) {
+	switch ctor := ctorVal.(type) {
+	case *types.NativeConstructor:
+		outer := prc.constructing
+		prc.constructing = ctor
+		defer func() { prc.constructing = outer }()
+		return ctor.Call(prc, args)
+
+	case *BoundFunction:
+		return prc.newInstance(ctor.Target, append(ctor.BoundArgs, args...))
+
+	case *Proxy:
+		return prc.proxyConstruct(ctor, args)
+
+	case *ScriptFunction:
+		if !ctor.IsConstructor() {
+			return nil, fmt.Errorf("TypeError: %s is not a constructor",
+				ctor.Name)
+		}
+
+		// Script function constructs against a new object, unless returned
+		thisObj := types.NewObject()
+		thisObj.Proto = ctor.Prototype()
+		res, err := ctor.CallWithThis(prc, thisObj, args)
+		if err != nil {
+			return nil, err
+		}
+		switch res.(type) {
+		case *types.ObjectType, *types.ArrayType:
+			return res, nil
+		}
+		return thisObj, nil
+	}
+
+	return nil, fmt.Errorf("TypeError: %s is not a constructor",
+		types.ToString(ctorVal))
+}
+
+// Suspend the running async task until the awaited value is settled
+func AwaitOperation(prc *Process, op *OpCode) (err error) {
+	if prc.asyncResult == nil {
+		return fmt.Errorf("SyntaxError: await is only valid in async functions")
+	}
+	val, err := prc.pop()
+	if err != nil {
+		return err
+	}
+
+	// Resume (through a job) once the promise settles
+	types.PromiseResolve(prc, val).OnSettled(prc,
+		func(jprc types.Process, res types.DataType, rejected bool) error {
+			prc.continueTask(res, rejected)
+			return nil
+		})
+	return errSuspend
+}
+
 func ReturnOperation(prc *Process, op *OpCode) (err error) {
 	var retVal types.DataType
 	if op.OpData.(bool) {
@@ -2014,24 +3202,13 @@ func ReturnOperation(prc *Process, op *OpCode) (err error) {
 		return
 	}
 
-	// Restore previous execution context from the call stack
-	frame := prc.callStack
-	prc.callStack = frame.previous
-	prc.locals = frame.locals
-	prc.cells = frame.cells
-	prc.closure = frame.closure
-
-	// Immediate return for native frame marker (null body)
-	if frame.body == nil {
-		prc.sp = frame.sp
-		prc.pc = -1
-		err = prc.push(retVal)
-		return
+	// Discard any try contexts that were active within the returning call
+	for prc.exceptionCtx != nil && prc.exceptionCtx.frame == prc.callStack {
+		prc.exceptionCtx = prc.exceptionCtx.previous
 	}
 
-	prc.body = frame.body
-	prc.sp = frame.sp
-	prc.pc = frame.pc
+	// Restore previous execution context from the call stack
+	prc.popFrame()
 
 	// Return value can now be pushed onto the prior call stack
 	err = prc.push(retVal)
@@ -2047,15 +3224,40 @@ func PushFunctionOperation(prc *Process, op *OpCode) (err error) {
 		return
 	}
 
-	// If the function has no closures, can use it directly
-	if len(sfn.Captures) == 0 {
-		err = prc.push(fnTemplate)
-		return
+	// Each evaluation is a distinct function object (with its own prototype),
+	// create from the template with any capture cells
+	closure := prc.captureCells(sfn.Captures)
+
+	// Clone the source function with the new capture instance
+	fnCopy := &ScriptFunction{
+		Name:          sfn.Name,
+		ParamNames:    sfn.ParamNames,
+		Body:          sfn.Body,
+		VarCount:      sfn.VarCount,
+		HasRestParam:  sfn.HasRestParam,
+		ArgumentsSlot: sfn.ArgumentsSlot,
+		ThisSlot:      sfn.ThisSlot,
+		IsArrowFunc:   sfn.IsArrowFunc,
+		IsAsync:       sfn.IsAsync,
+		Captures:      sfn.Captures,
+		Closure:       closure,
+		Source:        sfn.Source,
 	}
 
-	// Otherwise, create a function from the template with capture cells
-	closure := make([]*Cell, len(sfn.Captures))
-	for idx, cap := range sfn.Captures {
+	// And that is the value we push onto the stack
+	fnVal := types.DataType(fnCopy)
+	err = prc.push(fnVal)
+	return
+}
+
+// Resolve the cells for the captured variables, either from the current
+// closure or the (shared) cells of the local variables
+func (prc *Process) captureCells(captures []CaptureInfo) []*Cell {
+	if len(captures) == 0 {
+		return nil
+	}
+	closure := make([]*Cell, len(captures))
+	for idx, cap := range captures {
 		if cap.IsCapture {
 			if prc.closure != nil && cap.SlotIndex < len(prc.closure) {
 				// Capture from the current closure (already attached to a cell)
@@ -2085,25 +3287,7 @@ func PushFunctionOperation(prc *Process, op *OpCode) (err error) {
 			closure[idx] = prc.cells[cap.SlotIndex]
 		}
 	}
-
-	// Clone the source function with the new capture instance
-	fnCopy := &ScriptFunction{
-		Name:          sfn.Name,
-		ParamNames:    sfn.ParamNames,
-		Body:          sfn.Body,
-		VarCount:      sfn.VarCount,
-		HasRestParam:  sfn.HasRestParam,
-		ArgumentsSlot: sfn.ArgumentsSlot,
-		ThisSlot:      sfn.ThisSlot,
-		IsArrowFunc:   sfn.IsArrowFunc,
-		Captures:      sfn.Captures,
-		Closure:       closure,
-	}
-
-	// And that is the value we push onto the stack
-	fnVal := types.DataType(fnCopy)
-	err = prc.push(fnVal)
-	return
+	return closure
 }
 
 func LoadCaptureOperation(prc *Process, op *OpCode) (err error) {
@@ -2119,6 +3303,9 @@ func LoadCaptureOperation(prc *Process, op *OpCode) (err error) {
 		err = prc.push(types.Undefined)
 		return
 	}
+	if err := prc.checkInitialized(*cell.Value); err != nil {
+		return err
+	}
 	err = prc.push(*cell.Value)
 	return
 }
@@ -2147,6 +3334,11 @@ func StoreCaptureKeepOperation(prc *Process, op *OpCode) (err error) {
 		return err
 	}
 	if prc.closure != nil && capIdx < len(prc.closure) {
+		if cell := prc.closure[capIdx]; cell != nil && cell.Value != nil {
+			if err := prc.checkInitialized(*cell.Value); err != nil {
+				return err
+			}
+		}
 		// Store the value into the closure cell instance (create if first)
 		if prc.closure[capIdx] == nil {
 			prc.closure[capIdx] = &Cell{}
@@ -2164,16 +3356,27 @@ func ForInKeysOperation(prc *Process, op *OpCode) (err error) {
 
 	var keys []string
 	switch tgt := obj.(type) {
+	case *Proxy:
+		if keys, err = prc.proxyEnumerate(tgt); err != nil {
+			return err
+		}
 	case *types.ObjectType:
 		keys = make([]string, 0, len(tgt.Properties))
 		for key := range tgt.Properties {
 			keys = append(keys, key)
 		}
+	case types.HostObject:
+		keys = tgt.Keys()
 	case *types.ArrayType:
 		keys = make([]string, len(tgt.Elements))
 		for idx := range tgt.Elements {
 			keys[idx] = types.ToString(types.IntegerType(idx))
 		}
+	case *types.TypedArrayType:
+		keys = make([]string, tgt.Length())
+		for idx := range keys {
+			keys[idx] = types.ToString(types.IntegerType(idx))
+		}
 	default:
 		keys = []string{}
 	}
@@ -2243,7 +3446,7 @@ func ForInNextOperation(prc *Process, op *OpCode) (err error) {
 
 	// Store array entry to variable, increment iterator index
 	if idx < len(arr.Elements) {
-		prc.locals[slotIndex] = arr.Elements[idx]
+		storeVariable(prc, slotIndex, arr.Elements[idx])
 	}
 	return prc.push(types.IntegerType(idx + 1))
 }
@@ -2255,11 +3458,115 @@ func ForInCleanupOperation(prc *Process, op *OpCode) (err error) {
 	return nil
 }
 
+// Iteration state for values that implement the iterator protocol, where
+// the next results of an async iterator are awaited (result) by the loop
+type iteratorRecord struct {
+	iterator types.DataType
+	next     types.FunctionType
+	value    types.DataType
+	async    bool
+	result   types.DataType
+}
+
+func (ir *iteratorRecord) Native() interface{} {
+	return ir.iterator.Native()
+}
+func (ir *iteratorRecord) ToPrimitive(pref any) types.DataType {
+	return ir.iterator.ToPrimitive(pref)
+}
+
+// For await (OpData true) prefers Symbol.asyncIterator, falling back to the
+// (sync) Symbol.iterator, where the loop awaits the values instead
 func ForOfIteratorOperation(prc *Process, op *OpCode) (err error) {
-	// So much easier than above, already have the iterator instance
+	iterable, err := prc.peek()
+	if err != nil {
+		return err
+	}
+
+	// So much easier than above, arrays and strings are directly indexed
+	switch iterable.(type) {
+	case *types.ArrayType, types.StringType:
+		return prc.push(types.IntegerType(0))
+	}
+
+	// Otherwise, replace with the iterator from the iterator symbol method
+	async, _ := op.OpData.(bool)
+	symbol := types.SymbolIterator
+	var method types.FunctionType
+	ok := false
+	if async {
+		method, ok = prc.getSymbolMember(iterable,
+			types.SymbolAsyncIterator).(types.FunctionType)
+		if ok {
+			symbol = types.SymbolAsyncIterator
+		}
+	}
+	if !ok {
+		method, ok = prc.getSymbolMember(iterable,
+			types.SymbolIterator).(types.FunctionType)
+		async = false
+	}
+	if ok {
+		iter, err := types.CallMethod(prc, method, iterable, nil)
+		if err != nil {
+			return err
+		}
+		var next types.DataType = types.Undefined
+		if obj, ok := iter.(*types.ObjectType); ok {
+			next = obj.Get("next")
+		}
+		nextFn, ok := next.(types.FunctionType)
+		if !ok {
+			return types.ThrowError(prc, "TypeError", "Result of the "+
+				symbol.String()+" method is not an iterator")
+		}
+		prc.pop()
+		prc.push(&iteratorRecord{iterator: iter, next: nextFn, async: async})
+	}
 	return prc.push(types.IntegerType(0))
 }
 
+// Obtain the iterable (or iterator record) below the iteration index
+func (prc *Process) forOfIterable() (types.DataType, error) {
+	if prc.sp < 2 {
+		return nil, ErrStackUnderflow
+	}
+	return prc.stack[prc.sp-2], nil
+}
+
+// For await, push the (promise) result of the next method of an async
+// iterator for the loop to await (undefined for other iterables)
+func ForOfAsyncNextOperation(prc *Process, op *OpCode) (err error) {
+	iterable, err := prc.forOfIterable()
+	if err != nil {
+		return err
+	}
+	if it, ok := iterable.(*iteratorRecord); ok && it.async {
+		res, err := types.CallMethod(prc, it.next, it.iterator, nil)
+		if err != nil {
+			return err
+		}
+		return prc.push(res)
+	}
+	return prc.push(types.Undefined)
+}
+
+// And record the awaited result for the following has more check
+func ForOfAsyncResultOperation(prc *Process, op *OpCode) (err error) {
+	res, err := prc.pop()
+	if err != nil {
+		return err
+	}
+	iterable, err := prc.forOfIterable()
+	if err != nil {
+		return err
+	}
+	if it, ok := iterable.(*iteratorRecord); ok && it.async {
+		it.result = res
+	}
+	return nil
+}
+
 func ForOfHasMoreOperation(prc *Process, op *OpCode) (err error) {
 	index, err := prc.pop()
 	if err != nil {
@@ -2285,6 +3592,21 @@ func ForOfHasMoreOperation(prc *Process, op *OpCode) (err error) {
 		hasMore = idx < len(it.Elements)
 	case types.StringType:
 		hasMore = idx < len(string(it))
+	case *iteratorRecord:
+		res := it.result
+		if !it.async {
+			res, err = types.CallMethod(prc, it.next, it.iterator, nil)
+			if err != nil {
+				return err
+			}
+		}
+		resObj, ok := res.(*types.ObjectType)
+		if !ok {
+			return types.ThrowError(prc, "TypeError",
+				"Iterator result "+types.ToString(res)+" is not an object")
+		}
+		hasMore = !types.IsTruthy(resObj.Get("done"))
+		it.value = resObj.Get("value")
 	default:
 		hasMore = false
 	}
@@ -2313,12 +3635,17 @@ func ForOfNextOperation(prc *Process, op *OpCode) (err error) {
 	switch it := iterable.(type) {
 	case *types.ArrayType:
 		if idx < len(it.Elements) {
-			prc.locals[slotIndex] = it.Elements[idx]
+			storeVariable(prc, slotIndex, it.Elements[idx])
 		}
 	case types.StringType:
+		// Strings iterate by code point, the index is the byte offset
 		if idx < len(string(it)) {
-			prc.locals[slotIndex] = types.StringType(string(it)[idx : idx+1])
+			cp, size := types.NextCodePoint(string(it), idx)
+			storeVariable(prc, slotIndex, types.StringType(cp))
+			return prc.push(types.IntegerType(idx + size))
 		}
+	case *iteratorRecord:
+		storeVariable(prc, slotIndex, it.value)
 	}
 
 	// And increment the iterator index
@@ -0,0 +1,1008 @@
+/*
+ * Proxy exotic objects and the Reflect namespace object.
+ *
+ * Copyright (C) 2005-2026 J.M. Heisz.  All Rights Reserved.
+ * See the LICENSE file accompanying the distribution your rights to use
+ * this software.
+ */
+
+package engine
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/heisz/gescript/types"
+)
+
+/*
+ * Section 10.5, a proxy wraps a target object where the operations on the
+ * proxy are intercepted by the (trap) functions of the handler object, any
+ * operation without a trap is forwarded to the target.  The operations of the
+ * engine dispatch proxies with the executing process, but proxies are also
+ * host objects so the natives (Object.keys, JSON, etc.) see the traps, which
+ * run in the calling process through the checked host methods.  The plain
+ * host methods (for Go access) run the traps in the process that created the
+ * proxy, where trap errors cannot be reported by Has() and Keys().
+ *
+ * As the engine has no property descriptors or non-extensible (other than
+ * frozen) objects, the invariants of the traps are only enforced against
+ * frozen targets.
+ */
+type Proxy struct {
+	target  types.DataType
+	handler types.DataType
+
+	// Originating process for the host object access (nil for Go proxies)
+	prc *Process
+}
+
+// Create the proxy for the target and handler, which must both be objects
+func NewProxy(prc *Process, target, handler types.DataType) (*Proxy, error) {
+	if types.IsPrimitive(target) || types.IsPrimitive(handler) {
+		msg := "Cannot create proxy with a non-object as target or handler"
+		if prc == nil {
+			return nil, errors.New("TypeError: " + msg)
+		}
+		return nil, types.ThrowError(prc, "TypeError", msg)
+	}
+	return &Proxy{target: target, handler: handler, prc: prc}, nil
+}
+
+// Revoke the proxy, where all subsequent operations on the proxy will fail
+func (pxy *Proxy) Revoke() {
+	pxy.handler = nil
+}
+
+func (pxy *Proxy) Native() interface{} {
+	return pxy.target.Native()
+}
+
+func (pxy *Proxy) ToPrimitive(pref any) types.DataType {
+	return pxy.target.ToPrimitive(pref)
+}
+
+// The host object methods, for natives without the executing process
+func (pxy *Proxy) hostProcess() *Process {
+	if pxy.prc == nil {
+		pxy.prc = NewProcess(256, nil, nil, nil)
+	}
+	return pxy.prc
+}
+
+func (pxy *Proxy) Get(name string) (types.DataType, error) {
+	return pxy.hostProcess().proxyGet(pxy, types.StringType(name))
+}
+
+func (pxy *Proxy) Set(name string, val types.DataType) error {
+	_, err := pxy.hostProcess().proxySet(pxy, types.StringType(name), val)
+	return err
+}
+
+func (pxy *Proxy) Delete(name string) (bool, error) {
+	return pxy.hostProcess().proxyDelete(pxy, types.StringType(name))
+}
+
+func (pxy *Proxy) Has(name string) bool {
+	has, _ := pxy.hostProcess().proxyHas(pxy, types.StringType(name))
+	return has
+}
+
+func (pxy *Proxy) Keys() []string {
+	keys, _ := pxy.hostProcess().proxyEnumerate(pxy)
+	return keys
+}
+
+// The checked host object methods, with the traps run in the calling process
+func (pxy *Proxy) GetChecked(prc types.Process,
+	name string) (types.DataType, error) {
+	return prc.(*Process).proxyGet(pxy, types.StringType(name))
+}
+
+// A rejected assignment (falsish trap result) is always a TypeError here, as
+// for the natives that assign with the throw flag (e.g. Object.assign)
+func (pxy *Proxy) SetChecked(prc types.Process, name string,
+	val types.DataType) error {
+	ok, err := prc.(*Process).proxySet(pxy, types.StringType(name), val)
+	if err == nil && !ok {
+		err = types.ThrowError(prc, "TypeError", "'set' on proxy: trap "+
+			"returned falsish for property '"+name+"'")
+	}
+	return err
+}
+
+func (pxy *Proxy) HasChecked(prc types.Process, name string) (bool, error) {
+	return prc.(*Process).proxyHas(pxy, types.StringType(name))
+}
+
+func (pxy *Proxy) KeysChecked(prc types.Process) ([]string, error) {
+	return prc.(*Process).proxyEnumerate(pxy)
+}
+
+// Proxies are only functions if the target is callable
+func (pxy *Proxy) TypeOf() string {
+	if isCallable(pxy.target) {
+		return "function"
+	}
+	return "object"
+}
+
+// Determine if the value can be called as a function
+func isCallable(val types.DataType) bool {
+	switch fn := val.(type) {
+	case *Proxy:
+		return isCallable(fn.target)
+	case types.FunctionType, types.HostCallable:
+		return true
+	}
+	return false
+}
+
+// Determine if the value can be used with new
+func isConstructor(val types.DataType) bool {
+	switch ctor := val.(type) {
+	case *Proxy:
+		return isConstructor(ctor.target)
+	case *BoundFunction:
+		return isConstructor(ctor.Target)
+	case *ScriptFunction:
+		return ctor.IsConstructor()
+	case *types.NativeConstructor:
+		return true
+	}
+	return false
+}
+
+// Convert the key to a property key (symbol or string) for the traps
+func propertyKey(key types.DataType) types.DataType {
+	if sym, ok := key.(*types.SymbolType); ok {
+		return sym
+	}
+	return types.StringType(types.ToString(key))
+}
+
+// Printable form of the property key for error messages
+func keyName(key types.DataType) string {
+	if sym, ok := key.(*types.SymbolType); ok {
+		return sym.String()
+	}
+	return types.ToString(key)
+}
+
+// Call the function value with the this value (synchronously)
+func (prc *Process) callFunction(fnVal, thisVal types.DataType,
+	args []types.DataType) (types.DataType, error) {
+	switch fn := fnVal.(type) {
+	case *Proxy:
+		return prc.proxyApply(fn, thisVal, args)
+	case types.FunctionType:
+		res, err := types.CallMethod(prc, fn, thisVal, args)
+		if res == nil && err == nil {
+			res = types.Undefined
+		}
+		return res, err
+	case types.HostCallable:
+		res, err := fn.Call(prc, args)
+		if err != nil {
+			return nil, types.HostError(prc, err)
+		}
+		return res, nil
+	}
+	return nil, types.ThrowError(prc, "TypeError",
+		types.ToString(fnVal)+" is not a function")
+}
+
+// Retrieve the trap function from the handler, nil if not defined
+func (prc *Process) proxyTrap(pxy *Proxy, name string) (types.DataType,
+	error) {
+	if pxy.handler == nil {
+		return nil, types.ThrowError(prc, "TypeError", "Cannot perform '"+
+			name+"' on a proxy that has been revoked")
+	}
+	trap, err := prc.getElement(pxy.handler, types.StringType(name))
+	if err != nil {
+		return nil, err
+	}
+	switch trap.(type) {
+	case types.UndefinedType, types.NullType:
+		return nil, nil
+	}
+	if !isCallable(trap) {
+		return nil, types.ThrowError(prc, "TypeError",
+			"'"+name+"' on proxy: trap is not a function")
+	}
+	return trap, nil
+}
+
+// Call the trap of the handler (this) with the target and the arguments
+func (prc *Process) callTrap(pxy *Proxy, trap types.DataType,
+	args ...types.DataType) (types.DataType, error) {
+	return prc.callFunction(trap, pxy.handler,
+		append([]types.DataType{pxy.target}, args...))
+}
+
+// Rejected assignments/deletions are errors for strict code (as for frozen
+// objects), but just a false result for Reflect
+func (prc *Process) proxyRejected(name string, key types.DataType) error {
+	return prc.readOnlyWrite("'" + name + "' on proxy: trap returned " +
+		"falsish for property '" + keyName(key) + "'")
+}
+
+// Common error for a trap result that violates the invariants
+func (prc *Process) trapViolation(name string, msg string) error {
+	return types.ThrowError(prc, "TypeError", "'"+name+"' on proxy: "+msg)
+}
+
+// Own property value of a frozen target, for the invariant checks
+func frozenValue(target,
+	key types.DataType) (val types.DataType, frozen bool, found bool) {
+	switch tgt := target.(type) {
+	case *types.ObjectType:
+		if !tgt.Frozen {
+			return nil, false, false
+		}
+		if sym, ok := key.(*types.SymbolType); ok {
+			val, found = tgt.Symbols[sym]
+			return val, true, found
+		}
+		val, found = tgt.Properties[types.ToString(key)]
+		return val, true, found
+	case *types.ArrayType:
+		if !tgt.Frozen {
+			return nil, false, false
+		}
+		idx, err := strconv.Atoi(types.ToString(key))
+		if err != nil || idx < 0 || idx >= len(tgt.Elements) {
+			return nil, true, false
+		}
+		return tgt.Elements[idx], true, true
+	}
+	return nil, false, false
+}
+
+// Section 10.5.8, [[Get]]
+func (prc *Process) proxyGet(pxy *Proxy,
+	key types.DataType) (types.DataType, error) {
+	trap, err := prc.proxyTrap(pxy, "get")
+	if err != nil {
+		return nil, err
+	}
+	if trap == nil {
+		return prc.getElement(pxy.target, key)
+	}
+	res, err := prc.callTrap(pxy, trap, key, pxy)
+	if err != nil {
+		return nil, err
+	}
+	if val, _, found := frozenValue(pxy.target, key); found &&
+		!types.StrictEquals(val, res) {
+		return nil, prc.trapViolation("get", "property '"+keyName(key)+
+			"' of the frozen target differs from the trap result")
+	}
+	return res, nil
+}
+
+// Section 10.5.9, [[Set]]
+func (prc *Process) proxySet(pxy *Proxy, key,
+	val types.DataType) (bool, error) {
+	trap, err := prc.proxyTrap(pxy, "set")
+	if err != nil {
+		return false, err
+	}
+	if trap == nil {
+		return prc.setElement(pxy.target, key, val)
+	}
+	res, err := prc.callTrap(pxy, trap, key, val, pxy)
+	if err != nil {
+		return false, err
+	}
+	if !types.IsTruthy(res) {
+		return false, nil
+	}
+	if cur, _, found := frozenValue(pxy.target, key); found &&
+		!types.StrictEquals(cur, val) {
+		return false, prc.trapViolation("set", "cannot change property '"+
+			keyName(key)+"' of the frozen target")
+	}
+	return true, nil
+}
+
+// Section 10.5.7, [[HasProperty]]
+func (prc *Process) proxyHas(pxy *Proxy, key types.DataType) (bool, error) {
+	trap, err := prc.proxyTrap(pxy, "has")
+	if err != nil {
+		return false, err
+	}
+	if trap == nil {
+		return prc.hasProperty(pxy.target, key)
+	}
+	res, err := prc.callTrap(pxy, trap, key)
+	if err != nil {
+		return false, err
+	}
+	if _, _, found := frozenValue(pxy.target, key); found &&
+		!types.IsTruthy(res) {
+		return false, prc.trapViolation("has", "cannot hide property '"+
+			keyName(key)+"' of the frozen target")
+	}
+	return types.IsTruthy(res), nil
+}
+
+// Section 10.5.10, [[Delete]]
+func (prc *Process) proxyDelete(pxy *Proxy, key types.DataType) (bool, error) {
+	trap, err := prc.proxyTrap(pxy, "deleteProperty")
+	if err != nil {
+		return false, err
+	}
+	if trap == nil {
+		return prc.deleteElement(pxy.target, key)
+	}
+	res, err := prc.callTrap(pxy, trap, key)
+	if err != nil {
+		return false, err
+	}
+	if !types.IsTruthy(res) {
+		return false, nil
+	}
+	if _, _, found := frozenValue(pxy.target, key); found {
+		return false, prc.trapViolation("deleteProperty", "cannot delete "+
+			"property '"+keyName(key)+"' of the frozen target")
+	}
+	return true, nil
+}
+
+// Section 10.5.11, [[OwnPropertyKeys]]
+func (prc *Process) proxyOwnKeys(pxy *Proxy) ([]types.DataType, error) {
+	trap, err := prc.proxyTrap(pxy, "ownKeys")
+	if err != nil {
+		return nil, err
+	}
+	if trap == nil {
+		return prc.ownKeys(pxy.target)
+	}
+	res, err := prc.callTrap(pxy, trap)
+	if err != nil {
+		return nil, err
+	}
+
+	// Result must be a list of unique strings and symbols
+	arr, ok := res.(*types.ArrayType)
+	if !ok {
+		return nil, prc.trapViolation("ownKeys",
+			"trap result is not an array")
+	}
+	keys := make([]types.DataType, 0, len(arr.Elements))
+	seen := make(map[types.DataType]bool)
+	for _, key := range arr.Elements {
+		switch key.(type) {
+		case types.StringType, *types.SymbolType:
+		default:
+			return nil, prc.trapViolation("ownKeys", types.ToString(key)+
+				" is not a valid property name")
+		}
+		if seen[key] {
+			return nil, prc.trapViolation("ownKeys", "trap returned "+
+				"duplicate entries")
+		}
+		seen[key] = true
+		keys = append(keys, key)
+	}
+
+	// And the frozen target cannot report additional or missing keys
+	if _, frozen, _ := frozenValue(pxy.target, types.Undefined); frozen {
+		targetKeys, err := prc.ownKeys(pxy.target)
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range targetKeys {
+			if !seen[key] {
+				return nil, prc.trapViolation("ownKeys", "trap result "+
+					"must include '"+keyName(key)+"' of the frozen target")
+			}
+		}
+		if len(targetKeys) != len(keys) {
+			return nil, prc.trapViolation("ownKeys", "trap returned "+
+				"extra keys for the frozen target")
+		}
+	}
+	return keys, nil
+}
+
+// Enumerable own string keys of the proxy (for-in and the host object keys)
+func (prc *Process) proxyEnumerate(pxy *Proxy) ([]string, error) {
+	keys, err := prc.proxyOwnKeys(pxy)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(keys))
+	for _, key := range keys {
+		name, ok := key.(types.StringType)
+		if !ok {
+			continue
+		}
+		desc, err := prc.proxyGetOwnPropertyDescriptor(pxy, name)
+		if err != nil {
+			return nil, err
+		}
+		if obj, ok := desc.(*types.ObjectType); ok &&
+			types.IsTruthy(obj.Get("enumerable")) {
+			names = append(names, string(name))
+		}
+	}
+	return names, nil
+}
+
+// Section 10.5.5, [[GetOwnProperty]]
+func (prc *Process) proxyGetOwnPropertyDescriptor(pxy *Proxy,
+	key types.DataType) (types.DataType, error) {
+	trap, err := prc.proxyTrap(pxy, "getOwnPropertyDescriptor")
+	if err != nil {
+		return nil, err
+	}
+	if trap == nil {
+		return prc.ownDescriptor(pxy.target, key)
+	}
+	res, err := prc.callTrap(pxy, trap, key)
+	if err != nil {
+		return nil, err
+	}
+	switch res.(type) {
+	case types.UndefinedType:
+		if _, _, found := frozenValue(pxy.target, key); found {
+			return nil, prc.trapViolation("getOwnPropertyDescriptor",
+				"cannot hide property '"+keyName(key)+
+					"' of the frozen target")
+		}
+	case *types.ObjectType:
+	default:
+		return nil, prc.trapViolation("getOwnPropertyDescriptor",
+			"trap returned neither object nor undefined for property '"+
+				keyName(key)+"'")
+	}
+	return res, nil
+}
+
+// Section 10.5.6, [[DefineOwnProperty]]
+func (prc *Process) proxyDefineProperty(pxy *Proxy, key,
+	desc types.DataType) (bool, error) {
+	trap, err := prc.proxyTrap(pxy, "defineProperty")
+	if err != nil {
+		return false, err
+	}
+	if trap == nil {
+		return prc.defineProperty(pxy.target, key, desc)
+	}
+	res, err := prc.callTrap(pxy, trap, key, desc)
+	if err != nil {
+		return false, err
+	}
+	if !types.IsTruthy(res) {
+		return false, nil
+	}
+	if _, frozen, _ := frozenValue(pxy.target, key); frozen {
+		return false, prc.trapViolation("defineProperty", "cannot define "+
+			"property '"+keyName(key)+"' of the frozen target")
+	}
+	return true, nil
+}
+
+// Section 10.5.1, [[GetPrototypeOf]]
+func (prc *Process) proxyGetPrototypeOf(pxy *Proxy) (types.DataType, error) {
+	trap, err := prc.proxyTrap(pxy, "getPrototypeOf")
+	if err != nil {
+		return nil, err
+	}
+	if trap == nil {
+		return prc.prototypeOf(pxy.target)
+	}
+	res, err := prc.callTrap(pxy, trap)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := res.(types.NullType); !ok && types.IsPrimitive(res) {
+		return nil, prc.trapViolation("getPrototypeOf",
+			"trap returned neither object nor null")
+	}
+	if _, frozen, _ := frozenValue(pxy.target, types.Undefined); frozen {
+		proto, err := prc.prototypeOf(pxy.target)
+		if err != nil {
+			return nil, err
+		}
+		if !types.StrictEquals(proto, res) {
+			return nil, prc.trapViolation("getPrototypeOf", "trap result "+
+				"differs from the prototype of the frozen target")
+		}
+	}
+	return res, nil
+}
+
+// Section 10.5.2, [[SetPrototypeOf]]
+func (prc *Process) proxySetPrototypeOf(pxy *Proxy,
+	proto types.DataType) (bool, error) {
+	trap, err := prc.proxyTrap(pxy, "setPrototypeOf")
+	if err != nil {
+		return false, err
+	}
+	if trap == nil {
+		return prc.setPrototypeOf(pxy.target, proto)
+	}
+	res, err := prc.callTrap(pxy, trap, proto)
+	if err != nil {
+		return false, err
+	}
+	return types.IsTruthy(res), nil
+}
+
+// Section 10.5.3, [[IsExtensible]]
+func (prc *Process) proxyIsExtensible(pxy *Proxy) (bool, error) {
+	trap, err := prc.proxyTrap(pxy, "isExtensible")
+	if err != nil {
+		return false, err
+	}
+	extensible, err := prc.isExtensible(pxy.target)
+	if err != nil || trap == nil {
+		return extensible, err
+	}
+	res, err := prc.callTrap(pxy, trap)
+	if err != nil {
+		return false, err
+	}
+	if types.IsTruthy(res) != extensible {
+		return false, prc.trapViolation("isExtensible", "trap result "+
+			"does not reflect the extensibility of the target")
+	}
+	return extensible, nil
+}
+
+// Section 10.5.4, [[PreventExtensions]]
+func (prc *Process) proxyPreventExtensions(pxy *Proxy) (bool, error) {
+	trap, err := prc.proxyTrap(pxy, "preventExtensions")
+	if err != nil {
+		return false, err
+	}
+	if trap == nil {
+		return prc.preventExtensions(pxy.target)
+	}
+	res, err := prc.callTrap(pxy, trap)
+	if err != nil {
+		return false, err
+	}
+	if !types.IsTruthy(res) {
+		return false, nil
+	}
+	if extensible, err := prc.isExtensible(pxy.target); err != nil ||
+		extensible {
+		if err != nil {
+			return false, err
+		}
+		return false, prc.trapViolation("preventExtensions", "trap "+
+			"returned truish but the target is extensible")
+	}
+	return true, nil
+}
+
+// Section 10.5.12, [[Call]]
+func (prc *Process) proxyApply(pxy *Proxy, thisVal types.DataType,
+	args []types.DataType) (types.DataType, error) {
+	trap, err := prc.proxyTrap(pxy, "apply")
+	if err != nil {
+		return nil, err
+	}
+	if !isCallable(pxy.target) {
+		return nil, types.ThrowError(prc, "TypeError",
+			"proxy is not a function")
+	}
+	if trap == nil {
+		return prc.callFunction(pxy.target, thisVal, args)
+	}
+	return prc.callTrap(pxy, trap, thisVal, newArrayOf(args))
+}
+
+// Section 10.5.13, [[Construct]]
+func (prc *Process) proxyConstruct(pxy *Proxy,
+	args []types.DataType) (types.DataType, error) {
+	trap, err := prc.proxyTrap(pxy, "construct")
+	if err != nil {
+		return nil, err
+	}
+	if !isConstructor(pxy.target) {
+		return nil, types.ThrowError(prc, "TypeError",
+			"proxy is not a constructor")
+	}
+	if trap == nil {
+		return prc.newInstance(pxy.target, args)
+	}
+	res, err := prc.callTrap(pxy, trap, newArrayOf(args), pxy)
+	if err != nil {
+		return nil, err
+	}
+	if types.IsPrimitive(res) {
+		return nil, prc.trapViolation("construct",
+			"trap returned non-object")
+	}
+	return res, nil
+}
+
+// Array of the (copied) values
+func newArrayOf(vals []types.DataType) *types.ArrayType {
+	arr := types.NewArray(len(vals))
+	copy(arr.Elements, vals)
+	return arr
+}
+
+/*
+ * The ordinary (non-proxy) object operations for the Reflect functions and
+ * the defaults of the proxy traps.  These follow the limited object model of
+ * the engine, all properties are plain (enumerable) data properties that are
+ * read-only and non-configurable when the object is frozen.
+ */
+
+// Own property keys of the object, strings before symbols
+func (prc *Process) ownKeys(target types.DataType) ([]types.DataType, error) {
+	var keys []types.DataType
+	switch tgt := target.(type) {
+	case *Proxy:
+		return prc.proxyOwnKeys(tgt)
+	case *types.ObjectType:
+		for key := range tgt.Properties {
+			keys = append(keys, types.StringType(key))
+		}
+		for sym := range tgt.Symbols {
+			keys = append(keys, sym)
+		}
+	case *types.ArrayType:
+		for idx := range tgt.Elements {
+			keys = append(keys, types.StringType(strconv.Itoa(idx)))
+		}
+		keys = append(keys, types.StringType("length"))
+	case *types.TypedArrayType:
+		for idx := 0; idx < tgt.Length(); idx++ {
+			keys = append(keys, types.StringType(strconv.Itoa(idx)))
+		}
+	case types.HostObject:
+		for _, key := range tgt.Keys() {
+			keys = append(keys, types.StringType(key))
+		}
+	}
+	return keys, nil
+}
+
+// Property descriptor for the own property, undefined if there is none
+func (prc *Process) ownDescriptor(target,
+	key types.DataType) (types.DataType, error) {
+	if pxy, ok := target.(*Proxy); ok {
+		return prc.proxyGetOwnPropertyDescriptor(pxy, key)
+	}
+
+	var val types.DataType
+	found, frozen, enumerable := false, false, true
+	switch tgt := target.(type) {
+	case *types.ObjectType:
+		if sym, ok := key.(*types.SymbolType); ok {
+			val, found = tgt.Symbols[sym]
+		} else {
+			val, found = tgt.Properties[types.ToString(key)]
+		}
+		frozen = tgt.Frozen
+	case *types.ArrayType:
+		name := types.ToString(key)
+		if name == "length" {
+			val, found = types.IntegerType(len(tgt.Elements)), true
+			enumerable = false
+		} else if idx, err := strconv.Atoi(name); err == nil &&
+			idx >= 0 && idx < len(tgt.Elements) {
+			val, found = tgt.Elements[idx], true
+		}
+		frozen = tgt.Frozen
+	case types.HostObject:
+		if _, ok := key.(*types.SymbolType); !ok &&
+			tgt.Has(types.ToString(key)) {
+			var err error
+			if val, err = tgt.Get(types.ToString(key)); err != nil {
+				return nil, types.HostError(prc, err)
+			}
+			found = true
+		}
+	}
+	if !found {
+		return types.Undefined, nil
+	}
+
+	desc := types.NewObject()
+	desc.Set("value", val)
+	desc.Set("writable", types.BooleanType(!frozen))
+	desc.Set("enumerable", types.BooleanType(enumerable))
+	desc.Set("configurable", types.BooleanType(!frozen && enumerable))
+	return desc, nil
+}
+
+// Define (assign) the property from the descriptor, accessor properties are
+// not supported (false)
+func (prc *Process) defineProperty(target, key,
+	desc types.DataType) (bool, error) {
+	if pxy, ok := target.(*Proxy); ok {
+		return prc.proxyDefineProperty(pxy, key, desc)
+	}
+	obj, ok := desc.(*types.ObjectType)
+	if !ok {
+		return false, types.ThrowError(prc, "TypeError",
+			"Property description must be an object")
+	}
+	if obj.Has("get") || obj.Has("set") {
+		return false, nil
+	}
+	if _, frozen, _ := frozenValue(target, key); frozen {
+		return false, nil
+	}
+	if !obj.Has("value") {
+		exists, err := prc.hasProperty(target, key)
+		if err != nil || exists {
+			return exists, err
+		}
+	}
+	return prc.setElement(target, key, obj.Get("value"))
+}
+
+// The prototype of the object (null if none)
+func (prc *Process) prototypeOf(target types.DataType) (types.DataType,
+	error) {
+	switch tgt := target.(type) {
+	case *Proxy:
+		return prc.proxyGetPrototypeOf(tgt)
+	case *types.ObjectType:
+		if tgt.Proto != nil {
+			return tgt.Proto, nil
+		}
+	}
+	return types.NullType{}, nil
+}
+
+// Replace the prototype of the object (only script objects have one)
+func (prc *Process) setPrototypeOf(target,
+	proto types.DataType) (bool, error) {
+	if pxy, ok := target.(*Proxy); ok {
+		return prc.proxySetPrototypeOf(pxy, proto)
+	}
+	obj, ok := target.(*types.ObjectType)
+	if !ok || obj.Frozen {
+		return false, nil
+	}
+	switch val := proto.(type) {
+	case types.NullType:
+		obj.Proto = nil
+	case *types.ObjectType:
+		for cur := val; cur != nil; cur = cur.Proto {
+			if cur == obj {
+				// Cannot create a cycle in the chain
+				return false, nil
+			}
+		}
+		obj.Proto = val
+	default:
+		return false, nil
+	}
+	return true, nil
+}
+
+// Objects are extensible unless frozen
+func (prc *Process) isExtensible(target types.DataType) (bool, error) {
+	if pxy, ok := target.(*Proxy); ok {
+		return prc.proxyIsExtensible(pxy)
+	}
+	_, frozen, _ := frozenValue(target, types.Undefined)
+	return !frozen, nil
+}
+
+// As there is no non-extensible state other than frozen, this only succeeds
+// for frozen objects (use Object.freeze)
+func (prc *Process) preventExtensions(target types.DataType) (bool, error) {
+	if pxy, ok := target.(*Proxy); ok {
+		return prc.proxyPreventExtensions(pxy)
+	}
+	extensible, err := prc.isExtensible(target)
+	return !extensible, err
+}
+
+// Native implementation of the Proxy constructor (only through new)
+func proxyConstructor(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	if !types.IsConstructing(prc, "Proxy") {
+		return nil, types.ThrowError(prc, "TypeError",
+			"Constructor Proxy requires 'new'")
+	}
+	return NewProxy(prc.(*Process), types.Arg(args, 0), types.Arg(args, 1))
+}
+
+// Section 28.2.2.1, Proxy.revocable() returns the proxy and revoke function
+func proxyRevocable(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	pxy, err := NewProxy(prc.(*Process), types.Arg(args, 0),
+		types.Arg(args, 1))
+	if err != nil {
+		return nil, err
+	}
+	res := types.NewObject()
+	res.Set("proxy", pxy)
+	res.Set("revoke", &types.NativeFunction{Name: "revoke",
+		Fn: func(prc types.Process,
+			args []types.DataType) (types.DataType, error) {
+			pxy.Revoke()
+			return types.Undefined, nil
+		}})
+	return res, nil
+}
+
+// Create the Proxy constructor (there is no Proxy.prototype)
+func NewProxyConstructor() *types.NativeConstructor {
+	ctor := types.NewNativeConstructor("Proxy", proxyConstructor)
+	ctor.AddStaticMethod("revocable", proxyRevocable)
+	return ctor
+}
+
+// Wrap a Reflect function with the common check of the target argument
+func reflectFn(name string, needsFn bool,
+	fn func(prc *Process, target types.DataType,
+		args []types.DataType) (types.DataType, error)) *types.NativeFunction {
+	return &types.NativeFunction{Name: name,
+		Fn: func(prc types.Process,
+			args []types.DataType) (types.DataType, error) {
+			target := types.Arg(args, 0)
+			if (needsFn && !isCallable(target)) ||
+				types.IsPrimitive(target) {
+				return nil, types.ThrowError(prc, "TypeError",
+					"Reflect."+name+" called on non-object")
+			}
+			return fn(prc.(*Process), target, args)
+		}}
+}
+
+// Property key argument of the Reflect functions
+func reflectKey(prc *Process, args []types.DataType) (types.DataType, error) {
+	return types.ToPropertyKey(prc, types.Arg(args, 1))
+}
+
+// Argument list from an array (CreateListFromArrayLike)
+func reflectArgs(prc *Process, val types.DataType) ([]types.DataType,
+	error) {
+	arr, ok := val.(*types.ArrayType)
+	if !ok {
+		return nil, types.ThrowError(prc, "TypeError",
+			"CreateListFromArrayLike called on non-object")
+	}
+	return append([]types.DataType{}, arr.Elements...), nil
+}
+
+// Boolean result of an operation
+func reflectBool(res bool, err error) (types.DataType, error) {
+	if err != nil {
+		return nil, err
+	}
+	return types.BooleanType(res), nil
+}
+
+// Section 28.1, the Reflect namespace (the same operations as the traps)
+func NewReflectObject() *types.ObjectType {
+	reflect := types.NewObject()
+	define := func(fn *types.NativeFunction) {
+		reflect.Properties[fn.Name] = fn
+	}
+
+	define(reflectFn("apply", true, func(prc *Process, target types.DataType,
+		args []types.DataType) (types.DataType, error) {
+		list, err := reflectArgs(prc, types.Arg(args, 2))
+		if err != nil {
+			return nil, err
+		}
+		return prc.callFunction(target, types.Arg(args, 1), list)
+	}))
+	define(reflectFn("construct", false, func(prc *Process,
+		target types.DataType,
+		args []types.DataType) (types.DataType, error) {
+		// Note that there is no support for a distinct newTarget
+		if !isConstructor(target) {
+			return nil, types.ThrowError(prc, "TypeError",
+				types.ToString(target)+" is not a constructor")
+		}
+		list, err := reflectArgs(prc, types.Arg(args, 1))
+		if err != nil {
+			return nil, err
+		}
+		return prc.newInstance(target, list)
+	}))
+	define(reflectFn("defineProperty", false, func(prc *Process,
+		target types.DataType,
+		args []types.DataType) (types.DataType, error) {
+		key, err := reflectKey(prc, args)
+		if err != nil {
+			return nil, err
+		}
+		return reflectBool(prc.defineProperty(target, key,
+			types.Arg(args, 2)))
+	}))
+	define(reflectFn("deleteProperty", false, func(prc *Process,
+		target types.DataType,
+		args []types.DataType) (types.DataType, error) {
+		key, err := reflectKey(prc, args)
+		if err != nil {
+			return nil, err
+		}
+		if pxy, ok := target.(*Proxy); ok {
+			return reflectBool(prc.proxyDelete(pxy, key))
+		}
+		if _, frozen, found := frozenValue(target, key); frozen && found {
+			return types.BooleanType(false), nil
+		}
+		return reflectBool(prc.deleteElement(target, key))
+	}))
+	define(reflectFn("get", false, func(prc *Process, target types.DataType,
+		args []types.DataType) (types.DataType, error) {
+		key, err := reflectKey(prc, args)
+		if err != nil {
+			return nil, err
+		}
+		return prc.getElement(target, key)
+	}))
+	define(reflectFn("getOwnPropertyDescriptor", false, func(prc *Process,
+		target types.DataType,
+		args []types.DataType) (types.DataType, error) {
+		key, err := reflectKey(prc, args)
+		if err != nil {
+			return nil, err
+		}
+		return prc.ownDescriptor(target, key)
+	}))
+	define(reflectFn("getPrototypeOf", false, func(prc *Process,
+		target types.DataType,
+		args []types.DataType) (types.DataType, error) {
+		return prc.prototypeOf(target)
+	}))
+	define(reflectFn("has", false, func(prc *Process, target types.DataType,
+		args []types.DataType) (types.DataType, error) {
+		key, err := reflectKey(prc, args)
+		if err != nil {
+			return nil, err
+		}
+		return reflectBool(prc.hasProperty(target, key))
+	}))
+	define(reflectFn("isExtensible", false, func(prc *Process,
+		target types.DataType,
+		args []types.DataType) (types.DataType, error) {
+		return reflectBool(prc.isExtensible(target))
+	}))
+	define(reflectFn("ownKeys", false, func(prc *Process,
+		target types.DataType,
+		args []types.DataType) (types.DataType, error) {
+		keys, err := prc.ownKeys(target)
+		if err != nil {
+			return nil, err
+		}
+		return newArrayOf(keys), nil
+	}))
+	define(reflectFn("preventExtensions", false, func(prc *Process,
+		target types.DataType,
+		args []types.DataType) (types.DataType, error) {
+		return reflectBool(prc.preventExtensions(target))
+	}))
+	define(reflectFn("set", false, func(prc *Process, target types.DataType,
+		args []types.DataType) (types.DataType, error) {
+		key, err := reflectKey(prc, args)
+		if err != nil {
+			return nil, err
+		}
+		val := types.Arg(args, 2)
+		if pxy, ok := target.(*Proxy); ok {
+			return reflectBool(prc.proxySet(pxy, key, val))
+		}
+		if _, frozen, _ := frozenValue(target, key); frozen {
+			return types.BooleanType(false), nil
+		}
+		return reflectBool(prc.setElement(target, key, val))
+	}))
+	define(reflectFn("setPrototypeOf", false, func(prc *Process,
+		target types.DataType,
+		args []types.DataType) (types.DataType, error) {
+		return reflectBool(prc.setPrototypeOf(target, types.Arg(args, 1)))
+	}))
+	reflect.SetSymbol(types.SymbolToStringTag, types.StringType("Reflect"))
+	return reflect
+}
@@ -0,0 +1,195 @@
+/*
+ * Object scopes, for the with statement and the host implicit scope object.
+ *
+ * Copyright (C) 2005-2026 J.M. Heisz.  All Rights Reserved.
+ * See the LICENSE file accompanying the distribution your rights to use
+ * this software.
+ */
+
+package engine
+
+import (
+	"github.com/heisz/gescript/types"
+)
+
+// Identifiers within a with statement are resolved dynamically, the object
+// (on the stack) is checked first and if the property is present the lookup
+// jumps past the remaining (lexical/global) resolution to the target.
+type WithInfo struct {
+	Name   string
+	Target int
+}
+
+// Validate the object for a with statement (remains on the stack)
+func WithScopeOperation(prc *Process, op *OpCode) (err error) {
+	obj, err := prc.peek()
+	if err != nil {
+		return err
+	}
+	switch obj.(type) {
+	case types.UndefinedType, types.NullType:
+		return types.ThrowError(prc, "TypeError",
+			"Cannot convert undefined or null to object")
+	}
+	return nil
+}
+
+// Load the identifier from the scope object, if it has the property
+func WithLoadOperation(prc *Process, op *OpCode) (err error) {
+	info := op.OpData.(WithInfo)
+	obj, err := prc.pop()
+	if err != nil {
+		return err
+	}
+
+	val, found, err := prc.scopeLookup(obj, info.Name)
+	if err != nil || !found {
+		return err
+	}
+	prc.pc = info.Target - 1
+	return prc.push(val)
+}
+
+// Assign the identifier in the scope object, if it has the property
+func WithStoreOperation(prc *Process, op *OpCode) (err error) {
+	info := op.OpData.(WithInfo)
+	obj, err := prc.pop()
+	if err != nil {
+		return err
+	}
+	val, err := prc.peek()
+	if err != nil {
+		return err
+	}
+
+	found, err := prc.scopeAssign(obj, info.Name, val)
+	if err != nil || !found {
+		return err
+	}
+	prc.pc = info.Target - 1
+	return nil
+}
+
+// Load the identifier for a call from the scope object, a function found on
+// the object is called with the object as 'this' (replacing the undefined
+// placeholder beneath)
+func WithCallLoadOperation(prc *Process, op *OpCode) (err error) {
+	info := op.OpData.(WithInfo)
+	obj, err := prc.pop()
+	if err != nil {
+		return err
+	}
+
+	val, found, err := prc.scopeLookup(obj, info.Name)
+	if err != nil || !found {
+		return err
+	}
+	if prc.sp < 1 {
+		return ErrStackUnderflow
+	}
+	prc.stack[prc.sp-1] = obj
+	prc.pc = info.Target - 1
+	return prc.push(val)
+}
+
+// Delete the identifier from the scope object, if it has the property
+func WithDeleteOperation(prc *Process, op *OpCode) (err error) {
+	info := op.OpData.(WithInfo)
+	obj, err := prc.pop()
+	if err != nil {
+		return err
+	}
+
+	_, found, err := prc.scopeLookup(obj, info.Name)
+	if err != nil || !found {
+		return err
+	}
+	deleted, err := prc.deleteElement(obj, types.StringType(info.Name))
+	if err != nil {
+		return err
+	}
+	prc.pc = info.Target - 1
+	return prc.push(types.BooleanType(deleted))
+}
+
+// Resolve the named property of a scope object, where the result indicates
+// if the object has the property at all (distinct from undefined)
+func (prc *Process) scopeLookup(scope types.DataType,
+	name string) (types.DataType, bool, error) {
+	switch tgt := scope.(type) {
+	case types.UndefinedType, types.NullType:
+		return nil, false, nil
+	case *Proxy:
+		key := types.StringType(name)
+		if has, err := prc.proxyHas(tgt, key); err != nil || !has {
+			return nil, false, err
+		}
+		val, err := prc.proxyGet(tgt, key)
+		return val, err == nil, err
+	case types.HostObject:
+		if !tgt.Has(name) {
+			return nil, false, nil
+		}
+		val, err := tgt.Get(name)
+		if err != nil {
+			return nil, false, types.HostError(prc, err)
+		}
+		return val, true, nil
+	case *types.NativeConstructor:
+		if val := tgt.Get(name); val != types.Undefined {
+			return val, true, nil
+		}
+		return nil, false, nil
+	case *ScriptFunction:
+		if val := prc.functionMember(tgt, name); val != types.Undefined {
+			return val, true, nil
+		}
+		return nil, false, nil
+	case *types.ObjectType:
+		for obj := tgt; obj != nil; obj = obj.Proto {
+			if val, ok := obj.Properties[name]; ok {
+				return val, true, nil
+			}
+		}
+	}
+
+	// Instances of the native (and registered) constructors
+	if member := prc.resolveInstanceMember(scope, name); member != nil {
+		return member, true, nil
+	}
+	return nil, false, nil
+}
+
+// Assign the named property of a scope object if present (as per lookup)
+func (prc *Process) scopeAssign(scope types.DataType, name string,
+	val types.DataType) (bool, error) {
+	switch tgt := scope.(type) {
+	case *Proxy:
+		key := types.StringType(name)
+		if has, err := prc.proxyHas(tgt, key); err != nil || !has {
+			return false, err
+		}
+		_, err := prc.proxySet(tgt, key, val)
+		return true, err
+	case types.HostObject:
+		if !tgt.Has(name) {
+			return false, nil
+		}
+		if err := tgt.Set(name, val); err != nil {
+			return true, types.HostError(prc, err)
+		}
+		return true, nil
+	case *types.ObjectType:
+		if _, found, err := prc.scopeLookup(tgt, name); err != nil || !found {
+			return false, err
+		}
+		if tgt.Frozen {
+			if err := prc.frozenWrite(tgt, name); err != nil {
+				return true, err
+			}
+		}
+		tgt.Set(name, val)
+		return true, nil
+	}
+	return false, nil
+}
@@ -10,8 +10,8 @@ package native
 
 import (
 	"fmt"
+	"math"
 	"sort"
-	"strings"
 
 	"github.com/heisz/gescript/types"
 )
@@ -34,6 +34,44 @@ func arrayConcat(prc types.Process,
 	return res, nil
 }
 
+func arrayAt(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	arr := args[0].(*types.ArrayType)
+	idx := int(math.Trunc(types.ToNumber(types.Arg(args, 1))))
+	if idx < 0 {
+		idx += len(arr.Elements)
+	}
+	return arr.Get(idx), nil
+}
+
+func arrayCopyWithin(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	arr := args[0].(*types.ArrayType)
+	length := len(arr.Elements)
+	target := relativeIndex(types.Arg(args, 1), length, 0)
+	start := relativeIndex(types.Arg(args, 2), length, 0)
+	end := relativeIndex(types.Arg(args, 3), length, length)
+	if start < end {
+		copy(arr.Elements[target:], arr.Elements[start:end])
+	}
+	return arr, nil
+}
+
+// Array entries iterator, the [index, value] pairs (live against the array)
+func arrayEntries(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	arr := args[0].(*types.ArrayType)
+	idx := 0
+	return types.NewIterator(func() (types.DataType, bool) {
+		if idx >= len(arr.Elements) {
+			return nil, false
+		}
+		idx++
+		return &types.ArrayType{Elements: []types.DataType{
+			types.IntegerType(idx - 1), arr.Elements[idx-1]}}, true
+	}), nil
+}
+
 func arrayEvery(prc types.Process,
 	args []types.DataType) (types.DataType, error) {
 	arr := args[0].(*types.ArrayType)
@@ -174,6 +212,42 @@ func arrayFindIndex(prc types.Process,
 	return types.IntegerType(-1), nil
 }
 
+// Common implementation of findLast and findLastIndex (searching from the end
+// of the array), the result is the element or the index
+func arrayFindLast(index bool) types.NativeFn {
+	return func(prc types.Process,
+		args []types.DataType) (types.DataType, error) {
+		arr := args[0].(*types.ArrayType)
+		method := "findLast"
+		if index {
+			method = "findLastIndex"
+		}
+		callback, err := callbackArg(prc, args, method)
+		if err != nil {
+			return nil, err
+		}
+		for idx := len(arr.Elements) - 1; idx >= 0; idx-- {
+			entry := arr.Get(idx)
+			res, err := callback.Call(prc, []types.DataType{
+				entry, types.IntegerType(idx), arr,
+			})
+			if err != nil {
+				return nil, err
+			}
+			if types.IsTruthy(res) {
+				if index {
+					return types.IntegerType(idx), nil
+				}
+				return entry, nil
+			}
+		}
+		if index {
+			return types.IntegerType(-1), nil
+		}
+		return types.Undefined, nil
+	}
+}
+
 func flatten(res *types.ArrayType, elements []types.DataType, depth int) {
 	for _, elem := range elements {
 		if inner, ok := elem.(*types.ArrayType); ok && depth > 0 {
@@ -197,6 +271,28 @@ func arrayFlat(prc types.Process,
 	return res, nil
 }
 
+// Map each element and flatten the results (by a single level)
+func arrayFlatMap(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	arr := args[0].(*types.ArrayType)
+	callback, err := callbackArg(prc, args, "flatMap")
+	if err != nil {
+		return nil, err
+	}
+
+	res := types.NewArray(0)
+	for idx, entry := range arr.Elements {
+		mres, err := callback.Call(prc, []types.DataType{
+			entry, types.IntegerType(idx), arr,
+		})
+		if err != nil {
+			return nil, err
+		}
+		flatten(res, []types.DataType{mres}, 1)
+	}
+	return res, nil
+}
+
 func arrayForEach(prc types.Process,
 	args []types.DataType) (types.DataType, error) {
 	arr := args[0].(*types.ArrayType)
@@ -272,19 +368,50 @@ func arrayIndexOf(prc types.Process,
 	return types.IntegerType(-1), nil
 }
 
+// Array values iterator, for Symbol.iterator (live against the array)
+func arrayIterator(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	arr := args[0].(*types.ArrayType)
+	idx := 0
+	return types.NewIterator(func() (types.DataType, bool) {
+		if idx >= len(arr.Elements) {
+			return nil, false
+		}
+		idx++
+		return arr.Elements[idx-1], true
+	}), nil
+}
+
 func arrayJoin(prc types.Process,
 	args []types.DataType) (types.DataType, error) {
 	arr := args[0].(*types.ArrayType)
 	sep := ","
-	if len(args) > 1 {
-		sep = types.ToString(args[1])
+	if _, ok := types.Arg(args, 1).(types.UndefinedType); !ok {
+		str, err := types.ToStringChecked(prc, args[1])
+		if err != nil {
+			return nil, err
+		}
+		sep = str
 	}
-
-	parts := make([]string, len(arr.Elements))
-	for idx, entry := range arr.Elements {
-		parts[idx] = types.ToString(entry)
+	str, err := types.JoinElementsChecked(prc, arr.Elements, sep)
+	if err != nil {
+		return nil, err
 	}
-	return types.StringType(strings.Join(parts, sep)), nil
+	return types.StringType(str), nil
+}
+
+// Array keys iterator, the indices (live against the array)
+func arrayKeys(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	arr := args[0].(*types.ArrayType)
+	idx := 0
+	return types.NewIterator(func() (types.DataType, bool) {
+		if idx >= len(arr.Elements) {
+			return nil, false
+		}
+		idx++
+		return types.IntegerType(idx - 1), true
+	}), nil
 }
 
 func arrayLastIndexOf(prc types.Process,
@@ -518,74 +645,175 @@ func arraySome(prc types.Process,
 	return types.BooleanType(false), nil
 }
 
-func arraySort(prc types.Process,
-	args []types.DataType) (types.DataType, error) {
-	arr := args[0].(*types.ArrayType)
-	// Default is to sort by string versions of entries
-	sort.SliceStable(arr.Elements, func(idx int, idy int) bool {
-		return types.ToString(arr.Elements[idx]) <
-			types.ToString(arr.Elements[idy])
+// Extract the comparator argument for the sort methods, nil for the default
+// ordering
+func sortComparator(prc types.Process,
+	args []types.DataType) (types.FunctionType, error) {
+	switch cmp := types.Arg(args, 1).(type) {
+	case types.UndefinedType:
+		return nil, nil
+	case types.FunctionType:
+		return cmp, nil
+	}
+	return nil, types.ThrowError(prc, "TypeError",
+		"The comparison function must be either a function or undefined")
+}
+
+// Stable sort of the values, through the comparator (if not nil) or by the
+// default ordering, where an error from the comparator aborts the sort
+func sortValues(prc types.Process, vals []types.DataType,
+	comparator types.FunctionType,
+	less func(lval, rval types.DataType) bool) error {
+	var cmpErr error
+	sort.SliceStable(vals, func(idx int, idy int) bool {
+		if cmpErr != nil {
+			return false
+		}
+		if comparator == nil {
+			return less(vals[idx], vals[idy])
+		}
+		res, err := comparator.Call(prc, []types.DataType{
+			vals[idx], vals[idy]})
+		if err == nil {
+			var num float64
+			num, err = types.ToNumberChecked(prc, res)
+			if err == nil {
+				return num < 0
+			}
+		}
+		cmpErr = err
+		return false
 	})
-	return arr, nil
+	return cmpErr
 }
 
-func arraySplice(prc types.Process,
-	args []types.DataType) (types.DataType, error) {
-	arr := args[0].(*types.ArrayType)
-	alen := len(arr.Elements)
+// Sort the array elements in place, where undefined values are always moved
+// to the end (and are not passed to the comparator)
+func sortElements(prc types.Process, elements []types.DataType,
+	comparator types.FunctionType) error {
+	vals := make([]types.DataType, 0, len(elements))
+	for _, entry := range elements {
+		if _, ok := entry.(types.UndefinedType); !ok && entry != nil {
+			vals = append(vals, entry)
+		}
+	}
 
-	if len(args) < 2 {
-		return types.NewArray(0), nil
+	// Default is to sort by string versions of entries
+	err := sortValues(prc, vals, comparator,
+		func(lval, rval types.DataType) bool {
+			return types.ToString(lval) < types.ToString(rval)
+		})
+	if err != nil {
+		return err
 	}
+	copy(elements, vals)
+	for idx := len(vals); idx < len(elements); idx++ {
+		elements[idx] = types.Undefined
+	}
+	return nil
+}
 
-	start := types.ToInt(args[1])
-	if start < 0 {
-		start = alen + start
-		if start < 0 {
-			start = 0
-		}
+func arraySort(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	arr := args[0].(*types.ArrayType)
+	comparator, err := sortComparator(prc, args)
+	if err != nil {
+		return nil, err
 	}
-	if start > alen {
-		start = alen
+	if err := sortElements(prc, arr.Elements, comparator); err != nil {
+		return nil, err
 	}
+	return arr, nil
+}
 
-	delCount := alen - start
-	if len(args) > 2 {
-		delCount = types.ToInt(args[2])
-		if delCount < 0 {
-			delCount = 0
-		}
-		if start+delCount > alen {
-			delCount = alen - start
-		}
+// Resolve the start and delete count arguments for splice and toSpliced
+func spliceRange(args []types.DataType, length int) (int, int) {
+	start := relativeIndex(types.Arg(args, 1), length, 0)
+	switch len(args) {
+	case 1:
+		return start, 0
+	case 2:
+		return start, length - start
+	}
+	count := math.Trunc(types.ToNumber(args[2]))
+	if math.IsNaN(count) || count < 0 {
+		count = 0
 	}
+	return start, int(math.Min(count, float64(length-start)))
+}
+
+func arraySplice(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	arr := args[0].(*types.ArrayType)
+	start, delCount := spliceRange(args, len(arr.Elements))
 
 	// Remaining arguments are items to splice in
-	additions := args[3:]
+	var additions []types.DataType
+	if len(args) > 3 {
+		additions = args[3:]
+	}
 
 	// Extract the removed items for return
 	removed := types.NewArray(delCount)
 	copy(removed.Elements, arr.Elements[start:start+delCount])
 
 	// Splice it all together
-	newLen := alen - delCount + len(additions)
+	arr.Elements = splicedElements(arr.Elements, start, delCount, additions)
+	return removed, nil
+}
+
+// Assemble the elements with the deleted range replaced by the additions
+// (always a new slice)
+func splicedElements(elements []types.DataType, start, delCount int,
+	additions []types.DataType) []types.DataType {
+	newLen := len(elements) - delCount + len(additions)
 	newElems := make([]types.DataType, newLen)
-	copy(newElems, arr.Elements[:start])
+	copy(newElems, elements[:start])
 	copy(newElems[start:], additions)
-	copy(newElems[start+len(additions):], arr.Elements[start+delCount:])
-	arr.Elements = newElems
-
-	return removed, nil
+	copy(newElems[start+len(additions):], elements[start+delCount:])
+	return newElems
 }
 
-func arrayToString(prc types.Process,
+func arrayToReversed(prc types.Process,
 	args []types.DataType) (types.DataType, error) {
 	arr := args[0].(*types.ArrayType)
-	parts := make([]string, len(arr.Elements))
+	res := types.NewArray(len(arr.Elements))
 	for idx, entry := range arr.Elements {
-		parts[idx] = types.ToString(entry)
+		res.Elements[len(arr.Elements)-idx-1] = entry
+	}
+	return res, nil
+}
+
+func arrayToSorted(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	arr := args[0].(*types.ArrayType)
+	comparator, err := sortComparator(prc, args)
+	if err != nil {
+		return nil, err
+	}
+	res := types.NewArray(len(arr.Elements))
+	copy(res.Elements, arr.Elements)
+	if err := sortElements(prc, res.Elements, comparator); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func arrayToSpliced(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	arr := args[0].(*types.ArrayType)
+	start, delCount := spliceRange(args, len(arr.Elements))
+	var additions []types.DataType
+	if len(args) > 3 {
+		additions = args[3:]
 	}
-	return types.StringType(strings.Join(parts, ",")), nil
+	return &types.ArrayType{Elements: splicedElements(arr.Elements, start,
+		delCount, additions)}, nil
+}
+
+func arrayToString(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	return arrayJoin(prc, args[:1])
 }
 
 func arrayUnshift(prc types.Process,
@@ -599,6 +827,27 @@ func arrayUnshift(prc types.Process,
 	return types.IntegerType(len(arr.Elements)), nil
 }
 
+// Copy of the array with the (relative) index replaced by the value
+func arrayWith(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	arr := args[0].(*types.ArrayType)
+	num := math.Trunc(types.ToNumber(types.Arg(args, 1)))
+	if math.IsNaN(num) {
+		num = 0
+	}
+	if num < 0 {
+		num += float64(len(arr.Elements))
+	}
+	if num < 0 || num >= float64(len(arr.Elements)) {
+		return nil, types.ThrowError(prc, "RangeError",
+			"Invalid index : "+types.ToString(types.Arg(args, 1)))
+	}
+	res := types.NewArray(len(arr.Elements))
+	copy(res.Elements, arr.Elements)
+	res.Elements[int(num)] = types.Arg(args, 2)
+	return res, nil
+}
+
 // Resolve properties and methods for the Array type
 func arrayMemberResolver(target types.DataType, name string) types.DataType {
 	arr, ok := target.(*types.ArrayType)
@@ -614,9 +863,18 @@ func arrayMemberResolver(target types.DataType, name string) types.DataType {
 	// Otherwise look up the array instance methods
 	var method *types.NativeFunction
 	switch name {
+	case "at":
+		method = &types.NativeFunction{Name: "at",
+			Fn: arrayAt}
 	case "concat":
 		method = &types.NativeFunction{Name: "concat",
 			Fn: arrayConcat}
+	case "copyWithin":
+		method = &types.NativeFunction{Name: "copyWithin",
+			Fn: arrayCopyWithin}
+	case "entries":
+		method = &types.NativeFunction{Name: "entries",
+			Fn: arrayEntries}
 	case "every":
 		method = &types.NativeFunction{Name: "every",
 			Fn: arrayEvery}
@@ -632,9 +890,18 @@ func arrayMemberResolver(target types.DataType, name string) types.DataType {
 	case "findIndex":
 		method = &types.NativeFunction{Name: "findIndex",
 			Fn: arrayFindIndex}
+	case "findLast":
+		method = &types.NativeFunction{Name: "findLast",
+			Fn: arrayFindLast(false)}
+	case "findLastIndex":
+		method = &types.NativeFunction{Name: "findLastIndex",
+			Fn: arrayFindLast(true)}
 	case "flat":
 		method = &types.NativeFunction{Name: "flat",
 			Fn: arrayFlat}
+	case "flatMap":
+		method = &types.NativeFunction{Name: "flatMap",
+			Fn: arrayFlatMap}
 	case "forEach":
 		method = &types.NativeFunction{Name: "forEach",
 			Fn: arrayForEach}
@@ -647,6 +914,9 @@ func arrayMemberResolver(target types.DataType, name string) types.DataType {
 	case "join":
 		method = &types.NativeFunction{Name: "join",
 			Fn: arrayJoin}
+	case "keys":
+		method = &types.NativeFunction{Name: "keys",
+			Fn: arrayKeys}
 	case "lastIndexOf":
 		method = &types.NativeFunction{Name: "lastIndexOf",
 			Fn: arrayLastIndexOf}
@@ -683,18 +953,53 @@ func arrayMemberResolver(target types.DataType, name string) types.DataType {
 	case "splice":
 		method = &types.NativeFunction{Name: "splice",
 			Fn: arraySplice}
+	case "toReversed":
+		method = &types.NativeFunction{Name: "toReversed",
+			Fn: arrayToReversed}
+	case "toSorted":
+		method = &types.NativeFunction{Name: "toSorted",
+			Fn: arrayToSorted}
+	case "toSpliced":
+		method = &types.NativeFunction{Name: "toSpliced",
+			Fn: arrayToSpliced}
 	case "toString":
 		method = &types.NativeFunction{Name: "toString",
 			Fn: arrayToString}
 	case "unshift":
 		method = &types.NativeFunction{Name: "unshift",
 			Fn: arrayUnshift}
+	case "values":
+		method = &types.NativeFunction{Name: "values",
+			Fn: arrayIterator}
+	case "with":
+		method = &types.NativeFunction{Name: "with",
+			Fn: arrayWith}
+	case "@@iterator":
+		method = &types.NativeFunction{Name: "[Symbol.iterator]",
+			Fn: arrayIterator}
 	default:
 		return nil
 	}
+
+	// Modifying methods are rejected for frozen arrays
+	if arr.Frozen {
+		switch name {
+		case "copyWithin", "fill", "pop", "push", "reverse", "shift", "sort",
+			"splice", "unshift":
+			method = &types.NativeFunction{Name: method.Name,
+				Fn: arrayFrozen}
+		}
+	}
 	return &types.NativeMethod{Target: arr, Method: method}
 }
 
+// Replacement for the modifying methods of a frozen array
+func arrayFrozen(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	return nil, types.ThrowError(prc, "TypeError",
+		"Cannot modify a frozen array")
+}
+
 func arrayIsArray(prc types.Process,
 	args []types.DataType) (types.DataType, error) {
 	if len(args) == 0 {
@@ -704,6 +1009,50 @@ func arrayIsArray(prc types.Process,
 	return types.BooleanType(isArray), nil
 }
 
+// Create an array from an iterable or array-like, with the optional mapping
+func arrayFrom(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	src := types.Arg(args, 0)
+	switch src.(type) {
+	case types.UndefinedType, types.NullType:
+		return nil, types.ThrowError(prc, "TypeError",
+			types.ToString(src)+" is not iterable")
+	}
+	var mapFn types.FunctionType
+	if _, ok := types.Arg(args, 1).(types.UndefinedType); !ok {
+		fn, ok := args[1].(types.FunctionType)
+		if !ok {
+			return nil, types.ThrowError(prc, "TypeError",
+				types.ToString(args[1])+" is not a function")
+		}
+		mapFn = fn
+	}
+
+	vals, _, err := iterableValues(prc, src)
+	if err != nil {
+		return nil, err
+	}
+	res := types.NewArray(len(vals))
+	for idx, val := range vals {
+		if mapFn != nil {
+			val, err = mapFn.Call(prc, []types.DataType{
+				val, types.IntegerType(idx)})
+			if err != nil {
+				return nil, err
+			}
+		}
+		res.Elements[idx] = val
+	}
+	return res, nil
+}
+
+func arrayOf(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	arr := types.NewArray(len(args))
+	copy(arr.Elements, args)
+	return arr, nil
+}
+
 // Create the Array global constructor with static isArray and member elements
 func NewArrayConstructor() *types.NativeConstructor {
 	ctor := types.NewNativeConstructor("Array",
@@ -726,8 +1075,14 @@ func NewArrayConstructor() *types.NativeConstructor {
 			return arr, nil
 		})
 
+	ctor.AddStaticMethod("from", arrayFrom)
 	ctor.AddStaticMethod("isArray", arrayIsArray)
+	ctor.AddStaticMethod("of", arrayOf)
 	ctor.InstanceMembers = arrayMemberResolver
+	ctor.IsInstance = func(val types.DataType) bool {
+		_, ok := val.(*types.ArrayType)
+		return ok
+	}
 
 	return ctor
 }
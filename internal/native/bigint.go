@@ -0,0 +1,155 @@
+/*
+ * Implementations of standard elements for the BigInt type.
+ *
+ * Copyright (C) 2005-2026 J.M. Heisz.  All Rights Reserved.
+ * See the LICENSE file accompanying the distribution your rights to use
+ * this software.
+ */
+
+package native
+
+import (
+	"math"
+
+	"github.com/heisz/gescript/types"
+)
+
+// Note: in all instance methods, args[0] is 'this', aka the BigInt instance
+
+// Explicit conversion to a BigInt (Section 7.1.13, ToBigInt), where numbers
+// are only accepted by the BigInt() function itself (integral values)
+func toBigInt(prc types.Process, val types.DataType,
+	numbers bool) (types.BigIntType, error) {
+	prim, err := types.ToPrimitiveChecked(prc, val, "number")
+	if err != nil {
+		return types.BigIntType{}, err
+	}
+
+	switch tval := prim.(type) {
+	case types.BigIntType:
+		return tval, nil
+	case types.BooleanType:
+		if tval {
+			return types.NewBigInt(1), nil
+		}
+		return types.BigIntType{}, nil
+	case types.StringType:
+		res, err := types.ParseBigInt(string(tval))
+		if err != nil {
+			return res, types.ThrowError(prc, "SyntaxError", err.Error())
+		}
+		return res, nil
+	case types.IntegerType:
+		if numbers {
+			return types.NewBigInt(int64(tval)), nil
+		}
+	case types.NumberType:
+		if numbers {
+			res, err := types.NewBigIntFromFloat(float64(tval))
+			if err != nil {
+				return res, types.ThrowError(prc, "RangeError", err.Error())
+			}
+			return res, nil
+		}
+	}
+	return types.BigIntType{}, types.ThrowError(prc, "TypeError",
+		"Cannot convert "+types.ToString(prim)+" to a BigInt")
+}
+
+// Extract the bit count for asIntN/asUintN (Section 7.1.22, ToIndex)
+func bigIntBits(prc types.Process, val types.DataType) (uint64, error) {
+	num, err := types.ToNumberChecked(prc, val)
+	if err != nil {
+		return 0, err
+	}
+	if math.IsNaN(num) {
+		num = 0
+	}
+	num = math.Trunc(num)
+	if num < 0 || num > 9007199254740991 {
+		return 0, types.ThrowError(prc, "RangeError", "Invalid value: "+
+			"not (convertible to) a safe integer")
+	}
+	return uint64(num), nil
+}
+
+func bigIntToString(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	radix := 10
+	if _, ok := types.Arg(args, 1).(types.UndefinedType); !ok {
+		radix = types.ToInt(args[1])
+		if radix < 2 || radix > 36 {
+			return nil, types.ThrowError(prc, "RangeError",
+				"toString() radix must be between 2 and 36")
+		}
+	}
+	return types.StringType(args[0].(types.BigIntType).Text(radix)), nil
+}
+
+func bigIntValueOf(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	return args[0], nil
+}
+
+// Resolve methods for the BigInt type (no properties)
+func bigIntMemberResolver(target types.DataType, name string) types.DataType {
+	bval, ok := target.(types.BigIntType)
+	if !ok {
+		return nil
+	}
+
+	var method *types.NativeFunction
+	switch name {
+	case "toLocaleString", "toString":
+		method = &types.NativeFunction{Name: name, Fn: bigIntToString}
+	case "valueOf":
+		method = &types.NativeFunction{Name: "valueOf", Fn: bigIntValueOf}
+	default:
+		return nil
+	}
+	return &types.NativeMethod{Target: bval, Method: method}
+}
+
+// Common implementation of asIntN/asUintN, the bits and BigInt arguments
+func bigIntAsN(asN func(bval types.BigIntType,
+	bits uint64) (types.BigIntType, error)) types.NativeFn {
+	return func(prc types.Process,
+		args []types.DataType) (types.DataType, error) {
+		bits, err := bigIntBits(prc, types.Arg(args, 0))
+		if err != nil {
+			return nil, err
+		}
+		bval, err := toBigInt(prc, types.Arg(args, 1), false)
+		if err != nil {
+			return nil, err
+		}
+		res, err := asN(bval, bits)
+		if err != nil {
+			return nil, types.HostError(prc, err)
+		}
+		return res, nil
+	}
+}
+
+// Create the BigInt global function, conversion only (no instances)
+func NewBigIntConstructor() *types.NativeConstructor {
+	ctor := types.NewNativeConstructor("BigInt",
+		func(prc types.Process, args []types.DataType) (types.DataType, error) {
+			if types.IsConstructing(prc, "BigInt") {
+				return nil, types.ThrowError(prc, "TypeError",
+					"BigInt is not a constructor")
+			}
+			return toBigInt(prc, types.Arg(args, 0), true)
+		})
+
+	ctor.AddStaticMethod("asIntN", bigIntAsN(types.BigIntType.AsIntN))
+	ctor.AddStaticMethod("asUintN", bigIntAsN(types.BigIntType.AsUintN))
+
+	ctor.InstanceMembers = bigIntMemberResolver
+	ctor.IsInstance = func(val types.DataType) bool {
+		_, ok := val.(types.BigIntType)
+		return ok
+	}
+
+	return ctor
+}
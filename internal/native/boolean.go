@@ -73,6 +73,10 @@ func NewBooleanConstructor() *types.NativeConstructor {
 		})
 
 	ctor.InstanceMembers = booleanMemberResolver
+	ctor.IsInstance = func(val types.DataType) bool {
+		_, ok := val.(types.BooleanType)
+		return ok
+	}
 
 	return ctor
 }
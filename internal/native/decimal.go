@@ -0,0 +1,288 @@
+/*
+ * Implementations of the Decimal (arbitrary precision) type elements.
+ *
+ * Copyright (C) 2005-2026 J.M. Heisz.  All Rights Reserved.
+ * See the LICENSE file accompanying the distribution your rights to use
+ * this software.
+ */
+
+package native
+
+import (
+	"strconv"
+
+	"github.com/heisz/gescript/types"
+)
+
+// Limit on the decimal places for rounding/formatting
+const decimalMaxPlaces = 1000
+
+// Note: in all instance methods, args[0] is 'this', aka the decimal instance
+
+// Convert the script value to a decimal (strings are parsed exactly, numbers
+// through their shortest representation)
+func toDecimal(prc types.Process, val types.DataType) (types.Decimal, error) {
+	switch tval := val.(type) {
+	case types.Decimal:
+		return tval, nil
+	case types.IntegerType:
+		return types.NewDecimal(int64(tval), 0), nil
+	case types.NumberType:
+		dec, err := types.NewDecimalFromFloat(float64(tval))
+		if err != nil {
+			return dec, types.ThrowError(prc, "RangeError", err.Error())
+		}
+		return dec, nil
+	case types.StringType:
+		dec, err := types.ParseDecimal(string(tval))
+		if err != nil {
+			return dec, types.ThrowError(prc, "SyntaxError", err.Error())
+		}
+		return dec, nil
+	}
+	return types.Decimal{}, types.ThrowError(prc, "TypeError",
+		"Cannot convert "+types.ToString(val)+" to a Decimal")
+}
+
+// Extract the decimal places argument (default zero)
+func decimalPlaces(prc types.Process, args []types.DataType, idx int,
+	method string, minPlaces int) (int32, error) {
+	places := 0
+	if _, ok := types.Arg(args, idx).(types.UndefinedType); !ok {
+		places = types.ToInt(args[idx])
+	}
+	if places < minPlaces || places > decimalMaxPlaces {
+		return 0, types.ThrowError(prc, "RangeError",
+			method+"() digits argument must be between "+
+				strconv.Itoa(minPlaces)+" and "+
+				strconv.Itoa(decimalMaxPlaces))
+	}
+	return int32(places), nil
+}
+
+// Extract the rounding mode argument (default from the decimal context)
+func decimalRounding(prc types.Process, args []types.DataType,
+	idx int) (types.RoundingMode, error) {
+	arg := types.Arg(args, idx)
+	if _, ok := arg.(types.UndefinedType); ok {
+		return types.DecimalContextOf(prc).Rounding, nil
+	}
+	mode, err := types.ParseRoundingMode(types.ToString(arg))
+	if err != nil {
+		return mode, types.ThrowError(prc, "RangeError", err.Error())
+	}
+	return mode, nil
+}
+
+// Common wrapper for the binary methods (this and the decimal argument)
+func decimalBinary(fn func(prc types.Process, dec, val types.Decimal,
+	args []types.DataType) (types.DataType, error)) types.NativeFn {
+	return func(prc types.Process,
+		args []types.DataType) (types.DataType, error) {
+		val, err := toDecimal(prc, types.Arg(args, 1))
+		if err != nil {
+			return nil, err
+		}
+		return fn(prc, args[0].(types.Decimal), val, args)
+	}
+}
+
+var decimalAdd = decimalBinary(func(prc types.Process, dec, val types.Decimal,
+	args []types.DataType) (types.DataType, error) {
+	return dec.Add(val), nil
+})
+
+var decimalSub = decimalBinary(func(prc types.Process, dec, val types.Decimal,
+	args []types.DataType) (types.DataType, error) {
+	return dec.Sub(val), nil
+})
+
+var decimalMul = decimalBinary(func(prc types.Process, dec, val types.Decimal,
+	args []types.DataType) (types.DataType, error) {
+	return dec.Mul(val), nil
+})
+
+// Division uses the context precision unless specified (significant digits)
+var decimalDiv = decimalBinary(func(prc types.Process, dec, val types.Decimal,
+	args []types.DataType) (types.DataType, error) {
+	dctx := types.DecimalContextOf(prc)
+	if _, ok := types.Arg(args, 2).(types.UndefinedType); !ok {
+		dctx.Precision = types.ToInt(args[2])
+		if dctx.Precision < 1 || dctx.Precision > decimalMaxPlaces {
+			return nil, types.ThrowError(prc, "RangeError",
+				"div() precision argument must be between 1 and "+
+					strconv.Itoa(decimalMaxPlaces))
+		}
+	}
+	res, err := dec.Quo(val, dctx)
+	if err != nil {
+		return nil, types.HostError(prc, err)
+	}
+	return res, nil
+})
+
+var decimalMod = decimalBinary(func(prc types.Process, dec, val types.Decimal,
+	args []types.DataType) (types.DataType, error) {
+	res, err := dec.Rem(val)
+	if err != nil {
+		return nil, types.HostError(prc, err)
+	}
+	return res, nil
+})
+
+var decimalCmp = decimalBinary(func(prc types.Process, dec, val types.Decimal,
+	args []types.DataType) (types.DataType, error) {
+	return types.IntegerType(dec.Cmp(val)), nil
+})
+
+// Comparison methods are all variants of the comparison result
+func decimalCompare(test func(cmp int) bool) types.NativeFn {
+	return decimalBinary(func(prc types.Process, dec, val types.Decimal,
+		args []types.DataType) (types.DataType, error) {
+		return types.BooleanType(test(dec.Cmp(val))), nil
+	})
+}
+
+func decimalNeg(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	return args[0].(types.Decimal).Neg(), nil
+}
+
+func decimalAbs(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	return args[0].(types.Decimal).Abs(), nil
+}
+
+func decimalRound(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	places, err := decimalPlaces(prc, args, 1, "round", -decimalMaxPlaces)
+	if err != nil {
+		return nil, err
+	}
+	mode, err := decimalRounding(prc, args, 2)
+	if err != nil {
+		return nil, err
+	}
+	return args[0].(types.Decimal).Round(places, mode), nil
+}
+
+func decimalToFixed(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	places, err := decimalPlaces(prc, args, 1, "toFixed", 0)
+	if err != nil {
+		return nil, err
+	}
+	mode, err := decimalRounding(prc, args, 2)
+	if err != nil {
+		return nil, err
+	}
+	return types.StringType(args[0].(types.Decimal).StringFixed(places,
+		mode)), nil
+}
+
+func decimalToString(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	return types.StringType(args[0].(types.Decimal).String()), nil
+}
+
+func decimalToNumber(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	return types.NumberType(args[0].(types.Decimal).Float64()), nil
+}
+
+func decimalIsZero(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	return types.BooleanType(args[0].(types.Decimal).Sign() == 0), nil
+}
+
+func decimalSign(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	return types.IntegerType(args[0].(types.Decimal).Sign()), nil
+}
+
+// Resolve properties and methods for the Decimal type
+func decimalMemberResolver(target types.DataType,
+	name string) types.DataType {
+	dec, ok := target.(types.Decimal)
+	if !ok {
+		return nil
+	}
+
+	// Scale (decimal places) is the only property
+	if name == "scale" {
+		return types.IntegerType(dec.Scale())
+	}
+
+	var fn types.NativeFn
+	switch name {
+	case "abs":
+		fn = decimalAbs
+	case "add":
+		fn = decimalAdd
+	case "cmp":
+		fn = decimalCmp
+	case "div":
+		fn = decimalDiv
+	case "eq":
+		fn = decimalCompare(func(cmp int) bool { return cmp == 0 })
+	case "gt":
+		fn = decimalCompare(func(cmp int) bool { return cmp > 0 })
+	case "gte":
+		fn = decimalCompare(func(cmp int) bool { return cmp >= 0 })
+	case "isZero":
+		fn = decimalIsZero
+	case "lt":
+		fn = decimalCompare(func(cmp int) bool { return cmp < 0 })
+	case "lte":
+		fn = decimalCompare(func(cmp int) bool { return cmp <= 0 })
+	case "mod":
+		fn = decimalMod
+	case "mul":
+		fn = decimalMul
+	case "neg":
+		fn = decimalNeg
+	case "round":
+		fn = decimalRound
+	case "sign":
+		fn = decimalSign
+	case "sub":
+		fn = decimalSub
+	case "toFixed":
+		fn = decimalToFixed
+	case "toJSON", "toString", "valueOf":
+		fn = decimalToString
+	case "toNumber":
+		fn = decimalToNumber
+	default:
+		return nil
+	}
+	return &types.NativeMethod{Target: dec,
+		Method: &types.NativeFunction{Name: name, Fn: fn}}
+}
+
+func decimalIsDecimal(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	_, ok := types.Arg(args, 0).(types.Decimal)
+	return types.BooleanType(ok), nil
+}
+
+// Create the Decimal global constructor (exact values from strings/numbers)
+func NewDecimalConstructor() *types.NativeConstructor {
+	ctor := types.NewNativeConstructor("Decimal",
+		func(prc types.Process, args []types.DataType) (types.DataType, error) {
+			if len(args) == 0 {
+				return types.Decimal{}, nil
+			}
+			return toDecimal(prc, args[0])
+		})
+
+	ctor.AddStaticMethod("isDecimal", decimalIsDecimal)
+
+	ctor.InstanceMembers = decimalMemberResolver
+	ctor.IsInstance = func(val types.DataType) bool {
+		_, ok := val.(types.Decimal)
+		return ok
+	}
+
+	return ctor
+}
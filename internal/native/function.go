@@ -9,6 +9,8 @@
 package native
 
 import (
+	"strings"
+
 	"github.com/heisz/gescript/internal/engine"
 	"github.com/heisz/gescript/types"
 )
@@ -137,7 +139,8 @@ func functionToString(prc types.Process,
 		return types.StringType("function () { }"), nil
 	}
 
-	// ToPrimitive already generates the named descriptor
+	// ToPrimitive already generates the source text (script functions) or
+	// the named descriptor
 	return fn.ToPrimitive(nil), nil
 }
 
@@ -183,17 +186,31 @@ func functionMemberResolver(target types.DataType, name string) types.DataType {
 func NewFunctionConstructor() *types.NativeConstructor {
 	ctor := types.NewNativeConstructor("Function",
 		func(prc types.Process, args []types.DataType) (types.DataType, error) {
-			// Return a no-op function, don't have full parse setup (TODO)
-			return &types.NativeFunction{
-				Name: "anonymous",
-				Fn: func(prc types.Process,
-					args []types.DataType) (types.DataType, error) {
-					return types.Undefined, nil
-				},
-			}, nil
+			// Last argument is the body, any preceding are the parameters
+			var params []string
+			body := ""
+			for idx, arg := range args {
+				if idx == len(args)-1 {
+					body = types.ToString(arg)
+				} else {
+					params = append(params, types.ToString(arg))
+				}
+			}
+
+			// Compiled by the engine (parser), in the global scope
+			engPrc, ok := prc.(*engine.Process)
+			if !ok {
+				return nil, types.ThrowError(prc, "EvalError",
+					"Code generation from strings is not available")
+			}
+			return engPrc.CompileFunction(strings.Join(params, ","), body)
 		})
 
 	ctor.InstanceMembers = functionMemberResolver
+	ctor.IsInstance = func(val types.DataType) bool {
+		_, ok := val.(types.FunctionType)
+		return ok
+	}
 
 	return ctor
 }
@@ -0,0 +1,224 @@
+/*
+ * Implementations of the Map keyed collection.
+ *
+ * Copyright (C) 2005-2026 J.M. Heisz.  All Rights Reserved.
+ * See the LICENSE file accompanying the distribution your rights to use
+ * this software.
+ */
+
+package native
+
+import (
+	"github.com/heisz/gescript/types"
+)
+
+// Note: in all instance methods, args[0] is 'this', aka the map instance
+
+func mapClear(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	args[0].(*types.MapType).Clear()
+	return types.Undefined, nil
+}
+
+func mapDelete(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	m := args[0].(*types.MapType)
+	return types.BooleanType(m.Delete(types.Arg(args, 1))), nil
+}
+
+func mapForEach(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	m := args[0].(*types.MapType)
+	callback, err := callbackArg(prc, args, "forEach")
+	if err != nil {
+		return nil, err
+	}
+	for key, val, pos := m.Next(0); pos >= 0; key, val, pos = m.Next(pos) {
+		_, err := callback.Call(prc, []types.DataType{val, key, m})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return types.Undefined, nil
+}
+
+func mapGet(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	val, _ := args[0].(*types.MapType).Get(types.Arg(args, 1))
+	return val, nil
+}
+
+func mapHas(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	m := args[0].(*types.MapType)
+	return types.BooleanType(m.Has(types.Arg(args, 1))), nil
+}
+
+func mapSet(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	m := args[0].(*types.MapType)
+	m.Set(types.Arg(args, 1), types.Arg(args, 2))
+	return m, nil
+}
+
+// Common implementation of the keys/values/entries iterators (live against
+// the map contents)
+func mapIterator(
+	result func(key, val types.DataType) types.DataType) types.NativeFn {
+	return func(prc types.Process,
+		args []types.DataType) (types.DataType, error) {
+		m := args[0].(*types.MapType)
+		pos := 0
+		return types.NewIterator(func() (types.DataType, bool) {
+			if pos < 0 {
+				return nil, false
+			}
+			var key, val types.DataType
+			key, val, pos = m.Next(pos)
+			if pos < 0 {
+				return nil, false
+			}
+			return result(key, val), true
+		}), nil
+	}
+}
+
+// Entries are the [key, value] pairs, for iteration and Array.from
+func mapEntry(key, val types.DataType) types.DataType {
+	return &types.ArrayType{Elements: []types.DataType{key, val}}
+}
+
+// Resolve properties and methods for the Map type
+func mapMemberResolver(target types.DataType, name string) types.DataType {
+	m, ok := target.(*types.MapType)
+	if !ok {
+		return nil
+	}
+
+	if name == "size" {
+		return types.IntegerType(m.Size())
+	}
+
+	var fn types.NativeFn
+	switch name {
+	case "clear":
+		fn = mapClear
+	case "delete":
+		fn = mapDelete
+	case "entries":
+		fn = mapIterator(mapEntry)
+	case "forEach":
+		fn = mapForEach
+	case "get":
+		fn = mapGet
+	case "has":
+		fn = mapHas
+	case "keys":
+		fn = mapIterator(func(key, val types.DataType) types.DataType {
+			return key
+		})
+	case "set":
+		fn = mapSet
+	case "values":
+		fn = mapIterator(func(key, val types.DataType) types.DataType {
+			return val
+		})
+	case "@@iterator":
+		name = "[Symbol.iterator]"
+		fn = mapIterator(mapEntry)
+	default:
+		return nil
+	}
+	return &types.NativeMethod{Target: m,
+		Method: &types.NativeFunction{Name: name, Fn: fn}}
+}
+
+// Collect the values of the iterable into groups by the callback result,
+// the add function accumulates each value into the group (Section 7.3.35)
+func groupValues(prc types.Process, args []types.DataType,
+	add func(key, val types.DataType) error) error {
+	vals, ok, err := iterableValues(prc, types.Arg(args, 0))
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return types.ThrowError(prc, "TypeError",
+			types.ToString(types.Arg(args, 0))+" is not iterable")
+	}
+	callback, err := callbackArg(prc, args, "groupBy")
+	if err != nil {
+		return err
+	}
+	for idx, val := range vals {
+		key, err := callback.Call(prc, []types.DataType{
+			val, types.IntegerType(idx)})
+		if err != nil {
+			return err
+		}
+		if err := add(key, val); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func mapGroupBy(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	res := types.NewMap()
+	err := groupValues(prc, args, func(key, val types.DataType) error {
+		group, ok := res.Get(key)
+		if !ok {
+			group = types.NewArray(0)
+			res.Set(key, group)
+		}
+		arr := group.(*types.ArrayType)
+		arr.Elements = append(arr.Elements, val)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Create the Map global constructor, optionally from an iterable of entries
+func NewMapConstructor() *types.NativeConstructor {
+	ctor := types.NewNativeConstructor("Map",
+		func(prc types.Process, args []types.DataType) (types.DataType, error) {
+			res := types.NewMap()
+			switch types.Arg(args, 0).(type) {
+			case types.UndefinedType, types.NullType:
+				return res, nil
+			}
+			entries, ok, err := iterableValues(prc, args[0])
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				return nil, types.ThrowError(prc, "TypeError",
+					types.ToString(args[0])+" is not iterable")
+			}
+			for _, entry := range entries {
+				switch tentry := entry.(type) {
+				case *types.ArrayType:
+					res.Set(tentry.Get(0), tentry.Get(1))
+				case *types.ObjectType:
+					res.Set(tentry.Get("0"), tentry.Get("1"))
+				default:
+					return nil, types.ThrowError(prc, "TypeError",
+						"Iterator value "+types.ToString(entry)+
+							" is not an entry object")
+				}
+			}
+			return res, nil
+		})
+
+	ctor.AddStaticMethod("groupBy", mapGroupBy)
+	ctor.InstanceMembers = mapMemberResolver
+	ctor.IsInstance = func(val types.DataType) bool {
+		_, ok := val.(*types.MapType)
+		return ok
+	}
+
+	return ctor
+}
@@ -335,9 +335,10 @@ func JSONStringify(prc types.Process,
 		return types.StringType("undefined"), nil
 	}
 
-	str, err := types.StringifyJSON(args[0])
+	// Errors (including from host objects) are catchable by the script
+	str, err := types.StringifyJSONChecked(prc, args[0])
 	if err != nil {
-		return types.Undefined, fmt.Errorf("JSON.stringify: %v", err)
+		return types.Undefined, types.HostError(prc, err)
 	}
 
 	return types.StringType(str), nil
@@ -366,6 +367,10 @@ func init() {
 	register("encodeURI", EncodeURI)
 	register("encodeURIComponent", EncodeURIComponent)
 
+	// Global value properties (globalThis is provided by the process)
+	NativeFunctions["Infinity"] = types.NumberType(math.Inf(1))
+	NativeFunctions["NaN"] = types.NaN
+
 	// Create/register the JSON object (static methods)
 	jsonObj := types.NewObject()
 	jsonObj.Properties["parse"] = &types.NativeFunction{
@@ -382,6 +387,17 @@ func init() {
 		NewNumberConstructor(),
 		NewBooleanConstructor(),
 		NewFunctionConstructor(),
+		NewPromiseConstructor(),
+		NewSymbolConstructor(),
+		NewBigIntConstructor(),
+		NewDecimalConstructor(),
+		NewMapConstructor(),
+		NewArrayBufferConstructor(),
+		NewDataViewConstructor(),
+	}
+	for _, kind := range types.TypedArrayKinds {
+		NativeConstructors = append(NativeConstructors,
+			NewTypedArrayConstructor(kind))
 	}
 
 	// Register constructors in the natives map by name
@@ -0,0 +1,148 @@
+/*
+ * Unicode normalization (NFC/NFD/NFKC/NFKD) for String.prototype.normalize.
+ *
+ * Copyright (C) 2005-2026 J.M. Heisz.  All Rights Reserved.
+ * See the LICENSE file accompanying the distribution your rights to use
+ * this software.
+ */
+
+package native
+
+import (
+	"unicode/utf16"
+
+	"github.com/heisz/gescript/types"
+)
+
+// Hangul syllables are decomposed/composed algorithmically (Section 3.12)
+const (
+	hangulSBase  = 0xAC00
+	hangulLBase  = 0x1100
+	hangulVBase  = 0x1161
+	hangulTBase  = 0x11A7
+	hangulLCount = 19
+	hangulVCount = 21
+	hangulTCount = 28
+	hangulNCount = hangulVCount * hangulTCount
+	hangulSCount = hangulLCount * hangulNCount
+)
+
+// Append the full (recursive) decomposition of the code point
+func decompose(res []rune, ch rune, compat bool) []rune {
+	if ch >= hangulSBase && ch < hangulSBase+hangulSCount {
+		sidx := ch - hangulSBase
+		res = append(res, hangulLBase+sidx/hangulNCount,
+			hangulVBase+(sidx%hangulNCount)/hangulTCount)
+		if tidx := sidx % hangulTCount; tidx != 0 {
+			res = append(res, hangulTBase+tidx)
+		}
+		return res
+	}
+	if dcmp, ok := decompositions[ch]; ok && (compat || !dcmp.compat) {
+		for _, dch := range dcmp.mapping {
+			res = decompose(res, dch, compat)
+		}
+		return res
+	}
+	return append(res, ch)
+}
+
+// Canonical ordering of the combining marks (stable sort of the runs of
+// non-starters by combining class)
+func canonicalOrder(chars []rune) {
+	for idx := 1; idx < len(chars); idx++ {
+		ccc := combiningClasses[chars[idx]]
+		if ccc == 0 {
+			continue
+		}
+		for idy := idx; idy > 0; idy-- {
+			prev := combiningClasses[chars[idy-1]]
+			if prev == 0 || prev <= ccc {
+				break
+			}
+			chars[idy], chars[idy-1] = chars[idy-1], chars[idy]
+		}
+	}
+}
+
+// Determine the primary composite for the pair, false if none
+func composePair(first, second rune) (rune, bool) {
+	if first >= hangulLBase && first < hangulLBase+hangulLCount &&
+		second >= hangulVBase && second < hangulVBase+hangulVCount {
+		return hangulSBase + ((first-hangulLBase)*hangulVCount+
+			(second-hangulVBase))*hangulTCount, true
+	}
+	if first >= hangulSBase && first < hangulSBase+hangulSCount &&
+		(first-hangulSBase)%hangulTCount == 0 &&
+		second > hangulTBase && second < hangulTBase+hangulTCount {
+		return first + (second - hangulTBase), true
+	}
+	res, ok := compositions[[2]rune{first, second}]
+	return res, ok
+}
+
+// Canonical composition of the (decomposed and ordered) characters
+func compose(chars []rune) []rune {
+	if len(chars) == 0 {
+		return chars
+	}
+	res := chars[:1]
+	starter, lastCCC := 0, combiningClasses[chars[0]]
+	if lastCCC != 0 {
+		// No starter yet, only composes against following starters
+		lastCCC = 255
+	}
+	for _, ch := range chars[1:] {
+		ccc := combiningClasses[ch]
+		blocked := lastCCC != 0 && (lastCCC == 255 || lastCCC >= ccc)
+		if len(res)-1 == starter {
+			blocked = false
+		}
+		if !blocked && combiningClasses[res[starter]] == 0 {
+			if comp, ok := composePair(res[starter], ch); ok {
+				res[starter] = comp
+				continue
+			}
+		}
+		if ccc == 0 {
+			starter = len(res)
+			lastCCC = 0
+		} else {
+			lastCCC = ccc
+		}
+		res = append(res, ch)
+	}
+	return res
+}
+
+// Normalize the string to the specified form, surrogates are retained as
+// is (the characters are processed by code point)
+func normalizeString(str string, form string) string {
+	compat := form == "NFKC" || form == "NFKD"
+	units := types.UTF16(str)
+	chars := make([]rune, 0, len(units))
+	for idx := 0; idx < len(units); idx++ {
+		ch := rune(units[idx])
+		if ch >= 0xD800 && ch < 0xDC00 && idx+1 < len(units) &&
+			units[idx+1] >= 0xDC00 && units[idx+1] <= 0xDFFF {
+			ch = utf16.DecodeRune(ch, rune(units[idx+1]))
+			idx++
+		}
+		chars = decompose(chars, ch, compat)
+	}
+	canonicalOrder(chars)
+	if form == "NFC" || form == "NFKC" {
+		chars = compose(chars)
+	}
+
+	res := make([]uint16, 0, len(chars))
+	for _, ch := range chars {
+		if ch >= 0x10000 {
+			high, low := utf16.EncodeRune(ch)
+			res = append(res, uint16(high), uint16(low))
+		} else {
+			res = append(res, uint16(ch))
+		}
+	}
+	return types.FromUTF16(res)
+}
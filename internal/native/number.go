@@ -9,9 +9,10 @@
 package native
 
 import (
-	"fmt"
 	"math"
+	"math/big"
 	"strconv"
+	"strings"
 
 	"github.com/heisz/gescript/types"
 )
@@ -33,78 +34,282 @@ func getNumber(args []types.DataType) float64 {
 	}
 }
 
+// Convert the digits argument to an integer (Section 7.1.5,
+// ToIntegerOrInfinity), where undefined/NaN is zero
+func numberDigits(prc types.Process, val types.DataType) (float64, error) {
+	num, err := types.ToNumberChecked(prc, val)
+	if err != nil || math.IsNaN(num) {
+		return 0, err
+	}
+	return math.Trunc(num), nil
+}
+
+// Determine if the (optional) argument was not provided
+func isUndefined(val types.DataType) bool {
+	_, ok := val.(types.UndefinedType)
+	return ok
+}
+
+// Determine the significant digits of the (positive, finite) number rounded
+// to the count (half up on the exact binary value, as per the specification),
+// along with the exponent of the leading digit
+func roundedDigits(num float64, count int) (string, int) {
+	// Formatting with enough precision gives the exact decimal expansion
+	exact := strconv.FormatFloat(num, 'e', 767, 64)
+	epos := strings.IndexByte(exact, 'e')
+	exp, _ := strconv.Atoi(exact[epos+1:])
+	digits := []byte(exact[:1] + exact[2:epos])
+
+	roundUp := digits[count] >= '5'
+	digits = digits[:count]
+	if roundUp {
+		idx := count - 1
+		for ; idx >= 0 && digits[idx] == '9'; idx-- {
+			digits[idx] = '0'
+		}
+		if idx < 0 {
+			// All nines rolled over, one more order of magnitude
+			digits = append([]byte{'1'}, digits[:count-1]...)
+			exp++
+		} else {
+			digits[idx]++
+		}
+	}
+	return string(digits), exp
+}
+
+// Format the number in exponential notation with the fractional digits (-1
+// for as many as needed to uniquely represent the value)
 func numberToExponential(prc types.Process,
 	args []types.DataType) (types.DataType, error) {
 	num := getNumber(args)
-	digits := -1
-	if len(args) > 1 {
-		digits = types.ToInt(args[1])
+	fdigits, err := numberDigits(prc, types.Arg(args, 1))
+	if err != nil {
+		return nil, err
+	}
+	if math.IsNaN(num) || math.IsInf(num, 0) {
+		return types.StringType(types.ToString(types.NumberType(num))), nil
+	}
+	if fdigits < 0 || fdigits > 100 {
+		return nil, types.ThrowError(prc, "RangeError",
+			"toExponential() argument must be between 0 and 100")
+	}
+	digits := int(fdigits)
+
+	sign := ""
+	if num < 0 {
+		sign, num = "-", -num
+	}
+	var mant string
+	var exp int
+	switch {
+	case num == 0:
+		mant = strings.Repeat("0", digits+1)
+	case isUndefined(types.Arg(args, 1)):
+		shortest := strconv.FormatFloat(num, 'e', -1, 64)
+		epos := strings.IndexByte(shortest, 'e')
+		exp, _ = strconv.Atoi(shortest[epos+1:])
+		mant = strings.Replace(shortest[:epos], ".", "", 1)
+	default:
+		mant, exp = roundedDigits(num, digits+1)
 	}
 
-	var result string
-	if digits < 0 {
-		result = fmt.Sprintf("%e", num)
-	} else {
-		result = fmt.Sprintf("%.*e", digits, num)
+	res := sign + mant[:1]
+	if len(mant) > 1 {
+		res += "." + mant[1:]
 	}
-	return types.StringType(result), nil
+	if exp < 0 {
+		return types.StringType(res + "e-" + strconv.Itoa(-exp)), nil
+	}
+	return types.StringType(res + "e+" + strconv.Itoa(exp)), nil
 }
 
+// Format the number with the fixed number of fractional digits (rounded
+// half up), large values (1e21 or more) are formatted as for toString
 func numberToFixed(prc types.Process,
 	args []types.DataType) (types.DataType, error) {
 	num := getNumber(args)
-	digits := 0
-	if len(args) > 1 {
-		digits = types.ToInt(args[1])
-		if digits < 0 {
-			digits = 0
-		}
-		if digits > 100 {
-			digits = 100
-		}
+	fdigits, err := numberDigits(prc, types.Arg(args, 1))
+	if err != nil {
+		return nil, err
+	}
+	if fdigits < 0 || fdigits > 100 {
+		return nil, types.ThrowError(prc, "RangeError",
+			"toFixed() digits argument must be between 0 and 100")
+	}
+	digits := int(fdigits)
+	if math.IsNaN(num) || math.IsInf(num, 0) || math.Abs(num) >= 1e21 {
+		return types.StringType(types.ToString(types.NumberType(num))), nil
 	}
 
-	result := fmt.Sprintf("%.*f", digits, num)
-	return types.StringType(result), nil
+	sign := ""
+	if num < 0 {
+		sign, num = "-", -num
+	}
+
+	// Exact rational arithmetic for n = floor(x * 10^f + 1/2)
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(digits)), nil)
+	val := new(big.Rat).SetFloat64(num)
+	val.Mul(val, new(big.Rat).SetInt(scale))
+	val.Add(val, big.NewRat(1, 2))
+	res := new(big.Int).Quo(val.Num(), val.Denom()).String()
+
+	if digits > 0 {
+		if len(res) <= digits {
+			res = strings.Repeat("0", digits-len(res)+1) + res
+		}
+		res = res[:len(res)-digits] + "." + res[len(res)-digits:]
+	}
+	return types.StringType(sign + res), nil
 }
 
+// Format the number to the significant digits, in exponential notation if
+// the exponent is less than -6 or not less than the precision
 func numberToPrecision(prc types.Process,
 	args []types.DataType) (types.DataType, error) {
 	num := getNumber(args)
-	if len(args) < 2 {
-		return types.StringType(fmt.Sprintf("%g", num)), nil
+	if isUndefined(types.Arg(args, 1)) {
+		return types.StringType(types.ToString(types.NumberType(num))), nil
+	}
+	fprecision, err := numberDigits(prc, args[1])
+	if err != nil {
+		return nil, err
+	}
+	if math.IsNaN(num) || math.IsInf(num, 0) {
+		return types.StringType(types.ToString(types.NumberType(num))), nil
+	}
+	if fprecision < 1 || fprecision > 100 {
+		return nil, types.ThrowError(prc, "RangeError",
+			"toPrecision() argument must be between 1 and 100")
+	}
+	precision := int(fprecision)
+
+	sign := ""
+	if num < 0 {
+		sign, num = "-", -num
+	}
+	mant, exp := strings.Repeat("0", precision), 0
+	if num != 0 {
+		mant, exp = roundedDigits(num, precision)
+	}
+
+	var res string
+	switch {
+	case exp < -6 || exp >= precision:
+		res = mant[:1]
+		if precision > 1 {
+			res += "." + mant[1:]
+		}
+		if exp < 0 {
+			res += "e-" + strconv.Itoa(-exp)
+		} else {
+			res += "e+" + strconv.Itoa(exp)
+		}
+	case exp == precision-1:
+		res = mant
+	case exp >= 0:
+		res = mant[:exp+1] + "." + mant[exp+1:]
+	default:
+		res = "0." + strings.Repeat("0", -(exp+1)) + mant
+	}
+	return types.StringType(sign + res), nil
+}
+
+// Digits for the radix conversions
+const radixDigits = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+// Format the (finite) number in the radix, where the fractional digits are
+// generated until the value is uniquely identified (as for V8 and others)
+func formatRadix(num float64, radix int) string {
+	sign := ""
+	if num < 0 {
+		sign, num = "-", -num
+	}
+	integer := math.Floor(num)
+	fraction := num - integer
+	fradix := float64(radix)
+
+	// Fractional digits are within half the distance to the next value
+	delta := 0.5 * (math.Nextafter(num, math.Inf(1)) - num)
+	delta = math.Max(math.Nextafter(0, 1), delta)
+	var frac []byte
+	if fraction >= delta {
+		for {
+			fraction *= fradix
+			delta *= fradix
+			digit := int(fraction)
+			frac = append(frac, radixDigits[digit])
+			fraction -= float64(digit)
+
+			// Round to even, with carry into the preceding digits
+			if fraction > 0.5 || (fraction == 0.5 && (digit&1) == 1) {
+				if fraction+delta > 1 {
+					for {
+						if len(frac) == 0 {
+							integer++
+							break
+						}
+						last := strings.IndexByte(radixDigits,
+							frac[len(frac)-1]) + 1
+						frac = frac[:len(frac)-1]
+						if last < radix {
+							frac = append(frac, radixDigits[last])
+							break
+						}
+					}
+					break
+				}
+			}
+			if fraction < delta {
+				break
+			}
+		}
 	}
 
-	precision := types.ToInt(args[1])
-	if precision < 1 {
-		precision = 1
+	// Integer digits, unrepresentable low order digits are zero
+	var intDigits []byte
+	for integer/fradix >= 1<<53 {
+		integer /= fradix
+		intDigits = append(intDigits, '0')
 	}
-	if precision > 100 {
-		precision = 100
+	for {
+		rem := math.Mod(integer, fradix)
+		intDigits = append(intDigits, radixDigits[int(rem)])
+		integer = (integer - rem) / fradix
+		if integer <= 0 {
+			break
+		}
+	}
+	for idx, idy := 0, len(intDigits)-1; idx < idy; idx, idy = idx+1, idy-1 {
+		intDigits[idx], intDigits[idy] = intDigits[idy], intDigits[idx]
 	}
 
-	result := strconv.FormatFloat(num, 'g', precision, 64)
-	return types.StringType(result), nil
+	if len(frac) == 0 {
+		return sign + string(intDigits)
+	}
+	return sign + string(intDigits) + "." + string(frac)
 }
 
 func numberToString(prc types.Process,
 	args []types.DataType) (types.DataType, error) {
 	num := getNumber(args)
 	radix := 10
-	if len(args) > 1 {
-		radix = types.ToInt(args[1])
-		if radix < 2 || radix > 36 {
-			radix = 10
+	if !isUndefined(types.Arg(args, 1)) {
+		fradix, err := numberDigits(prc, args[1])
+		if err != nil {
+			return nil, err
+		}
+		if fradix < 2 || fradix > 36 {
+			return nil, types.ThrowError(prc, "RangeError",
+				"toString() radix must be between 2 and 36")
 		}
+		radix = int(fradix)
 	}
 
-	// For integers, use strconv for radix support
-	if num == math.Trunc(num) && !math.IsInf(num, 0) && !math.IsNaN(num) {
-		return types.StringType(strconv.FormatInt(int64(num), radix)), nil
+	if radix == 10 || math.IsNaN(num) || math.IsInf(num, 0) || num == 0 {
+		return types.StringType(types.ToString(types.NumberType(num))), nil
 	}
-
-	// For floats, only base 10 is supported
-	return types.StringType(fmt.Sprintf("%g", num)), nil
+	return types.StringType(formatRadix(num, radix)), nil
 }
 
 func numberValueOf(prc types.Process,
@@ -254,7 +459,20 @@ func NewNumberConstructor() *types.NativeConstructor {
 			if len(args) == 0 {
 				return types.NumberType(0), nil
 			}
-			return types.NumberType(types.ToNumber(args[0])), nil
+
+			// Explicit conversion, BigInts are allowed (Section 21.1.1.1)
+			prim, err := types.ToPrimitiveChecked(prc, args[0], "number")
+			if err != nil {
+				return nil, err
+			}
+			if big, ok := prim.(types.BigIntType); ok {
+				return types.NumberType(big.Float64()), nil
+			}
+			num, err := types.ToNumberChecked(prc, prim)
+			if err != nil {
+				return nil, err
+			}
+			return types.NumberType(num), nil
 		})
 
 	ctor.AddStaticMethod("isFinite", numberIsFinite)
@@ -282,6 +500,13 @@ func NewNumberConstructor() *types.NativeConstructor {
 		types.NumberType(math.Nextafter(1, 2)-1))
 
 	ctor.InstanceMembers = numberMemberResolver
+	ctor.IsInstance = func(val types.DataType) bool {
+		switch val.(type) {
+		case types.IntegerType, types.NumberType:
+			return true
+		}
+		return false
+	}
 
 	return ctor
 }
@@ -26,7 +26,8 @@ func objectHasOwnProperty(prc types.Process,
 
 func objectToString(prc types.Process,
 	args []types.DataType) (types.DataType, error) {
-	return types.StringType("[object Object]"), nil
+	// Object primitive form already handles the Symbol.toStringTag override
+	return args[0].ToPrimitive("string"), nil
 }
 
 func objectValueOf(prc types.Process,
@@ -59,6 +60,17 @@ func objectMemberResolver(target types.DataType, name string) types.DataType {
 	return &types.NativeMethod{Target: obj, Method: method}
 }
 
+// All non-primitive values are objects (Section 6.1.7)
+func objectIsInstance(val types.DataType) bool {
+	switch val.(type) {
+	case types.UndefinedType, types.NullType, types.BooleanType,
+		types.IntegerType, types.NumberType, types.StringType,
+		*types.SymbolType:
+		return false
+	}
+	return true
+}
+
 // But plenty of static methods
 
 func objectKeys(prc types.Process,
@@ -67,6 +79,19 @@ func objectKeys(prc types.Process,
 		return types.NewArray(0), nil
 	}
 
+	// Host objects have their own enumeration
+	if host, ok := args[0].(types.HostObject); ok {
+		names, err := types.HostKeys(prc, host)
+		if err != nil {
+			return nil, types.HostError(prc, err)
+		}
+		arr := types.NewArray(len(names))
+		for idx, key := range names {
+			arr.Elements[idx] = types.StringType(key)
+		}
+		return arr, nil
+	}
+
 	obj, ok := args[0].(*types.ObjectType)
 	if !ok {
 		return types.NewArray(0), nil
@@ -88,6 +113,10 @@ func objectValues(prc types.Process,
 		return types.NewArray(0), nil
 	}
 
+	if host, ok := args[0].(types.HostObject); ok {
+		return hostEntries(prc, host, false)
+	}
+
 	obj, ok := args[0].(*types.ObjectType)
 	if !ok {
 		return types.NewArray(0), nil
@@ -109,6 +138,10 @@ func objectEntries(prc types.Process,
 		return types.NewArray(0), nil
 	}
 
+	if host, ok := args[0].(types.HostObject); ok {
+		return hostEntries(prc, host, true)
+	}
+
 	obj, ok := args[0].(*types.ObjectType)
 	if !ok {
 		return types.NewArray(0), nil
@@ -127,6 +160,45 @@ func objectEntries(prc types.Process,
 	return arr, nil
 }
 
+// Values or [key, value] entries of a host object, in key order
+func hostEntries(prc types.Process, host types.HostObject,
+	pairs bool) (types.DataType, error) {
+	keys, err := types.HostKeys(prc, host)
+	if err != nil {
+		return nil, types.HostError(prc, err)
+	}
+	arr := types.NewArray(len(keys))
+	for idx, key := range keys {
+		val, err := types.HostGet(prc, host, key)
+		if err != nil {
+			return nil, types.HostError(prc, err)
+		}
+		if !pairs {
+			arr.Elements[idx] = val
+			continue
+		}
+		pair := types.NewArray(2)
+		pair.Elements[0] = types.StringType(key)
+		pair.Elements[1] = val
+		arr.Elements[idx] = pair
+	}
+	return arr, nil
+}
+
+func objectGetOwnPropertySymbols(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	obj, ok := types.Arg(args, 0).(*types.ObjectType)
+	if !ok {
+		return types.NewArray(0), nil
+	}
+
+	arr := types.NewArray(0)
+	for sym := range obj.Symbols {
+		arr.Elements = append(arr.Elements, sym)
+	}
+	return arr, nil
+}
+
 func objectFromEntries(prc types.Process,
 	args []types.DataType) (types.DataType, error) {
 	if len(args) == 0 {
@@ -157,31 +229,87 @@ func objectAssign(prc types.Process,
 		return types.NewObject(), nil
 	}
 
-	target, ok := args[0].(*types.ObjectType)
-	if !ok {
-		return args[0], nil
+	target := args[0]
+	switch target.(type) {
+	case *types.ObjectType, types.HostObject:
+	default:
+		return target, nil
 	}
 
-	// Copy properties from each source
+	// Copy the (enumerable) properties from each source, host objects (and
+	// proxies) are enumerated and read through their own access
 	for _, source := range args[1:] {
-		srcObj, ok := source.(*types.ObjectType)
-		if !ok {
-			continue
-		}
-		for key, val := range srcObj.Properties {
-			target.Properties[key] = val
+		switch src := source.(type) {
+		case *types.ObjectType:
+			for key, val := range src.Properties {
+				if err := assignProperty(prc, target, key, val); err != nil {
+					return nil, err
+				}
+			}
+			tgtObj, ok := target.(*types.ObjectType)
+			if !ok || len(src.Symbols) == 0 {
+				continue
+			}
+			if tgtObj.Frozen {
+				return nil, types.ThrowError(prc, "TypeError",
+					"Cannot assign to properties of a frozen object")
+			}
+			for sym, val := range src.Symbols {
+				tgtObj.SetSymbol(sym, val)
+			}
+		case types.HostObject:
+			keys, err := types.HostKeys(prc, src)
+			if err != nil {
+				return nil, types.HostError(prc, err)
+			}
+			for _, key := range keys {
+				val, err := types.HostGet(prc, src, key)
+				if err != nil {
+					return nil, types.HostError(prc, err)
+				}
+				if err := assignProperty(prc, target, key, val); err != nil {
+					return nil, err
+				}
+			}
 		}
 	}
 
 	return target, nil
 }
 
+// Assign the property of the target object, as for a (strict) assignment
+// where host objects (and proxies) apply their own assignment rules
+func assignProperty(prc types.Process, target types.DataType, key string,
+	val types.DataType) error {
+	switch tgt := target.(type) {
+	case *types.ObjectType:
+		if tgt.Frozen {
+			return types.ThrowError(prc, "TypeError",
+				"Cannot assign to properties of a frozen object")
+		}
+		tgt.Set(key, val)
+	case types.HostObject:
+		if err := types.HostSet(prc, tgt, key, val); err != nil {
+			return types.HostError(prc, err)
+		}
+	}
+	return nil
+}
+
 func objectHasOwn(prc types.Process,
 	args []types.DataType) (types.DataType, error) {
 	if len(args) < 2 {
 		return types.BooleanType(false), nil
 	}
 
+	if host, ok := args[0].(types.HostObject); ok {
+		has, err := types.HostHas(prc, host, types.ToString(args[1]))
+		if err != nil {
+			return nil, types.HostError(prc, err)
+		}
+		return types.BooleanType(has), nil
+	}
+
 	obj, ok := args[0].(*types.ObjectType)
 	if !ok {
 		return types.BooleanType(false), nil
@@ -196,7 +324,14 @@ func objectFreeze(prc types.Process,
 	if len(args) == 0 {
 		return types.Undefined, nil
 	}
-	// We really don't support it, just return the original
+
+	// Only objects and arrays support it (the rest are immutable anyway)
+	switch val := args[0].(type) {
+	case *types.ObjectType:
+		val.Frozen = true
+	case *types.ArrayType:
+		val.Frozen = true
+	}
 	return args[0], nil
 }
 
@@ -205,12 +340,46 @@ func objectIsFrozen(prc types.Process,
 	if len(args) == 0 {
 		return types.BooleanType(true), nil
 	}
-	_, isObj := args[0].(*types.ObjectType)
-	if !isObj {
-		return types.BooleanType(true), nil
+	switch val := args[0].(type) {
+	case *types.ObjectType:
+		return types.BooleanType(val.Frozen), nil
+	case *types.ArrayType:
+		return types.BooleanType(val.Frozen), nil
+	}
+	return types.BooleanType(true), nil
+}
+
+// Group the iterable values into arrays on a (null prototype) object, keyed
+// by the callback result as a property key
+func objectGroupBy(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	res := types.NewObject()
+	err := groupValues(prc, args, func(key, val types.DataType) error {
+		propKey, err := types.ToPropertyKey(prc, key)
+		if err != nil {
+			return err
+		}
+		var group types.DataType
+		if sym, ok := propKey.(*types.SymbolType); ok {
+			if group = res.GetSymbol(sym); group == types.Undefined {
+				group = types.NewArray(0)
+				res.SetSymbol(sym, group)
+			}
+		} else {
+			name := string(propKey.(types.StringType))
+			if group = res.Get(name); group == types.Undefined {
+				group = types.NewArray(0)
+				res.Set(name, group)
+			}
+		}
+		arr := group.(*types.ArrayType)
+		arr.Elements = append(arr.Elements, val)
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
-	// Not implemented, always return false
-	return types.BooleanType(false), nil
+	return res, nil
 }
 
 // Create the Object global constructor with static/member elements
@@ -238,12 +407,15 @@ func NewObjectConstructor() *types.NativeConstructor {
 	ctor.AddStaticMethod("values", objectValues)
 	ctor.AddStaticMethod("entries", objectEntries)
 	ctor.AddStaticMethod("fromEntries", objectFromEntries)
+	ctor.AddStaticMethod("getOwnPropertySymbols", objectGetOwnPropertySymbols)
 	ctor.AddStaticMethod("assign", objectAssign)
 	ctor.AddStaticMethod("hasOwn", objectHasOwn)
 	ctor.AddStaticMethod("freeze", objectFreeze)
 	ctor.AddStaticMethod("isFrozen", objectIsFrozen)
+	ctor.AddStaticMethod("groupBy", objectGroupBy)
 
 	ctor.InstanceMembers = objectMemberResolver
+	ctor.IsInstance = objectIsInstance
 
 	return ctor
 }
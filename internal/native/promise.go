@@ -0,0 +1,290 @@
+/*
+ * Implementations of standard elements for the promise type.
+ *
+ * Copyright (C) 2005-2026 J.M. Heisz.  All Rights Reserved.
+ * See the LICENSE file accompanying the distribution your rights to use
+ * this software.
+ */
+
+package native
+
+import (
+	"github.com/heisz/gescript/types"
+)
+
+// Note: in all instance methods, args[0] is 'this', aka the promise instance
+
+func promiseThen(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	prm := args[0].(*types.PromiseType)
+	return prm.Then(prc, types.Arg(args, 1), types.Arg(args, 2)), nil
+}
+
+func promiseCatch(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	prm := args[0].(*types.PromiseType)
+	return prm.Then(prc, types.Undefined, types.Arg(args, 1)), nil
+}
+
+func promiseFinally(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	prm := args[0].(*types.PromiseType)
+	onFinally, ok := types.Arg(args, 1).(types.FunctionType)
+	if !ok {
+		// Per spec, non-callable just passes through the outcome
+		return prm.Then(prc, types.Undefined, types.Undefined), nil
+	}
+
+	// Wrap the handler to run and then pass through the original outcome
+	wrap := func(rejected bool) *types.NativeFunction {
+		return &types.NativeFunction{
+			Name: "finally",
+			Fn: func(prc types.Process,
+				args []types.DataType) (types.DataType, error) {
+				val := types.Arg(args, 0)
+				res, err := onFinally.Call(prc, nil)
+				if err != nil {
+					return nil, err
+				}
+				pass := &types.NativeFunction{
+					Name: "finally",
+					Fn: func(prc types.Process,
+						args []types.DataType) (types.DataType, error) {
+						if rejected {
+							return nil, prc.Throw(val)
+						}
+						return val, nil
+					},
+				}
+				return types.PromiseResolve(prc, res).Then(prc, pass,
+					types.Undefined), nil
+			},
+		}
+	}
+	return prm.Then(prc, wrap(false), wrap(true)), nil
+}
+
+// Resolve properties and methods for the Promise type
+func promiseMemberResolver(target types.DataType, name string) types.DataType {
+	if _, ok := target.(*types.PromiseType); !ok {
+		return nil
+	}
+
+	var method *types.NativeFunction
+	switch name {
+	case "then":
+		method = &types.NativeFunction{Name: "then", Fn: promiseThen}
+	case "catch":
+		method = &types.NativeFunction{Name: "catch", Fn: promiseCatch}
+	case "finally":
+		method = &types.NativeFunction{Name: "finally", Fn: promiseFinally}
+	default:
+		return nil
+	}
+	return &types.NativeMethod{Target: target, Method: method}
+}
+
+func promiseResolve(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	return types.PromiseResolve(prc, types.Arg(args, 0)), nil
+}
+
+func promiseReject(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	prm := types.NewPromise()
+	prm.Reject(prc, types.Arg(args, 0))
+	return prm, nil
+}
+
+// Common method for the combinators, extract the set of promises (or reject)
+func promiseInputs(prc types.Process, args []types.DataType,
+	fnName string) ([]*types.PromiseType, *types.PromiseType) {
+	arr, ok := types.Arg(args, 0).(*types.ArrayType)
+	if !ok {
+		res := types.NewPromise()
+		res.Reject(prc, types.NewError("TypeError",
+			"Promise."+fnName+" requires an iterable argument"))
+		return nil, res
+	}
+
+	inputs := make([]*types.PromiseType, len(arr.Elements))
+	for idx, entry := range arr.Elements {
+		inputs[idx] = types.PromiseResolve(prc, entry)
+	}
+	return inputs, nil
+}
+
+func promiseAll(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	inputs, failed := promiseInputs(prc, args, "all")
+	if failed != nil {
+		return failed, nil
+	}
+
+	res := types.NewPromise()
+	values := types.NewArray(len(inputs))
+	remaining := len(inputs)
+	if remaining == 0 {
+		res.Resolve(prc, values)
+	}
+	for idx, input := range inputs {
+		idx := idx
+		input.OnSettled(prc, func(prc types.Process, val types.DataType,
+			rejected bool) error {
+			if rejected {
+				res.Reject(prc, val)
+				return nil
+			}
+			values.Elements[idx] = val
+			if remaining--; remaining == 0 {
+				res.Resolve(prc, values)
+			}
+			return nil
+		})
+	}
+	return res, nil
+}
+
+func promiseAllSettled(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	inputs, failed := promiseInputs(prc, args, "allSettled")
+	if failed != nil {
+		return failed, nil
+	}
+
+	res := types.NewPromise()
+	values := types.NewArray(len(inputs))
+	remaining := len(inputs)
+	if remaining == 0 {
+		res.Resolve(prc, values)
+	}
+	for idx, input := range inputs {
+		idx := idx
+		input.OnSettled(prc, func(prc types.Process, val types.DataType,
+			rejected bool) error {
+			entry := types.NewObject()
+			if rejected {
+				entry.Set("status", types.StringType("rejected"))
+				entry.Set("reason", val)
+			} else {
+				entry.Set("status", types.StringType("fulfilled"))
+				entry.Set("value", val)
+			}
+			values.Elements[idx] = entry
+			if remaining--; remaining == 0 {
+				res.Resolve(prc, values)
+			}
+			return nil
+		})
+	}
+	return res, nil
+}
+
+func promiseAny(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	inputs, failed := promiseInputs(prc, args, "any")
+	if failed != nil {
+		return failed, nil
+	}
+
+	// Rejection (of all) is an AggregateError with the set of reasons
+	res := types.NewPromise()
+	reasons := types.NewArray(len(inputs))
+	aggregate := func() types.DataType {
+		err := types.NewError("AggregateError", "All promises were rejected")
+		err.Set("errors", reasons)
+		return err
+	}
+	remaining := len(inputs)
+	if remaining == 0 {
+		res.Reject(prc, aggregate())
+	}
+	for idx, input := range inputs {
+		idx := idx
+		input.OnSettled(prc, func(prc types.Process, val types.DataType,
+			rejected bool) error {
+			if !rejected {
+				res.Resolve(prc, val)
+				return nil
+			}
+			reasons.Elements[idx] = val
+			if remaining--; remaining == 0 {
+				res.Reject(prc, aggregate())
+			}
+			return nil
+		})
+	}
+	return res, nil
+}
+
+func promiseRace(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	inputs, failed := promiseInputs(prc, args, "race")
+	if failed != nil {
+		return failed, nil
+	}
+
+	// First to settle wins, promise ignores subsequent settlements
+	res := types.NewPromise()
+	for _, input := range inputs {
+		input.OnSettled(prc, func(prc types.Process, val types.DataType,
+			rejected bool) error {
+			if rejected {
+				res.Reject(prc, val)
+			} else {
+				res.Resolve(prc, val)
+			}
+			return nil
+		})
+	}
+	return res, nil
+}
+
+func promiseWithResolvers(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	prm := types.NewPromise()
+	resolve, reject := prm.ResolvingFunctions()
+
+	res := types.NewObject()
+	res.Set("promise", prm)
+	res.Set("resolve", resolve)
+	res.Set("reject", reject)
+	return res, nil
+}
+
+// Create the Promise global constructor with member elements
+func NewPromiseConstructor() *types.NativeConstructor {
+	ctor := types.NewNativeConstructor("Promise",
+		func(prc types.Process, args []types.DataType) (types.DataType, error) {
+			executor, ok := types.Arg(args, 0).(types.FunctionType)
+			if !ok {
+				return nil, types.ThrowError(prc, "TypeError",
+					"Promise resolver is not a function")
+			}
+
+			// Executor exceptions reject the promise (if not yet resolved)
+			prm := types.NewPromise()
+			resolve, reject := prm.ResolvingFunctions()
+			_, err := executor.Call(prc, []types.DataType{resolve, reject})
+			if err != nil {
+				reject.Call(prc, []types.DataType{prc.Catch(err)})
+			}
+			return prm, nil
+		})
+
+	ctor.InstanceMembers = promiseMemberResolver
+	ctor.IsInstance = func(val types.DataType) bool {
+		_, ok := val.(*types.PromiseType)
+		return ok
+	}
+
+	ctor.AddStaticMethod("resolve", promiseResolve)
+	ctor.AddStaticMethod("reject", promiseReject)
+	ctor.AddStaticMethod("all", promiseAll)
+	ctor.AddStaticMethod("allSettled", promiseAllSettled)
+	ctor.AddStaticMethod("any", promiseAny)
+	ctor.AddStaticMethod("race", promiseRace)
+	ctor.AddStaticMethod("withResolvers", promiseWithResolvers)
+
+	return ctor
+}
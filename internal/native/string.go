@@ -10,192 +10,253 @@ package native
 
 import (
 	"fmt"
+	"math"
 	"strings"
+	"unicode"
+	"unicode/utf16"
 
 	"github.com/heisz/gescript/types"
 )
 
 // Note: in all methods, args[0] is 'this', aka the string instance
 
+// Clamp the (integer) position argument to the range 0 to length, the
+// default value if undefined
+func unitPosition(val types.DataType, length, dflt int) int {
+	if _, ok := val.(types.UndefinedType); ok {
+		return dflt
+	}
+	num := math.Trunc(types.ToNumber(val))
+	if math.IsNaN(num) {
+		return 0
+	}
+	return int(math.Max(0, math.Min(num, float64(length))))
+}
+
+// Determine if the search code units appear at the position in the string
+func hasUnitsAt(units, search []uint16, pos int) bool {
+	if pos < 0 || pos+len(search) > len(units) {
+		return false
+	}
+	for idx, unit := range search {
+		if units[pos+idx] != unit {
+			return false
+		}
+	}
+	return true
+}
+
+// Find the first position (from the start) of the search code units, -1 if
+// not found
+func indexUnits(units, search []uint16, start int) int {
+	for pos := start; pos+len(search) <= len(units); pos++ {
+		if hasUnitsAt(units, search, pos) {
+			return pos
+		}
+	}
+	return -1
+}
+
+// Generate the padding code units to fill the string to the target length
+func padUnits(args []types.DataType, length int) ([]uint16, bool) {
+	targetLen := types.ToInt(types.Arg(args, 1))
+	pad := []uint16{' '}
+	if _, ok := types.Arg(args, 2).(types.UndefinedType); !ok {
+		pad = types.UTF16(types.ToString(args[2]))
+	}
+	if targetLen <= length || len(pad) == 0 {
+		return nil, false
+	}
+	res := make([]uint16, targetLen-length)
+	for idx := range res {
+		res[idx] = pad[idx%len(pad)]
+	}
+	return res, true
+}
+
+func stringAt(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	units := types.UTF16(string(args[0].(types.StringType)))
+	idx := int(math.Trunc(types.ToNumber(types.Arg(args, 1))))
+	if idx < 0 {
+		idx += len(units)
+	}
+	if idx < 0 || idx >= len(units) {
+		return types.Undefined, nil
+	}
+	return types.StringType(types.FromUTF16(units[idx : idx+1])), nil
+}
+
 func stringCharAt(prc types.Process,
 	args []types.DataType) (types.DataType, error) {
-	str := string(args[0].(types.StringType))
 	idx := 0
 	if len(args) > 1 {
 		idx = types.ToInt(args[1])
 	}
-	if idx < 0 || idx >= len(str) {
+	unit, ok := types.ProcessUnitCache(prc).CodeUnitAt(
+		string(args[0].(types.StringType)), idx)
+	if !ok {
 		return types.StringType(""), nil
 	}
-	return types.StringType(str[idx : idx+1]), nil
+	return types.StringType(types.FromUTF16([]uint16{unit})), nil
 }
 
 func stringCharCodeAt(prc types.Process,
 	args []types.DataType) (types.DataType, error) {
-	str := string(args[0].(types.StringType))
 	idx := 0
 	if len(args) > 1 {
 		idx = types.ToInt(args[1])
 	}
-	if idx < 0 || idx >= len(str) {
+	unit, ok := types.ProcessUnitCache(prc).CodeUnitAt(
+		string(args[0].(types.StringType)), idx)
+	if !ok {
 		return types.NaN, nil
 	}
-	return types.IntegerType(str[idx]), nil
+	return types.IntegerType(unit), nil
+}
+
+// Like charCodeAt, but combines surrogate pairs into the code point
+func stringCodePointAt(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	units := types.UTF16(string(args[0].(types.StringType)))
+	idx := 0
+	if len(args) > 1 {
+		idx = types.ToInt(args[1])
+	}
+	if idx < 0 || idx >= len(units) {
+		return types.Undefined, nil
+	}
+	high := rune(units[idx])
+	if high >= 0xD800 && high < 0xDC00 && idx+1 < len(units) {
+		if low := rune(units[idx+1]); low >= 0xDC00 && low <= 0xDFFF {
+			return types.IntegerType(utf16.DecodeRune(high, low)), nil
+		}
+	}
+	return types.IntegerType(high), nil
 }
 
 func stringConcat(prc types.Process,
 	args []types.DataType) (types.DataType, error) {
 	str := string(args[0].(types.StringType))
-	var sb strings.Builder
-	sb.WriteString(str)
 	for _, arg := range args[1:] {
-		sb.WriteString(types.ToString(arg))
+		str = types.ConcatStrings(str, types.ToString(arg))
 	}
-	return types.StringType(sb.String()), nil
+	return types.StringType(str), nil
 }
 
 func stringEndsWith(prc types.Process,
 	args []types.DataType) (types.DataType, error) {
-	str := string(args[0].(types.StringType))
+	units := types.UTF16(string(args[0].(types.StringType)))
 	if len(args) < 2 {
 		return types.BooleanType(false), nil
 	}
-	search := types.ToString(args[1])
-	endIdx := len(str)
-	if len(args) > 2 {
-		endIdx = types.ToInt(args[2])
-		if endIdx < 0 {
-			endIdx = 0
-		}
-		if endIdx > len(str) {
-			endIdx = len(str)
-		}
-	}
-	if endIdx < len(search) {
-		return types.BooleanType(false), nil
-	}
-	return types.BooleanType(strings.HasSuffix(str[:endIdx], search)), nil
+	search := types.UTF16(types.ToString(args[1]))
+	endIdx := unitPosition(types.Arg(args, 2), len(units), len(units))
+	return types.BooleanType(hasUnitsAt(units, search,
+		endIdx-len(search))), nil
 }
 
 func stringIncludes(prc types.Process,
 	args []types.DataType) (types.DataType, error) {
-	str := string(args[0].(types.StringType))
+	units := types.UTF16(string(args[0].(types.StringType)))
 	if len(args) < 2 {
 		return types.BooleanType(false), nil
 	}
-	search := types.ToString(args[1])
-	startIdx := 0
-	if len(args) > 2 {
-		startIdx = types.ToInt(args[2])
-		if startIdx < 0 {
-			startIdx = 0
-		}
-		if startIdx > len(str) {
-			return types.BooleanType(false), nil
-		}
-	}
-	return types.BooleanType(strings.Contains(str[startIdx:], search)), nil
+	search := types.UTF16(types.ToString(args[1]))
+	startIdx := unitPosition(types.Arg(args, 2), len(units), 0)
+	return types.BooleanType(indexUnits(units, search, startIdx) >= 0), nil
 }
 
 func stringIndexOf(prc types.Process,
 	args []types.DataType) (types.DataType, error) {
-	str := string(args[0].(types.StringType))
+	units := types.UTF16(string(args[0].(types.StringType)))
 	if len(args) < 2 {
 		return types.IntegerType(-1), nil
 	}
-	search := types.ToString(args[1])
-	startIdx := 0
-	if len(args) > 2 {
-		startIdx = types.ToInt(args[2])
-		if startIdx < 0 {
-			startIdx = 0
-		}
-	}
-	if startIdx >= len(str) {
-		if search == "" {
-			return types.IntegerType(len(str)), nil
+	search := types.UTF16(types.ToString(args[1]))
+	startIdx := unitPosition(types.Arg(args, 2), len(units), 0)
+	return types.IntegerType(indexUnits(units, search, startIdx)), nil
+}
+
+// Determine if the string contains no lone surrogates
+func stringIsWellFormed(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	str := string(args[0].(types.StringType))
+	return types.BooleanType(types.IsWellFormed(str)), nil
+}
+
+// String iterator (by code point), for Symbol.iterator
+func stringIterator(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	str := string(args[0].(types.StringType))
+	offset := 0
+	return types.NewIterator(func() (types.DataType, bool) {
+		if offset >= len(str) {
+			return nil, false
 		}
-		return types.IntegerType(-1), nil
-	}
-	idx := strings.Index(str[startIdx:], search)
-	if idx == -1 {
-		return types.IntegerType(-1), nil
-	}
-	return types.IntegerType(startIdx + idx), nil
+		cp, size := types.NextCodePoint(str, offset)
+		offset += size
+		return types.StringType(cp), true
+	}), nil
 }
 
 func stringLastIndexOf(prc types.Process,
 	args []types.DataType) (types.DataType, error) {
-	str := string(args[0].(types.StringType))
+	units := types.UTF16(string(args[0].(types.StringType)))
 	if len(args) < 2 {
 		return types.IntegerType(-1), nil
 	}
-	search := types.ToString(args[1])
-	endIdx := len(str)
-	if len(args) > 2 {
-		endIdx = types.ToInt(args[2]) + len(search)
-		if endIdx > len(str) {
-			endIdx = len(str)
-		}
+	search := types.UTF16(types.ToString(args[1]))
+	startIdx := len(units)
+	if len(args) > 2 && !math.IsNaN(types.ToNumber(args[2])) {
+		startIdx = unitPosition(args[2], len(units), len(units))
+	}
+	if startIdx > len(units)-len(search) {
+		startIdx = len(units) - len(search)
 	}
-	if endIdx <= 0 {
-		if search == "" {
-			return types.IntegerType(0), nil
+	for pos := startIdx; pos >= 0; pos-- {
+		if hasUnitsAt(units, search, pos) {
+			return types.IntegerType(pos), nil
 		}
-		return types.IntegerType(-1), nil
 	}
-	idx := strings.LastIndex(str[:endIdx], search)
-	return types.IntegerType(idx), nil
+	return types.IntegerType(-1), nil
 }
 
-func stringPadEnd(prc types.Process,
+// Normalize to the Unicode normalization form (NFC by default)
+func stringNormalize(prc types.Process,
 	args []types.DataType) (types.DataType, error) {
-	str := string(args[0].(types.StringType))
-	targetLen := len(str)
-	padStr := " "
-	if len(args) > 1 {
-		targetLen = types.ToInt(args[1])
+	form := "NFC"
+	if _, ok := types.Arg(args, 1).(types.UndefinedType); !ok {
+		form = types.ToString(args[1])
 	}
-	if len(args) > 2 {
-		padStr = types.ToString(args[2])
-		if padStr == "" {
-			return args[0], nil
-		}
+	switch form {
+	case "NFC", "NFD", "NFKC", "NFKD":
+	default:
+		return nil, types.ThrowError(prc, "RangeError",
+			"The normalization form should be one of NFC, NFD, NFKC, NFKD")
 	}
-	if len(str) >= targetLen {
+	str := string(args[0].(types.StringType))
+	return types.StringType(normalizeString(str, form)), nil
+}
+
+func stringPadEnd(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	units := types.UTF16(string(args[0].(types.StringType)))
+	pad, ok := padUnits(args, len(units))
+	if !ok {
 		return args[0], nil
 	}
-	var sb strings.Builder
-	sb.WriteString(str)
-	for sb.Len() < targetLen {
-		sb.WriteString(padStr)
-	}
-	return types.StringType(sb.String()[:targetLen]), nil
+	return types.StringType(types.FromUTF16(append(units, pad...))), nil
 }
 
 func stringPadStart(prc types.Process,
 	args []types.DataType) (types.DataType, error) {
-	str := string(args[0].(types.StringType))
-	targetLen := len(str)
-	padStr := " "
-	if len(args) > 1 {
-		targetLen = types.ToInt(args[1])
-	}
-	if len(args) > 2 {
-		padStr = types.ToString(args[2])
-		if padStr == "" {
-			return args[0], nil
-		}
-	}
-	if len(str) >= targetLen {
+	units := types.UTF16(string(args[0].(types.StringType)))
+	pad, ok := padUnits(args, len(units))
+	if !ok {
 		return args[0], nil
 	}
-	padNeeded := targetLen - len(str)
-	var sb strings.Builder
-	for sb.Len() < padNeeded {
-		sb.WriteString(padStr)
-	}
-	return types.StringType(sb.String()[:padNeeded] + str), nil
+	return types.StringType(types.FromUTF16(append(pad, units...))), nil
 }
 
 func stringRepeat(prc types.Process,
@@ -236,36 +297,13 @@ func stringReplaceAll(prc types.Process,
 
 func stringSlice(prc types.Process,
 	args []types.DataType) (types.DataType, error) {
-	str := string(args[0].(types.StringType))
-	length := len(str)
-	start := 0
-	end := length
-
-	if len(args) > 1 {
-		start = types.ToInt(args[1])
-		if start < 0 {
-			start = length + start
-			if start < 0 {
-				start = 0
-			}
-		}
-		if start > length {
-			start = length
-		}
-	}
-	if len(args) > 2 {
-		end = types.ToInt(args[2])
-		if end < 0 {
-			end = length + end
-		}
-		if end > length {
-			end = length
-		}
-	}
+	units := types.UTF16(string(args[0].(types.StringType)))
+	start := relativeIndex(types.Arg(args, 1), len(units), 0)
+	end := relativeIndex(types.Arg(args, 2), len(units), len(units))
 	if start > end {
 		return types.StringType(""), nil
 	}
-	return types.StringType(str[start:end]), nil
+	return types.StringType(types.FromUTF16(units[start:end])), nil
 }
 
 func stringSplit(prc types.Process,
@@ -283,10 +321,11 @@ func stringSplit(prc types.Process,
 
 	var parts []string
 	if separator == "" {
-		// No separator, becomes an array of the characters
-		parts = make([]string, len(str))
-		for idx, ch := range str {
-			parts[idx] = string(ch)
+		// No separator, becomes an array of the (UTF-16) characters
+		units := types.UTF16(str)
+		parts = make([]string, len(units))
+		for idx := range units {
+			parts[idx] = types.FromUTF16(units[idx : idx+1])
 		}
 	} else {
 		parts = strings.Split(str, separator)
@@ -305,85 +344,35 @@ func stringSplit(prc types.Process,
 
 func stringStartsWith(prc types.Process,
 	args []types.DataType) (types.DataType, error) {
-	str := string(args[0].(types.StringType))
+	units := types.UTF16(string(args[0].(types.StringType)))
 	if len(args) < 2 {
 		return types.BooleanType(false), nil
 	}
-	search := types.ToString(args[1])
-	startIdx := 0
-	if len(args) > 2 {
-		startIdx = types.ToInt(args[2])
-		if startIdx < 0 {
-			startIdx = 0
-		}
-	}
-	if startIdx > len(str) {
-		return types.BooleanType(false), nil
-	}
-	return types.BooleanType(strings.HasPrefix(str[startIdx:], search)), nil
+	search := types.UTF16(types.ToString(args[1]))
+	startIdx := unitPosition(types.Arg(args, 2), len(units), 0)
+	return types.BooleanType(hasUnitsAt(units, search, startIdx)), nil
 }
 
 func stringSubstr(prc types.Process,
 	args []types.DataType) (types.DataType, error) {
-	str := string(args[0].(types.StringType))
-	length := len(str)
-	start := 0
-	sublen := length
-
-	if len(args) > 1 {
-		start = types.ToInt(args[1])
-		if start < 0 {
-			start = length + start
-			if start < 0 {
-				start = 0
-			}
-		}
-	}
-	if len(args) > 2 {
-		sublen = types.ToInt(args[2])
-		if sublen < 0 {
-			sublen = 0
-		}
+	units := types.UTF16(string(args[0].(types.StringType)))
+	start := relativeIndex(types.Arg(args, 1), len(units), 0)
+	end := len(units)
+	if _, ok := types.Arg(args, 2).(types.UndefinedType); !ok {
+		end = start + unitPosition(args[2], len(units)-start, 0)
 	}
-	if start >= length {
-		return types.StringType(""), nil
-	}
-	end := start + sublen
-	if end > length {
-		end = length
-	}
-	return types.StringType(str[start:end]), nil
+	return types.StringType(types.FromUTF16(units[start:end])), nil
 }
 
 func stringSubstring(prc types.Process,
 	args []types.DataType) (types.DataType, error) {
-	str := string(args[0].(types.StringType))
-	length := len(str)
-	start := 0
-	end := length
-
-	if len(args) > 1 {
-		start = types.ToInt(args[1])
-		if start < 0 {
-			start = 0
-		}
-		if start > length {
-			start = length
-		}
-	}
-	if len(args) > 2 {
-		end = types.ToInt(args[2])
-		if end < 0 {
-			end = 0
-		}
-		if end > length {
-			end = length
-		}
-	}
+	units := types.UTF16(string(args[0].(types.StringType)))
+	start := unitPosition(types.Arg(args, 1), len(units), 0)
+	end := unitPosition(types.Arg(args, 2), len(units), len(units))
 	if start > end {
 		start, end = end, start
 	}
-	return types.StringType(str[start:end]), nil
+	return types.StringType(types.FromUTF16(units[start:end])), nil
 }
 
 func stringToLowerCase(prc types.Process,
@@ -403,6 +392,13 @@ func stringToUpperCase(prc types.Process,
 	return types.StringType(strings.ToUpper(str)), nil
 }
 
+// Replace any lone surrogates with the U+FFFD replacement character
+func stringToWellFormed(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	str := string(args[0].(types.StringType))
+	return types.StringType(types.ToWellFormed(str)), nil
+}
+
 func stringTrim(prc types.Process,
 	args []types.DataType) (types.DataType, error) {
 	str := string(args[0].(types.StringType))
@@ -428,20 +424,26 @@ func stringMemberResolver(target types.DataType, name string) types.DataType {
 		return nil
 	}
 
-	// Length is the only dynamic property for arrays
+	// Length is the only dynamic property for strings (in UTF-16 units)
 	if name == "length" {
-		return types.IntegerType(len(string(str)))
+		return types.IntegerType(types.UTF16Length(string(str)))
 	}
 
 	// Otherwise look up the string instance methods
 	var method *types.NativeFunction
 	switch name {
+	case "at":
+		method = &types.NativeFunction{Name: "at",
+			Fn: stringAt}
 	case "charAt":
 		method = &types.NativeFunction{Name: "charAt",
 			Fn: stringCharAt}
 	case "charCodeAt":
 		method = &types.NativeFunction{Name: "charCodeAt",
 			Fn: stringCharCodeAt}
+	case "codePointAt":
+		method = &types.NativeFunction{Name: "codePointAt",
+			Fn: stringCodePointAt}
 	case "concat":
 		method = &types.NativeFunction{Name: "concat",
 			Fn: stringConcat}
@@ -454,9 +456,15 @@ func stringMemberResolver(target types.DataType, name string) types.DataType {
 	case "indexOf":
 		method = &types.NativeFunction{Name: "indexOf",
 			Fn: stringIndexOf}
+	case "isWellFormed":
+		method = &types.NativeFunction{Name: "isWellFormed",
+			Fn: stringIsWellFormed}
 	case "lastIndexOf":
 		method = &types.NativeFunction{Name: "lastIndexOf",
 			Fn: stringLastIndexOf}
+	case "normalize":
+		method = &types.NativeFunction{Name: "normalize",
+			Fn: stringNormalize}
 	case "padEnd":
 		method = &types.NativeFunction{Name: "padEnd",
 			Fn: stringPadEnd}
@@ -496,6 +504,9 @@ func stringMemberResolver(target types.DataType, name string) types.DataType {
 	case "toUpperCase":
 		method = &types.NativeFunction{Name: "toUpperCase",
 			Fn: stringToUpperCase}
+	case "toWellFormed":
+		method = &types.NativeFunction{Name: "toWellFormed",
+			Fn: stringToWellFormed}
 	case "trim":
 		method = &types.NativeFunction{Name: "trim",
 			Fn: stringTrim}
@@ -508,34 +519,104 @@ func stringMemberResolver(target types.DataType, name string) types.DataType {
 	case "valueOf":
 		method = &types.NativeFunction{Name: "valueOf",
 			Fn: stringToString}
+	case "@@iterator":
+		method = &types.NativeFunction{Name: "[Symbol.iterator]",
+			Fn: stringIterator}
 	default:
 		return nil
 	}
 	return &types.NativeMethod{Target: str, Method: method}
 }
 
+// Create a string from the UTF-16 code units (numbers are taken modulo 2^16)
 func stringFromCharCode(prc types.Process,
 	args []types.DataType) (types.DataType, error) {
-	var sb strings.Builder
+	units := make([]uint16, len(args))
+	for idx, arg := range args {
+		num := types.ToNumber(arg)
+		if !math.IsNaN(num) && !math.IsInf(num, 0) {
+			units[idx] = uint16(int64(math.Trunc(num)))
+		}
+	}
+	return types.StringType(types.FromUTF16(units)), nil
+}
+
+// Ditto for code points, RangeError for invalid (non-integral or too large)
+func stringFromCodePoint(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	units := make([]uint16, 0, len(args))
 	for _, arg := range args {
-		code := types.ToInt(arg)
-		sb.WriteByte(byte(code))
+		num, err := types.ToNumberChecked(prc, arg)
+		if err != nil {
+			return nil, err
+		}
+		if num != math.Trunc(num) || num < 0 || num > unicode.MaxRune {
+			return nil, types.ThrowError(prc, "RangeError",
+				"Invalid code point "+types.ToString(arg))
+		}
+		if num >= 0x10000 {
+			high, low := utf16.EncodeRune(rune(num))
+			units = append(units, uint16(high), uint16(low))
+		} else {
+			units = append(units, uint16(num))
+		}
+	}
+	return types.StringType(types.FromUTF16(units)), nil
+}
+
+// Interleave the raw strings of the template object with the substitutions
+func stringRaw(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	var raw types.DataType = types.Undefined
+	if tmpl, ok := types.Arg(args, 0).(*types.ObjectType); ok {
+		raw = tmpl.Get("raw")
+	}
+	parts, ok, err := iterableValues(prc, raw)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, types.ThrowError(prc, "TypeError",
+			"Cannot convert undefined or null to object")
+	}
+
+	var sb strings.Builder
+	for idx, part := range parts {
+		if idx > 0 && idx < len(args) {
+			sb.WriteString(types.ToString(args[idx]))
+		}
+		sb.WriteString(types.ToString(part))
 	}
 	return types.StringType(sb.String()), nil
 }
 
-// Create the String global constructor with fromCharCode and member elements
+// Create the String global constructor with static and member elements
 func NewStringConstructor() *types.NativeConstructor {
 	ctor := types.NewNativeConstructor("String",
 		func(prc types.Process, args []types.DataType) (types.DataType, error) {
 			if len(args) == 0 {
 				return types.StringType(""), nil
 			}
-			return types.StringType(types.ToString(args[0])), nil
+
+			// Explicit conversion, symbols are described rather than thrown
+			if sym, ok := args[0].(*types.SymbolType); ok {
+				return types.StringType(sym.String()), nil
+			}
+			str, err := types.ToStringChecked(prc, args[0])
+			if err != nil {
+				return nil, err
+			}
+			return types.StringType(str), nil
 		})
 
 	ctor.AddStaticMethod("fromCharCode", stringFromCharCode)
+	ctor.AddStaticMethod("fromCodePoint", stringFromCodePoint)
+	ctor.AddStaticMethod("raw", stringRaw)
 	ctor.InstanceMembers = stringMemberResolver
+	ctor.IsInstance = func(val types.DataType) bool {
+		_, ok := val.(types.StringType)
+		return ok
+	}
 
 	return ctor
 }
@@ -0,0 +1,106 @@
+/*
+ * Implementations of standard elements for the symbol type.
+ *
+ * Copyright (C) 2005-2026 J.M. Heisz.  All Rights Reserved.
+ * See the LICENSE file accompanying the distribution your rights to use
+ * this software.
+ */
+
+package native
+
+import (
+	"github.com/heisz/gescript/types"
+)
+
+// Note: in all instance methods, args[0] is 'this', aka the symbol instance
+
+func symbolToString(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	return types.StringType(args[0].(*types.SymbolType).String()), nil
+}
+
+func symbolValueOf(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	return args[0], nil
+}
+
+// Resolve properties and methods for the Symbol type
+func symbolMemberResolver(target types.DataType, name string) types.DataType {
+	sym, ok := target.(*types.SymbolType)
+	if !ok {
+		return nil
+	}
+
+	// Description is the only property for symbols
+	if name == "description" {
+		return sym.Description()
+	}
+
+	var method *types.NativeFunction
+	switch name {
+	case "toString":
+		method = &types.NativeFunction{Name: "toString",
+			Fn: symbolToString}
+	case "valueOf":
+		method = &types.NativeFunction{Name: "valueOf",
+			Fn: symbolValueOf}
+	default:
+		return nil
+	}
+	return &types.NativeMethod{Target: sym, Method: method}
+}
+
+func symbolFor(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	key, err := types.ToStringChecked(prc, types.Arg(args, 0))
+	if err != nil {
+		return nil, err
+	}
+	return types.SymbolFor(key), nil
+}
+
+func symbolKeyFor(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	sym, ok := types.Arg(args, 0).(*types.SymbolType)
+	if !ok {
+		return nil, types.ThrowError(prc, "TypeError",
+			types.ToString(types.Arg(args, 0))+" is not a symbol")
+	}
+	if key, ok := types.SymbolKeyFor(sym); ok {
+		return types.StringType(key), nil
+	}
+	return types.Undefined, nil
+}
+
+// Create the Symbol global function with registry and well-known symbols
+func NewSymbolConstructor() *types.NativeConstructor {
+	ctor := types.NewNativeConstructor("Symbol",
+		func(prc types.Process, args []types.DataType) (types.DataType, error) {
+			if types.IsConstructing(prc, "Symbol") {
+				return nil, types.ThrowError(prc, "TypeError",
+					"Symbol is not a constructor")
+			}
+			desc := types.Arg(args, 0)
+			if _, ok := desc.(types.UndefinedType); ok {
+				return types.NewAnonymousSymbol(), nil
+			}
+			str, err := types.ToStringChecked(prc, desc)
+			if err != nil {
+				return nil, err
+			}
+			return types.NewSymbol(str), nil
+		})
+
+	ctor.AddStaticMethod("for", symbolFor)
+	ctor.AddStaticMethod("keyFor", symbolKeyFor)
+
+	ctor.AddStaticProperty("asyncIterator", types.SymbolAsyncIterator)
+	ctor.AddStaticProperty("hasInstance", types.SymbolHasInstance)
+	ctor.AddStaticProperty("iterator", types.SymbolIterator)
+	ctor.AddStaticProperty("toPrimitive", types.SymbolToPrimitive)
+	ctor.AddStaticProperty("toStringTag", types.SymbolToStringTag)
+
+	ctor.InstanceMembers = symbolMemberResolver
+
+	return ctor
+}
@@ -0,0 +1,948 @@
+/*
+ * Implementations of the binary data types (ArrayBuffer, DataView and the
+ * typed arrays).
+ *
+ * Copyright (C) 2005-2026 J.M. Heisz.  All Rights Reserved.
+ * See the LICENSE file accompanying the distribution your rights to use
+ * this software.
+ */
+
+package native
+
+import (
+	"math"
+	"strings"
+
+	"github.com/heisz/gescript/types"
+)
+
+// Note: in all instance methods, args[0] is 'this', aka the buffer/view
+
+// Convert the argument to a (non-negative) index or length (Section 7.1.22,
+// ToIndex), RangeError with the message if out of range
+func toIndex(prc types.Process, val types.DataType, msg string) (int, error) {
+	if _, ok := val.(types.UndefinedType); ok {
+		return 0, nil
+	}
+	num, err := types.ToNumberChecked(prc, val)
+	if err != nil {
+		return 0, err
+	}
+	if math.IsNaN(num) {
+		return 0, nil
+	}
+	num = math.Trunc(num)
+	if num < 0 || num > types.MaxArrayBufferLength {
+		return 0, types.ThrowError(prc, "RangeError", msg)
+	}
+	return int(num), nil
+}
+
+// Resolve a relative (negative from end) index argument within the length,
+// the default value if undefined
+func relativeIndex(val types.DataType, length, dflt int) int {
+	if _, ok := val.(types.UndefinedType); ok {
+		return dflt
+	}
+	num := math.Trunc(types.ToNumber(val))
+	switch {
+	case math.IsNaN(num):
+		return 0
+	case num < 0:
+		return int(math.Max(float64(length)+num, 0))
+	}
+	return int(math.Min(num, float64(length)))
+}
+
+// Extract the values from an iterable (or array-like) source, false if the
+// value is not iterable
+func iterableValues(prc types.Process,
+	src types.DataType) ([]types.DataType, bool, error) {
+	switch tsrc := src.(type) {
+	case *types.ArrayType:
+		return tsrc.Elements, true, nil
+	case *types.TypedArrayType:
+		res := make([]types.DataType, tsrc.Length())
+		for idx := range res {
+			res[idx] = tsrc.Get(idx)
+		}
+		return res, true, nil
+	case *types.MapType:
+		res := []types.DataType{}
+		for key, val, pos := tsrc.Next(0); pos >= 0; key, val, pos =
+			tsrc.Next(pos) {
+			res = append(res, mapEntry(key, val))
+		}
+		return res, true, nil
+	case types.StringType:
+		res := []types.DataType{}
+		for offset := 0; offset < len(tsrc); {
+			cp, size := types.NextCodePoint(string(tsrc), offset)
+			res = append(res, types.StringType(cp))
+			offset += size
+		}
+		return res, true, nil
+	case *types.ObjectType:
+		if method, ok := tsrc.GetSymbol(
+			types.SymbolIterator).(types.FunctionType); ok {
+			res, err := iterate(prc, tsrc, method)
+			return res, true, err
+		}
+		if length := tsrc.Get("length"); length != types.Undefined {
+			res := make([]types.DataType, types.ToInt(length))
+			for idx := range res {
+				res[idx] = tsrc.Get(types.ToString(types.IntegerType(idx)))
+			}
+			return res, true, nil
+		}
+	case types.HostObject:
+		// Array-like host objects (e.g. wrapped Go slices)
+		length, err := types.HostGet(prc, tsrc, "length")
+		if err != nil {
+			return nil, false, types.HostError(prc, err)
+		}
+		if length == types.Undefined {
+			return nil, false, nil
+		}
+		res := make([]types.DataType, types.ToInt(length))
+		for idx := range res {
+			res[idx], err = types.HostGet(prc, tsrc,
+				types.ToString(types.IntegerType(idx)))
+			if err != nil {
+				return nil, false, types.HostError(prc, err)
+			}
+		}
+		return res, true, nil
+	}
+	return nil, false, nil
+}
+
+// Collect the values from the iterator protocol for the iterable object
+func iterate(prc types.Process, iterable types.DataType,
+	method types.FunctionType) ([]types.DataType, error) {
+	iter, err := types.CallMethod(prc, method, iterable, nil)
+	if err != nil {
+		return nil, err
+	}
+	var next types.DataType = types.Undefined
+	if obj, ok := iter.(*types.ObjectType); ok {
+		next = obj.Get("next")
+	}
+	nextFn, ok := next.(types.FunctionType)
+	if !ok {
+		return nil, types.ThrowError(prc, "TypeError",
+			"Result of the Symbol.iterator method is not an iterator")
+	}
+
+	res := []types.DataType{}
+	for {
+		step, err := types.CallMethod(prc, nextFn, iter, nil)
+		if err != nil {
+			return nil, err
+		}
+		stepObj, ok := step.(*types.ObjectType)
+		if !ok {
+			return nil, types.ThrowError(prc, "TypeError",
+				"Iterator result "+types.ToString(step)+" is not an object")
+		}
+		if types.IsTruthy(stepObj.Get("done")) {
+			return res, nil
+		}
+		res = append(res, stepObj.Get("value"))
+	}
+}
+
+// Create a typed array of the kind containing the (converted) values
+func typedArrayFrom(prc types.Process, kind types.TypedArrayKind,
+	vals []types.DataType) (*types.TypedArrayType, error) {
+	res := types.NewTypedArray(kind, len(vals))
+	for idx, val := range vals {
+		if err := res.Set(idx, val); err != nil {
+			return nil, types.HostError(prc, err)
+		}
+	}
+	return res, nil
+}
+
+// Extract the callback function argument for the named method
+func callbackArg(prc types.Process, args []types.DataType,
+	method string) (types.FunctionType, error) {
+	callback, ok := types.Arg(args, 1).(types.FunctionType)
+	if !ok {
+		return nil, types.ThrowError(prc, "TypeError",
+			types.ToString(types.Arg(args, 1))+" is not a function ("+
+				method+")")
+	}
+	return callback, nil
+}
+
+// Common implementation of the find/findIndex/findLast/findLastIndex family,
+// the result is the element or the index (-1/undefined if not found)
+func typedArrayFind(method string, fromEnd, index bool) types.NativeFn {
+	return func(prc types.Process,
+		args []types.DataType) (types.DataType, error) {
+		tarr := args[0].(*types.TypedArrayType)
+		callback, err := callbackArg(prc, args, method)
+		if err != nil {
+			return nil, err
+		}
+		for cnt := 0; cnt < tarr.Length(); cnt++ {
+			idx := cnt
+			if fromEnd {
+				idx = tarr.Length() - cnt - 1
+			}
+			val := tarr.Get(idx)
+			res, err := callback.Call(prc, []types.DataType{
+				val, types.IntegerType(idx), tarr,
+			})
+			if err != nil {
+				return nil, err
+			}
+			if types.IsTruthy(res) {
+				if index {
+					return types.IntegerType(idx), nil
+				}
+				return val, nil
+			}
+		}
+		if index {
+			return types.IntegerType(-1), nil
+		}
+		return types.Undefined, nil
+	}
+}
+
+func typedArrayAt(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	tarr := args[0].(*types.TypedArrayType)
+	idx := int(math.Trunc(types.ToNumber(types.Arg(args, 1))))
+	if idx < 0 {
+		idx += tarr.Length()
+	}
+	return tarr.Get(idx), nil
+}
+
+func typedArrayCopyWithin(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	tarr := args[0].(*types.TypedArrayType)
+	length := tarr.Length()
+	target := relativeIndex(types.Arg(args, 1), length, 0)
+	start := relativeIndex(types.Arg(args, 2), length, 0)
+	end := relativeIndex(types.Arg(args, 3), length, length)
+	if start < end {
+		size := tarr.Kind().ElementSize()
+		data := tarr.Bytes()
+		copy(data[target*size:], data[start*size:end*size])
+	}
+	return tarr, nil
+}
+
+func typedArrayEntries(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	tarr := args[0].(*types.TypedArrayType)
+	idx := 0
+	return types.NewIterator(func() (types.DataType, bool) {
+		if idx >= tarr.Length() {
+			return nil, false
+		}
+		idx++
+		return &types.ArrayType{Elements: []types.DataType{
+			types.IntegerType(idx - 1), tarr.Get(idx - 1)}}, true
+	}), nil
+}
+
+func typedArrayEvery(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	tarr := args[0].(*types.TypedArrayType)
+	callback, err := callbackArg(prc, args, "every")
+	if err != nil {
+		return nil, err
+	}
+	for idx := 0; idx < tarr.Length(); idx++ {
+		res, err := callback.Call(prc, []types.DataType{
+			tarr.Get(idx), types.IntegerType(idx), tarr,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if !types.IsTruthy(res) {
+			return types.BooleanType(false), nil
+		}
+	}
+	return types.BooleanType(true), nil
+}
+
+func typedArrayFill(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	tarr := args[0].(*types.TypedArrayType)
+	length := tarr.Length()
+	start := relativeIndex(types.Arg(args, 2), length, 0)
+	end := relativeIndex(types.Arg(args, 3), length, length)
+	for idx := start; idx < end; idx++ {
+		if err := tarr.Set(idx, types.Arg(args, 1)); err != nil {
+			return nil, types.HostError(prc, err)
+		}
+	}
+	return tarr, nil
+}
+
+func typedArrayFilter(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	tarr := args[0].(*types.TypedArrayType)
+	callback, err := callbackArg(prc, args, "filter")
+	if err != nil {
+		return nil, err
+	}
+	vals := []types.DataType{}
+	for idx := 0; idx < tarr.Length(); idx++ {
+		val := tarr.Get(idx)
+		res, err := callback.Call(prc, []types.DataType{
+			val, types.IntegerType(idx), tarr,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if types.IsTruthy(res) {
+			vals = append(vals, val)
+		}
+	}
+	return typedArrayFrom(prc, tarr.Kind(), vals)
+}
+
+func typedArrayForEach(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	tarr := args[0].(*types.TypedArrayType)
+	callback, err := callbackArg(prc, args, "forEach")
+	if err != nil {
+		return nil, err
+	}
+	for idx := 0; idx < tarr.Length(); idx++ {
+		_, err := callback.Call(prc, []types.DataType{
+			tarr.Get(idx), types.IntegerType(idx), tarr,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return types.Undefined, nil
+}
+
+// SameValueZero for includes (NaN is found), strict equality otherwise
+func typedArraySearch(includes, fromEnd bool) types.NativeFn {
+	return func(prc types.Process,
+		args []types.DataType) (types.DataType, error) {
+		tarr := args[0].(*types.TypedArrayType)
+		length := tarr.Length()
+		search := types.Arg(args, 1)
+		nanSearch := false
+		if num, ok := search.(types.NumberType); ok && includes {
+			nanSearch = math.IsNaN(float64(num))
+		}
+
+		start, step, end := relativeIndex(types.Arg(args, 2), length, 0), 1,
+			length
+		if fromEnd {
+			start, step, end = length-1, -1, -1
+			if len(args) > 2 {
+				start = int(math.Trunc(types.ToNumber(args[2])))
+				if start < 0 {
+					start += length
+				}
+				start = int(math.Min(float64(start), float64(length-1)))
+			}
+		}
+		for idx := start; idx != end && idx >= 0; idx += step {
+			val := tarr.Get(idx)
+			match := types.StrictEquals(val, search)
+			if nanSearch {
+				num, ok := val.(types.NumberType)
+				match = ok && math.IsNaN(float64(num))
+			}
+			if match {
+				if includes {
+					return types.BooleanType(true), nil
+				}
+				return types.IntegerType(idx), nil
+			}
+		}
+		if includes {
+			return types.BooleanType(false), nil
+		}
+		return types.IntegerType(-1), nil
+	}
+}
+
+func typedArrayJoin(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	tarr := args[0].(*types.TypedArrayType)
+	sep := ","
+	if _, ok := types.Arg(args, 1).(types.UndefinedType); !ok {
+		sep = types.ToString(args[1])
+	}
+	parts := make([]string, tarr.Length())
+	for idx := range parts {
+		parts[idx] = types.ToString(tarr.Get(idx))
+	}
+	return types.StringType(strings.Join(parts, sep)), nil
+}
+
+func typedArrayKeys(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	tarr := args[0].(*types.TypedArrayType)
+	idx := 0
+	return types.NewIterator(func() (types.DataType, bool) {
+		if idx >= tarr.Length() {
+			return nil, false
+		}
+		idx++
+		return types.IntegerType(idx - 1), true
+	}), nil
+}
+
+func typedArrayMap(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	tarr := args[0].(*types.TypedArrayType)
+	callback, err := callbackArg(prc, args, "map")
+	if err != nil {
+		return nil, err
+	}
+	res := types.NewTypedArray(tarr.Kind(), tarr.Length())
+	for idx := 0; idx < tarr.Length(); idx++ {
+		val, err := callback.Call(prc, []types.DataType{
+			tarr.Get(idx), types.IntegerType(idx), tarr,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := res.Set(idx, val); err != nil {
+			return nil, types.HostError(prc, err)
+		}
+	}
+	return res, nil
+}
+
+// Common implementation of reduce and reduceRight
+func typedArrayReduce(method string, fromEnd bool) types.NativeFn {
+	return func(prc types.Process,
+		args []types.DataType) (types.DataType, error) {
+		tarr := args[0].(*types.TypedArrayType)
+		callback, err := callbackArg(prc, args, method)
+		if err != nil {
+			return nil, err
+		}
+		length := tarr.Length()
+		order := func(cnt int) int {
+			if fromEnd {
+				return length - cnt - 1
+			}
+			return cnt
+		}
+
+		cnt := 0
+		var acc types.DataType
+		if len(args) > 2 {
+			acc = args[2]
+		} else if length > 0 {
+			acc = tarr.Get(order(0))
+			cnt = 1
+		} else {
+			return nil, types.ThrowError(prc, "TypeError",
+				method+" of empty array with no initial value")
+		}
+		for ; cnt < length; cnt++ {
+			idx := order(cnt)
+			acc, err = callback.Call(prc, []types.DataType{
+				acc, tarr.Get(idx), types.IntegerType(idx), tarr,
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+		return acc, nil
+	}
+}
+
+func typedArrayReverse(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	tarr := args[0].(*types.TypedArrayType)
+	for idx, idy := 0, tarr.Length()-1; idx < idy; idx, idy = idx+1, idy-1 {
+		lval, rval := tarr.Get(idx), tarr.Get(idy)
+		_ = tarr.Set(idx, rval)
+		_ = tarr.Set(idy, lval)
+	}
+	return tarr, nil
+}
+
+// Copy values from an array/typed array (or array-like) at the offset
+func typedArraySet(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	tarr := args[0].(*types.TypedArrayType)
+	offset, err := toIndex(prc, types.Arg(args, 2), "offset is out of bounds")
+	if err != nil {
+		return nil, err
+	}
+	vals, ok, err := iterableValues(prc, types.Arg(args, 1))
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, types.ThrowError(prc, "TypeError",
+			"Invalid source for set: "+types.ToString(types.Arg(args, 1)))
+	}
+	if offset+len(vals) > tarr.Length() {
+		return nil, types.ThrowError(prc, "RangeError",
+			"offset is out of bounds")
+	}
+	for idx, val := range vals {
+		if err := tarr.Set(offset+idx, val); err != nil {
+			return nil, types.HostError(prc, err)
+		}
+	}
+	return types.Undefined, nil
+}
+
+func typedArraySlice(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	tarr := args[0].(*types.TypedArrayType)
+	length := tarr.Length()
+	start := relativeIndex(types.Arg(args, 1), length, 0)
+	end := relativeIndex(types.Arg(args, 2), length, length)
+	if end < start {
+		end = start
+	}
+	res := types.NewTypedArray(tarr.Kind(), end-start)
+	copy(res.Bytes(), tarr.Subarray(start, end).Bytes())
+	return res, nil
+}
+
+func typedArraySome(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	tarr := args[0].(*types.TypedArrayType)
+	callback, err := callbackArg(prc, args, "some")
+	if err != nil {
+		return nil, err
+	}
+	for idx := 0; idx < tarr.Length(); idx++ {
+		res, err := callback.Call(prc, []types.DataType{
+			tarr.Get(idx), types.IntegerType(idx), tarr,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if types.IsTruthy(res) {
+			return types.BooleanType(true), nil
+		}
+	}
+	return types.BooleanType(false), nil
+}
+
+// Sort in place, numerically by default (NaN last) or by the comparator
+func typedArraySort(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	tarr := args[0].(*types.TypedArrayType)
+	comparator, err := sortComparator(prc, args)
+	if err != nil {
+		return nil, err
+	}
+
+	vals, _, _ := iterableValues(prc, tarr)
+	err = sortValues(prc, vals, comparator,
+		func(lval, rval types.DataType) bool {
+			if lbig, ok := lval.(types.BigIntType); ok {
+				return lbig.Cmp(rval.(types.BigIntType)) < 0
+			}
+			lnum, rnum := types.ToNumber(lval), types.ToNumber(rval)
+			return lnum < rnum || (!math.IsNaN(lnum) && math.IsNaN(rnum))
+		})
+	if err != nil {
+		return nil, err
+	}
+	for idx, val := range vals {
+		_ = tarr.Set(idx, val)
+	}
+	return tarr, nil
+}
+
+// New view on the same buffer (changes are shared)
+func typedArraySubarray(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	tarr := args[0].(*types.TypedArrayType)
+	length := tarr.Length()
+	begin := relativeIndex(types.Arg(args, 1), length, 0)
+	end := relativeIndex(types.Arg(args, 2), length, length)
+	return tarr.Subarray(begin, end), nil
+}
+
+func typedArrayValues(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	tarr := args[0].(*types.TypedArrayType)
+	idx := 0
+	return types.NewIterator(func() (types.DataType, bool) {
+		if idx >= tarr.Length() {
+			return nil, false
+		}
+		idx++
+		return tarr.Get(idx - 1), true
+	}), nil
+}
+
+// Resolve properties and methods for all of the typed array types
+func typedArrayMemberResolver(target types.DataType,
+	name string) types.DataType {
+	tarr, ok := target.(*types.TypedArrayType)
+	if !ok {
+		return nil
+	}
+
+	switch name {
+	case "BYTES_PER_ELEMENT":
+		return types.IntegerType(tarr.Kind().ElementSize())
+	case "buffer":
+		return tarr.Buffer()
+	case "byteLength":
+		return types.IntegerType(tarr.ByteLength())
+	case "byteOffset":
+		return types.IntegerType(tarr.ByteOffset())
+	case "length":
+		return types.IntegerType(tarr.Length())
+	}
+
+	var fn types.NativeFn
+	switch name {
+	case "at":
+		fn = typedArrayAt
+	case "copyWithin":
+		fn = typedArrayCopyWithin
+	case "entries":
+		fn = typedArrayEntries
+	case "every":
+		fn = typedArrayEvery
+	case "fill":
+		fn = typedArrayFill
+	case "filter":
+		fn = typedArrayFilter
+	case "find":
+		fn = typedArrayFind(name, false, false)
+	case "findIndex":
+		fn = typedArrayFind(name, false, true)
+	case "findLast":
+		fn = typedArrayFind(name, true, false)
+	case "findLastIndex":
+		fn = typedArrayFind(name, true, true)
+	case "forEach":
+		fn = typedArrayForEach
+	case "includes":
+		fn = typedArraySearch(true, false)
+	case "indexOf":
+		fn = typedArraySearch(false, false)
+	case "join":
+		fn = typedArrayJoin
+	case "keys":
+		fn = typedArrayKeys
+	case "lastIndexOf":
+		fn = typedArraySearch(false, true)
+	case "map":
+		fn = typedArrayMap
+	case "reduce":
+		fn = typedArrayReduce(name, false)
+	case "reduceRight":
+		fn = typedArrayReduce(name, true)
+	case "reverse":
+		fn = typedArrayReverse
+	case "set":
+		fn = typedArraySet
+	case "slice":
+		fn = typedArraySlice
+	case "some":
+		fn = typedArraySome
+	case "sort":
+		fn = typedArraySort
+	case "subarray":
+		fn = typedArraySubarray
+	case "toLocaleString", "toString":
+		fn = typedArrayJoin
+	case "values":
+		fn = typedArrayValues
+	case "@@iterator":
+		name = "[Symbol.iterator]"
+		fn = typedArrayValues
+	default:
+		return nil
+	}
+	return &types.NativeMethod{Target: tarr,
+		Method: &types.NativeFunction{Name: name, Fn: fn}}
+}
+
+// Create the typed array constructor for the element kind, from a length,
+// buffer (view), or array/iterable/typed array source (copy)
+func NewTypedArrayConstructor(kind types.TypedArrayKind) *types.NativeConstructor {
+	ctor := types.NewNativeConstructor(kind.String(),
+		func(prc types.Process, args []types.DataType) (types.DataType, error) {
+			src := types.Arg(args, 0)
+			if buf, ok := src.(*types.ArrayBufferType); ok {
+				offset, err := toIndex(prc, types.Arg(args, 1),
+					"Start offset is out of bounds")
+				if err != nil {
+					return nil, err
+				}
+				length := -1
+				if _, ok := types.Arg(args, 2).(types.UndefinedType); !ok {
+					length, err = toIndex(prc, args[2],
+						"Invalid typed array length")
+					if err != nil {
+						return nil, err
+					}
+				}
+				res, err := types.NewTypedArrayView(kind, buf, offset, length)
+				if err != nil {
+					return nil, types.HostError(prc, err)
+				}
+				return res, nil
+			}
+			if _, ok := src.(types.StringType); !ok {
+				vals, ok, err := iterableValues(prc, src)
+				if err != nil {
+					return nil, err
+				}
+				if ok {
+					return typedArrayFrom(prc, kind, vals)
+				}
+			}
+			length, err := toIndex(prc, src, "Invalid typed array length: "+
+				types.ToString(src))
+			if err != nil {
+				return nil, err
+			}
+			if length*kind.ElementSize() > types.MaxArrayBufferLength {
+				return nil, types.ThrowError(prc, "RangeError",
+					"Invalid typed array length: "+types.ToString(src))
+			}
+			return types.NewTypedArray(kind, length), nil
+		})
+
+	ctor.AddStaticMethod("from",
+		func(prc types.Process, args []types.DataType) (types.DataType, error) {
+			vals, ok, err := iterableValues(prc, types.Arg(args, 0))
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				vals = []types.DataType{}
+			}
+			if mapFn, ok := types.Arg(args, 1).(types.FunctionType); ok {
+				mapped := make([]types.DataType, len(vals))
+				for idx, val := range vals {
+					mapped[idx], err = mapFn.Call(prc, []types.DataType{
+						val, types.IntegerType(idx)})
+					if err != nil {
+						return nil, err
+					}
+				}
+				vals = mapped
+			}
+			return typedArrayFrom(prc, kind, vals)
+		})
+	ctor.AddStaticMethod("of",
+		func(prc types.Process, args []types.DataType) (types.DataType, error) {
+			return typedArrayFrom(prc, kind, args)
+		})
+	ctor.AddStaticProperty("BYTES_PER_ELEMENT",
+		types.IntegerType(kind.ElementSize()))
+
+	ctor.InstanceMembers = typedArrayMemberResolver
+	ctor.IsInstance = func(val types.DataType) bool {
+		tarr, ok := val.(*types.TypedArrayType)
+		return ok && tarr.Kind() == kind
+	}
+
+	return ctor
+}
+
+func arrayBufferSlice(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	data := args[0].(*types.ArrayBufferType).Bytes()
+	start := relativeIndex(types.Arg(args, 1), len(data), 0)
+	end := relativeIndex(types.Arg(args, 2), len(data), len(data))
+	if end < start {
+		end = start
+	}
+	res := types.NewArrayBuffer(end - start)
+	copy(res.Bytes(), data[start:end])
+	return res, nil
+}
+
+// Resolve properties and methods for the ArrayBuffer type
+func arrayBufferMemberResolver(target types.DataType,
+	name string) types.DataType {
+	buf, ok := target.(*types.ArrayBufferType)
+	if !ok {
+		return nil
+	}
+
+	switch name {
+	case "byteLength":
+		return types.IntegerType(buf.ByteLength())
+	case "slice":
+		return &types.NativeMethod{Target: buf,
+			Method: &types.NativeFunction{Name: "slice",
+				Fn: arrayBufferSlice}}
+	}
+	return nil
+}
+
+func arrayBufferIsView(prc types.Process,
+	args []types.DataType) (types.DataType, error) {
+	switch types.Arg(args, 0).(type) {
+	case *types.TypedArrayType, *types.DataViewType:
+		return types.BooleanType(true), nil
+	}
+	return types.BooleanType(false), nil
+}
+
+// Create the ArrayBuffer global constructor (zero-filled bytes)
+func NewArrayBufferConstructor() *types.NativeConstructor {
+	ctor := types.NewNativeConstructor("ArrayBuffer",
+		func(prc types.Process, args []types.DataType) (types.DataType, error) {
+			length, err := toIndex(prc, types.Arg(args, 0),
+				"Invalid array buffer length")
+			if err != nil {
+				return nil, err
+			}
+			return types.NewArrayBuffer(length), nil
+		})
+
+	ctor.AddStaticMethod("isView", arrayBufferIsView)
+
+	ctor.InstanceMembers = arrayBufferMemberResolver
+	ctor.IsInstance = func(val types.DataType) bool {
+		_, ok := val.(*types.ArrayBufferType)
+		return ok
+	}
+
+	return ctor
+}
+
+// The DataView accessor methods, by the name suffix (e.g. getUint16)
+var dataViewKinds = map[string]types.TypedArrayKind{
+	"Int8":      types.Int8Array,
+	"Uint8":     types.Uint8Array,
+	"Int16":     types.Int16Array,
+	"Uint16":    types.Uint16Array,
+	"Int32":     types.Int32Array,
+	"Uint32":    types.Uint32Array,
+	"Float32":   types.Float32Array,
+	"Float64":   types.Float64Array,
+	"BigInt64":  types.BigInt64Array,
+	"BigUint64": types.BigUint64Array,
+}
+
+// Read the value of the kind at the offset (big-endian unless specified)
+func dataViewGet(kind types.TypedArrayKind) types.NativeFn {
+	return func(prc types.Process,
+		args []types.DataType) (types.DataType, error) {
+		offset, err := toIndex(prc, types.Arg(args, 1),
+			"Offset is outside the bounds of the DataView")
+		if err != nil {
+			return nil, err
+		}
+		res, err := args[0].(*types.DataViewType).Get(kind, offset,
+			types.IsTruthy(types.Arg(args, 2)))
+		if err != nil {
+			return nil, types.HostError(prc, err)
+		}
+		return res, nil
+	}
+}
+
+// Ditto for writing the value at the offset
+func dataViewSet(kind types.TypedArrayKind) types.NativeFn {
+	return func(prc types.Process,
+		args []types.DataType) (types.DataType, error) {
+		offset, err := toIndex(prc, types.Arg(args, 1),
+			"Offset is outside the bounds of the DataView")
+		if err != nil {
+			return nil, err
+		}
+		err = args[0].(*types.DataViewType).Set(kind, offset,
+			types.Arg(args, 2), types.IsTruthy(types.Arg(args, 3)))
+		if err != nil {
+			return nil, types.HostError(prc, err)
+		}
+		return types.Undefined, nil
+	}
+}
+
+// Resolve properties and methods for the DataView type
+func dataViewMemberResolver(target types.DataType,
+	name string) types.DataType {
+	view, ok := target.(*types.DataViewType)
+	if !ok {
+		return nil
+	}
+
+	switch name {
+	case "buffer":
+		return view.Buffer()
+	case "byteLength":
+		return types.IntegerType(view.ByteLength())
+	case "byteOffset":
+		return types.IntegerType(view.ByteOffset())
+	}
+
+	if len(name) < 4 {
+		return nil
+	}
+	kind, ok := dataViewKinds[name[3:]]
+	if !ok {
+		return nil
+	}
+	var fn types.NativeFn
+	switch name[:3] {
+	case "get":
+		fn = dataViewGet(kind)
+	case "set":
+		fn = dataViewSet(kind)
+	default:
+		return nil
+	}
+	return &types.NativeMethod{Target: view,
+		Method: &types.NativeFunction{Name: name, Fn: fn}}
+}
+
+// Create the DataView global constructor (view on an ArrayBuffer)
+func NewDataViewConstructor() *types.NativeConstructor {
+	ctor := types.NewNativeConstructor("DataView",
+		func(prc types.Process, args []types.DataType) (types.DataType, error) {
+			buf, ok := types.Arg(args, 0).(*types.ArrayBufferType)
+			if !ok {
+				return nil, types.ThrowError(prc, "TypeError",
+					"First argument to DataView constructor must be an "+
+						"ArrayBuffer")
+			}
+			offset, err := toIndex(prc, types.Arg(args, 1),
+				"Start offset is outside the bounds of the buffer")
+			if err != nil {
+				return nil, err
+			}
+			length := -1
+			if _, ok := types.Arg(args, 2).(types.UndefinedType); !ok {
+				length, err = toIndex(prc, args[2], "Invalid DataView length")
+				if err != nil {
+					return nil, err
+				}
+			}
+			res, err := types.NewDataView(buf, offset, length)
+			if err != nil {
+				return nil, types.HostError(prc, err)
+			}
+			return res, nil
+		})
+
+	ctor.InstanceMembers = dataViewMemberResolver
+	ctor.IsInstance = func(val types.DataType) bool {
+		_, ok := val.(*types.DataViewType)
+		return ok
+	}
+
+	return ctor
+}
@@ -0,0 +1,385 @@
+/*
+ * Declaration scanning, hoisting and redeclaration rules for the scopes.
+ *
+ * Copyright (C) 2005-2026 J.M. Heisz.  All Rights Reserved.
+ * See the LICENSE file accompanying the distribution your rights to use
+ * this software.
+ */
+
+package parser
+
+import (
+	"github.com/heisz/gescript/internal/engine"
+	"github.com/heisz/gescript/types"
+)
+
+// Lexical (let/const/function) declaration found by the scan
+type scannedDecl struct {
+	name     string
+	declType varDeclType
+	function bool
+}
+
+// Function declaration pending the (hoisted) initialization of the scope
+type hoistedFunction struct {
+	fn     *engine.ScriptFunction
+	varDef *variable
+}
+
+// Parenthesized lists of these statements are followed by a block, all other
+// parenthesized lists followed by a brace are function parameters
+func isControlParen(token int) bool {
+	switch token {
+	case GTOK_IF, GTOK_WHILE, GTOK_FOR, GTOK_AWAIT, GTOK_SWITCH, GTOK_CATCH,
+		GTOK_WITH:
+		return true
+	}
+	return false
+}
+
+// Determine if the token (with the prior token) starts a statement, for
+// distinguishing function declarations from function expressions
+func isStatementStart(prev int, newline bool) bool {
+	switch prev {
+	case GTOK_LC, GTOK_RC, GTOK_SEMI, GTOK_EXPORT, GTOK_DEFAULT:
+		return true
+	case GTOK_IDENTIFIER, GTOK_LITERAL, GTOK_TEMPLATE, GTOK_RP, GTOK_RB,
+		GTOK_TRUE, GTOK_FALSE, GTOK_NULL, GTOK_THIS:
+		// Automatic semicolon insertion
+		return newline
+	}
+	return false
+}
+
+/*
+ * Section 8.2 (and the declaration instantiation of 10.2.11/14.2.3/16.1.7)
+ *
+ * Being a single pass parser, the declarations of a statement list are found
+ * by scanning the tokens ahead from the start of the list (lexer after the
+ * opening brace or at the start of the source).  Lexical declarations are
+ * those at the top level of the list, var declarations are any within the
+ * function (but not nested functions) for a function level scan.  Note that
+ * this is only a token scan, unusual forms are simply not hoisted.
+ */
+func (prs *parser) scanDeclarations(fnLevel bool) (vars []string,
+	lexical []scannedDecl) {
+	scan := *prs.ctx
+	var sym symType
+
+	// Track the nesting of brackets, where braces following a parameter list
+	// (or an arrow) are function bodies that are not scanned for var
+	type nesting struct {
+		prev   int
+		fnBody bool
+	}
+	var stack []nesting
+	fnDepth := 0
+	prev, prevIdent, prevStart := GTOK_LC, "", true
+	closedParen := GTOK_UNKNOWN
+
+	// State of the declaration list (names follow the keyword and commas)
+	inDecl, expectName, declDepth := false, false, 0
+	declType := DECL_VAR
+
+	for {
+		tok, err := scan.lex(&sym)
+		if err != nil || tok == GTOK_EOF || tok == GTOK_ERROR {
+			return
+		}
+		start := isStatementStart(prev, sym.newline)
+
+		if inDecl {
+			switch {
+			case expectName:
+				expectName = false
+				if tok != GTOK_IDENTIFIER {
+					inDecl = false
+				} else if declType == DECL_VAR {
+					vars = append(vars, sym.identifier)
+				} else {
+					lexical = append(lexical,
+						scannedDecl{name: sym.identifier, declType: declType})
+				}
+			case len(stack) < declDepth:
+				inDecl = false
+			case len(stack) == declDepth && tok == GTOK_COMMA:
+				expectName = true
+			case len(stack) == declDepth &&
+				(tok == GTOK_SEMI || sym.newline):
+				inDecl = false
+			}
+		}
+
+		switch tok {
+		case GTOK_VAR, GTOK_LET, GTOK_CONST:
+			if (tok == GTOK_VAR && fnLevel && fnDepth == 0) ||
+				(tok != GTOK_VAR && len(stack) == 0) {
+				inDecl, expectName, declDepth = true, true, len(stack)
+				declType = DECL_VAR
+				if tok == GTOK_LET {
+					declType = DECL_LET
+				} else if tok == GTOK_CONST {
+					declType = DECL_CONST
+				}
+			}
+		case GTOK_FUNCTION:
+			if len(stack) == 0 && (start ||
+				(prev == GTOK_IDENTIFIER && prevIdent == "async" &&
+					prevStart)) {
+				// Name is the next token, if not this is an expression
+				var name symType
+				named := scan
+				if tok, _ := named.lex(&name); tok == GTOK_IDENTIFIER {
+					lexical = append(lexical, scannedDecl{
+						name:     name.identifier,
+						function: true,
+					})
+				}
+			}
+		case GTOK_LP, GTOK_LB, GTOK_LC:
+			entry := nesting{prev: prev}
+			if tok == GTOK_LC && (prev == GTOK_ARROW ||
+				(prev == GTOK_RP && !isControlParen(closedParen))) {
+				entry.fnBody = true
+				fnDepth++
+			}
+			stack = append(stack, entry)
+		case GTOK_RP, GTOK_RB, GTOK_RC:
+			// Closing the scope itself completes the scan
+			if len(stack) == 0 {
+				return
+			}
+			entry := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if entry.fnBody {
+				fnDepth--
+			}
+			if tok == GTOK_RP {
+				closedParen = entry.prev
+			}
+		}
+
+		prev, prevIdent, prevStart = tok, sym.identifier, start
+	}
+}
+
+// Declare the scanned declarations of the (current) scope and generate the
+// entry operations, placing the lexical bindings in the temporal dead zone
+// and jumping to the (trailing) initialization of the hoisted functions
+func (prs *parser) enterScope(fnLevel bool) {
+	vars, lexical := prs.scanDeclarations(fnLevel)
+	blk := prs.block
+	for _, name := range vars {
+		if _, ok := prs.rootBlock.variables[name]; !ok {
+			prs.rootBlock.defineVariable(prs, name, DECL_VAR)
+		}
+	}
+
+	// Conflicts are ignored here, reported by the subsequent declaration
+	scope := engine.LexicalScope{Block: !fnLevel}
+	hasFunctions := false
+	for _, decl := range lexical {
+		if decl.function {
+			// Functions are variables at the function level, else lexical
+			varDef, ok := blk.variables[decl.name]
+			if !ok {
+				declType := DECL_LET
+				if blk == prs.rootBlock {
+					declType = DECL_VAR
+				}
+				varDef, _ = blk.defineVariable(prs, decl.name, declType)
+				varDef.predeclared = true
+				varDef.function = true
+			} else if varDef.declType != DECL_VAR && !varDef.function {
+				continue
+			}
+			varDef.initialized = true
+			varDef.hoisted = true
+			hasFunctions = true
+			continue
+		}
+
+		if _, ok := blk.variables[decl.name]; ok {
+			continue
+		}
+		varDef, _ := blk.defineVariable(prs, decl.name, decl.declType)
+		varDef.predeclared = true
+		scope.Names = append(scope.Names, decl.name)
+		scope.Slots = append(scope.Slots, varDef.slotIndex)
+	}
+
+	if len(scope.Slots) != 0 {
+		op := prs.pushOpCode(engine.EnterScopeOperation, 0)
+		op.OpData = scope
+	}
+	if hasFunctions {
+		blk.hoistJump = prs.pushOpCode(engine.JumpOperation, 0)
+		blk.hoistResume = len(prs.body.Code)
+	}
+}
+
+// Complete the scope with the initialization of the hoisted functions, which
+// is jumped to from the start of the scope (returning to the first statement)
+func (prs *parser) exitScope() {
+	blk := prs.block
+	if blk.hoistJump == nil {
+		return
+	}
+
+	skipOp := prs.pushOpCode(engine.JumpOperation, 0)
+	blk.hoistJump.OpData = len(prs.body.Code)
+	for _, hoisted := range blk.hoisted {
+		prs.pushFunctionStore(hoisted.fn, hoisted.varDef)
+	}
+	resumeOp := prs.pushOpCode(engine.JumpOperation, 0)
+	resumeOp.OpData = blk.hoistResume
+	skipOp.OpData = len(prs.body.Code)
+}
+
+// Determine if the parse is in the top level of a script, where functions
+// (and implicit assignments) are also globals of the context (including the
+// code of a direct eval called from the top level)
+func (prs *parser) atScriptTop() bool {
+	return prs.module == nil &&
+		(prs.outerScope == nil || prs.outerScope == prs.globalEval)
+}
+
+// Strict mode code cannot bind eval or arguments (Section 13.1.1), false
+// (with error) if the name is not a valid binding
+func (prs *parser) checkStrictBinding(name string) bool {
+	if prs.strict && (name == "eval" || name == "arguments") {
+		prs.addError("Unexpected '" + name + "' binding in strict mode")
+		return false
+	}
+	return true
+}
+
+// Nor can strict mode code assign to them (Section 13.15.1), false (with
+// error) if the assignment/update target is such a reference
+func (prs *parser) checkStrictTarget(target *symType) bool {
+	switch target.parseType {
+	case PARSED_IDENTIFIER, PARSED_CAPTURE_REFERENCE, PARSED_GLOBAL_REFERENCE:
+		name := target.identifier
+		if prs.strict && (name == "eval" || name == "arguments") {
+			prs.addError("Unexpected '" + name +
+				"' assignment in strict mode")
+			return false
+		}
+	}
+	return true
+}
+
+// Declare the variable in the current scope (var in the function scope),
+// reporting conflicting declarations as per Sections 14.2.1.1/14.3.1.1
+func (prs *parser) declareVariable(name string,
+	declType varDeclType) *variable {
+	if !prs.checkStrictBinding(name) {
+		return nil
+	}
+	if declType == DECL_VAR {
+		// Cannot hoist past a lexical declaration of the same name
+		for blk := prs.block; blk != nil; blk = blk.parent {
+			vr, ok := blk.variables[name]
+			if ok && vr.declType != DECL_VAR && !vr.catchParam {
+				prs.addError("Cannot redeclare '" + name + "' in this scope")
+				return nil
+			}
+		}
+		varDef, _ := prs.rootBlock.defineVariable(prs, name, DECL_VAR)
+		return varDef
+	}
+
+	// Lexical declarations were predeclared in the scope by the scan
+	existing, ok := prs.block.variables[name]
+	if ok && existing.predeclared && !existing.function &&
+		existing.declType == declType {
+		existing.predeclared = false
+		return existing
+	}
+	varDef, ok := prs.block.defineVariable(prs, name, declType)
+	if !ok {
+		prs.addError("Cannot redeclare '" + name + "' in this scope")
+		return nil
+	}
+	return varDef
+}
+
+// Define the variable for a declared function and store the function value,
+// where the initialization is hoisted to the start of the scope if scanned
+func (prs *parser) declareFunction(fn *engine.ScriptFunction) {
+	blk := prs.block
+	var varDef *variable
+	if blk == prs.rootBlock {
+		varDef = prs.declareVariable(fn.Name, DECL_VAR)
+	} else {
+		// Block functions are lexical, duplicates allowed for sloppy mode
+		existing, ok := blk.variables[fn.Name]
+		if ok && existing.function && (existing.predeclared || !prs.strict) {
+			existing.predeclared = false
+			varDef = existing
+		} else {
+			varDef = prs.declareVariable(fn.Name, DECL_LET)
+			if varDef != nil {
+				varDef.function = true
+			}
+		}
+	}
+	if varDef == nil {
+		return
+	}
+	varDef.initialized = true
+	prs.noteDeclaration(fn.Name)
+
+	if varDef.hoisted && blk.hoistJump != nil {
+		blk.hoisted = append(blk.hoisted, hoistedFunction{fn, varDef})
+	} else {
+		prs.pushFunctionStore(fn, varDef)
+	}
+
+	// Annex B.3.3, sloppy block functions are also function scope variables
+	// (assigned when the declaration is evaluated), unless that would conflict
+	if blk == prs.rootBlock || prs.strict {
+		return
+	}
+	for outer := blk.parent; outer != nil; outer = outer.parent {
+		if vr, ok := outer.variables[fn.Name]; ok && vr.declType != DECL_VAR {
+			return
+		}
+	}
+	fnVar, _ := prs.rootBlock.defineVariable(prs, fn.Name, DECL_VAR)
+	op := prs.pushOpCode(engine.LoadVariableOperation, 1)
+	op.OpData = varDef.slotIndex
+	if prs.atScriptTop() {
+		globalOp := prs.pushOpCode(engine.StoreGlobalOperation, 0)
+		globalOp.OpData = fn.Name
+	}
+	op = prs.pushOpCode(engine.StoreVariableOperation, -1)
+	op.OpData = fnVar.slotIndex
+}
+
+// Generate the function (closure) value and store it in the variable
+func (prs *parser) pushFunctionStore(fn *engine.ScriptFunction,
+	varDef *variable) {
+	op := prs.pushOpCode(engine.PushFunctionOperation, 1)
+	op.OpData = types.DataType(fn)
+
+	// Top level script functions are also globals (not so for modules)
+	if prs.atScriptTop() && prs.block == prs.rootBlock {
+		globalOp := prs.pushOpCode(engine.StoreGlobalOperation, 0)
+		globalOp.OpData = fn.Name
+	}
+
+	op = prs.pushOpCode(engine.StoreVariableOperation, -1)
+	op.OpData = varDef.slotIndex
+}
+
+// Parse a statement list in a new block scope (up to the closing brace)
+func (prs *parser) parseScopedStatementList() {
+	prs.block = newBlock(prs.block)
+	prs.blockDepth++
+	prs.enterScope(false)
+	prs.parseStatementList()
+	prs.exitScope()
+	prs.blockDepth--
+	prs.block = prs.block.parent
+}
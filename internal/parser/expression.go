@@ -16,9 +16,30 @@ import (
 // RBP that stops at comma operator (lbp=5), used when comma is a separator
 const RBP_NO_COMMA = 6
 
+// RBP for the operand of the unary operators (above the exponent operator)
+const RBP_UNARY = 70
+
+// Legacy octal numbers and string escapes are not strict (Section 12.9.4.1
+// and B.1.2), false (with error) if the literal is such a legacy form
+func (prs *parser) checkLegacyOctal(sym *symType) bool {
+	if !sym.legacyOctal || !prs.strict {
+		return true
+	}
+	if _, isStr := sym.literal.(types.StringType); isStr {
+		prs.addError("Octal escape sequences are not allowed in strict mode")
+	} else {
+		prs.addError("Octal literals are not allowed in strict mode")
+	}
+	return false
+}
+
 // Various null and left denotation functions used in the Pratt algorithm below
 
 func literalNud(prs *parser, prec *precDefn, sym *symType) *symType {
+	if !prs.checkLegacyOctal(sym) {
+		return nil
+	}
+
 	// Lexer defined the symbol but need to translate keyword literals
 	rs := *sym
 	switch sym.token {
@@ -38,7 +59,7 @@ func literalNud(prs *parser, prec *precDefn, sym *symType) *symType {
 
 func functionExprNud(prs *parser, prec *precDefn, sym *symType) *symType {
 	// Parse the full script function instance (name is optional)
-	fn := prs.parseFunctionDecl(false, false, nil)
+	fn := prs.parseFunctionDecl(false, false, false, nil, sym.start)
 	if fn == nil {
 		return nil
 	}
@@ -53,12 +74,26 @@ func functionExprNud(prs *parser, prec *precDefn, sym *symType) *symType {
 	return &rs
 }
 
+// Arrow is a restricted production, no line terminator before the '=>'
+func (prs *parser) checkArrowLine() bool {
+	if prs.ctx.sym.newline {
+		prs.addError("Line terminator not permitted before arrow")
+		return false
+	}
+	return true
+}
+
 func arrowLed(prs *parser, prec *precDefn, sym *symType,
 	left *symType) *symType {
 	// This led only occurs for form of single arg: x => expr
+	if sym.newline {
+		prs.addError("Line terminator not permitted before arrow")
+		return nil
+	}
 	switch left.parseType {
 	case PARSED_IDENTIFIER, PARSED_GLOBAL_REFERENCE:
-		return prs.parseArrowFunctionBody([]string{left.identifier})
+		return prs.parseArrowFunctionBody([]string{left.identifier}, false,
+			left.start)
 	default:
 		prs.addError("Invalid arrow function, expect identifier/arg on left")
 		return nil
@@ -79,7 +114,15 @@ func thisNud(prs *parser, prec *precDefn, sym *symType) *symType {
 			}
 		}
 
-		// No this binding available (global scope?), return undefined
+		// No this binding available (global scope), which is the global
+		// object for non-strict code
+		if !prs.strict {
+			op := prs.pushOpCode(engine.LoadGlobalOperation, 1)
+			op.OpData = "globalThis"
+			rs := *sym
+			rs.parseType = PARSED_VALUE
+			return &rs
+		}
 		rs := *sym
 		rs.parseType = PARSED_LITERAL
 		rs.literal = types.Undefined
@@ -104,6 +147,14 @@ func identifierNud(prs *parser, prec *precDefn, sym *symType) *symType {
 
 	// Resolve the variable in the block/scope chain
 	varDef := prs.block.resolveVariable(sym.identifier)
+
+	// Contextual async keyword for function/arrow expressions
+	if varDef == nil && sym.identifier == "async" {
+		switch prs.ctx.sym.token {
+		case GTOK_FUNCTION, GTOK_IDENTIFIER, GTOK_LP:
+			return prs.parseAsyncExpression(sym)
+		}
+	}
 	if varDef == nil {
 		// Variable is not locally declared, find in closure if applicable
 		if prs.outerScope != nil {
@@ -130,13 +181,6 @@ func identifierNud(prs *parser, prec *precDefn, sym *symType) *symType {
 		return &rs
 	}
 
-	// Capture uninitialized let/const access (strict)
-	if !varDef.initialized && varDef.declType != DECL_VAR {
-		prs.addError("Cannot access '" + sym.identifier +
-			"' before initialization")
-		return nil
-	}
-
 	// Leave as identifier for either retrieve or assignment
 	rs := *sym
 	rs.parseType = PARSED_IDENTIFIER
@@ -149,6 +193,9 @@ func assignmentLed(prs *parser, prec *precDefn, sym *symType,
 		prs.addError("Invalid left-hand side in assignment")
 		return nil
 	}
+	if !prs.checkStrictTarget(left) {
+		return nil
+	}
 
 	// Handle different assignment targets
 	switch left.parseType {
@@ -161,7 +208,7 @@ func assignmentLed(prs *parser, prec *precDefn, sym *symType,
 		}
 
 		// Check for const reassignment
-		if varDef.declType == DECL_CONST && varDef.initialized {
+		if varDef.declType == DECL_CONST {
 			prs.addError("Cannot reassign constant '" + left.identifier + "'")
 			return nil
 		}
@@ -173,8 +220,10 @@ func assignmentLed(prs *parser, prec *precDefn, sym *symType,
 		}
 
 		// Store value but leave on stack (assignment has expression value)
+		withOps := prs.pushWithLookups(left.identifier, true)
 		op := prs.pushOpCode(engine.StoreVariableKeepOperation, 0)
 		op.OpData = varDef.slotIndex
+		prs.patchWithLookups(withOps)
 
 		// Variable has initialization now, mark for use check
 		varDef.initialized = true
@@ -190,8 +239,22 @@ func assignmentLed(prs *parser, prec *precDefn, sym *symType,
 		}
 
 		// Store value but leave on stack (assignment has expression value)
+		withOps := prs.pushWithLookups(left.identifier, true)
 		op := prs.pushOpCode(engine.StoreCaptureKeepOperation, 0)
 		op.OpData = capIdx
+		prs.patchWithLookups(withOps)
+
+	case PARSED_GLOBAL_REFERENCE:
+		// Undeclared, implicit global (sloppy) or existing global (strict)
+		right := prs.parseExpression(prec.lbp - 1)
+		if right == nil || !prs.pushEvalExpression(right) {
+			return nil
+		}
+
+		withOps := prs.pushWithLookups(left.identifier, true)
+		op := prs.pushOpCode(engine.AssignGlobalOperation, 0)
+		op.OpData = left.identifier
+		prs.patchWithLookups(withOps)
 
 	case PARSED_ARRAY_REFERENCE:
 		// Target/index already handled in prior led, just evaluate and assign
@@ -222,6 +285,88 @@ func assignmentLed(prs *parser, prec *precDefn, sym *symType,
 	return &rs
 }
 
+// Compound assignment (e.g. +=), the target reference is evaluated once and
+// retained for the store of the operation result
+func compoundAssignmentLed(prs *parser, prec *precDefn, sym *symType,
+	left *symType) *symType {
+	if left == nil {
+		prs.addError("Invalid left-hand side in assignment")
+		return nil
+	}
+	if !prs.checkStrictTarget(left) {
+		return nil
+	}
+
+	// Load the current value of the target
+	var varDef *variable
+	switch left.parseType {
+	case PARSED_IDENTIFIER:
+		varDef = prs.block.resolveVariable(left.identifier)
+		if varDef == nil {
+			prs.addError("Undefined variable '" + left.identifier + "'")
+			return nil
+		}
+		if varDef.declType == DECL_CONST {
+			prs.addError("Cannot reassign constant '" + left.identifier + "'")
+			return nil
+		}
+		prs.pushEvalExpression(left)
+
+	case PARSED_CAPTURE_REFERENCE, PARSED_GLOBAL_REFERENCE:
+		prs.pushEvalExpression(left)
+
+	case PARSED_ARRAY_REFERENCE:
+		// Retain the target and index for the subsequent store
+		prs.pushOpCode(engine.DupPairOperation, 2)
+		prs.pushOpCode(engine.GetElementOperation, -1)
+
+	case PARSED_MEMBER_REFERENCE:
+		// Retain the target for the subsequent store
+		prs.pushOpCode(engine.DupOperation, 1)
+		op := prs.pushOpCode(engine.GetPropertyOperation, 0)
+		op.OpData = left.identifier
+
+	default:
+		prs.addError("Invalid left-hand side in assignment")
+		return nil
+	}
+
+	// Parse the right-hand side (right associative) and apply the operation
+	right := prs.parseExpression(prec.lbp - 1)
+	if right == nil || !prs.pushEvalExpression(right) {
+		return nil
+	}
+	prs.pushBinaryOperation(sym.assignOp)
+
+	// Store value but leave on stack (assignment has expression value)
+	var withOps []*engine.OpCode
+	switch left.parseType {
+	case PARSED_IDENTIFIER, PARSED_CAPTURE_REFERENCE, PARSED_GLOBAL_REFERENCE:
+		withOps = prs.pushWithLookups(left.identifier, true)
+	}
+	switch left.parseType {
+	case PARSED_IDENTIFIER:
+		op := prs.pushOpCode(engine.StoreVariableKeepOperation, 0)
+		op.OpData = varDef.slotIndex
+	case PARSED_CAPTURE_REFERENCE:
+		op := prs.pushOpCode(engine.StoreCaptureKeepOperation, 0)
+		op.OpData = left.assignOp
+	case PARSED_GLOBAL_REFERENCE:
+		op := prs.pushOpCode(engine.AssignGlobalOperation, 0)
+		op.OpData = left.identifier
+	case PARSED_ARRAY_REFERENCE:
+		prs.pushOpCode(engine.SetElementOperation, -2)
+	case PARSED_MEMBER_REFERENCE:
+		op := prs.pushOpCode(engine.SetPropertyOperation, -1)
+		op.OpData = left.identifier
+	}
+	prs.patchWithLookups(withOps)
+
+	rs := *sym
+	rs.parseType = PARSED_VALUE
+	return &rs
+}
+
 // This has lots of cases due to () being possible grouping or argset
 func parenNud(prs *parser, prec *precDefn, sym *symType) *symType {
 	// Quick check for no-arg arrow function
@@ -231,8 +376,11 @@ func parenNud(prs *parser, prec *precDefn, sym *symType) *symType {
 		}
 		if prs.ctx.sym.token == GTOK_ARROW {
 			// Consume the arrow and parse the function body (no args)
+			if !prs.checkArrowLine() {
+				return nil
+			}
 			prs.lex()
-			return prs.parseArrowFunctionBody(nil)
+			return prs.parseArrowFunctionBody(nil, false, sym.start)
 		}
 		prs.addError("Unexpected empty parentheses")
 		return nil
@@ -240,7 +388,8 @@ func parenNud(prs *parser, prec *precDefn, sym *symType) *symType {
 
 	// Possible arrow paramter set
 	if prs.ctx.sym.token == GTOK_IDENTIFIER {
-		ident := prs.ctx.sym.identifier
+		identSym := prs.ctx.sym
+		ident := identSym.identifier
 		tok := prs.lex()
 
 		// Continue to collect variable/parameter list, if applicable
@@ -271,27 +420,23 @@ func parenNud(prs *parser, prec *precDefn, sym *symType) *symType {
 			}
 			if prs.ctx.sym.token == GTOK_ARROW {
 				// Consume the arrow and parse the function body (with args)
+				if !prs.checkArrowLine() {
+					return nil
+				}
 				prs.lex()
-				return prs.parseArrowFunctionBody(varlist)
+				return prs.parseArrowFunctionBody(varlist, false, sym.start)
 			}
 
 			// Otherwise it's a comma expression, last var is result
 			if len(varlist) > 1 {
 				// Execute resolve/load for all but last
 				for idx := 0; idx < len(varlist)-1; idx++ {
-					varName := varlist[idx]
-					varDef := prs.block.resolveVariable(varName)
-					if varDef == nil {
-						op := prs.pushOpCode(engine.LoadGlobalOperation, 1)
-						op.OpData = varName
-					} else {
-						if !varDef.initialized && varDef.declType != DECL_VAR {
-							prs.addError("Cannot access '" + varName +
-								"' before initialization")
-							return nil
-						}
-						op := prs.pushOpCode(engine.LoadVariableOperation, 1)
-						op.OpData = varDef.slotIndex
+					if !prs.pushEvalExpression(identifierNud(prs, nil,
+						&symType{
+							token:      GTOK_IDENTIFIER,
+							identifier: varlist[idx],
+						})) {
+						return nil
 					}
 					prs.pushOpCode(engine.PopOperation, -1)
 				}
@@ -301,26 +446,14 @@ func parenNud(prs *parser, prec *precDefn, sym *symType) *symType {
 			}
 
 			// Return the final identifier as result
-			rs := &symType{
+			return identifierNud(prs, nil, &symType{
 				token:      GTOK_IDENTIFIER,
 				identifier: ident,
-			}
-			varDef := prs.block.resolveVariable(ident)
-			if varDef == nil {
-				rs.parseType = PARSED_GLOBAL_REFERENCE
-			} else {
-				if !varDef.initialized && varDef.declType != DECL_VAR {
-					prs.addError("Cannot access '" + ident +
-						"' before initialization")
-					return nil
-				}
-				rs.parseType = PARSED_IDENTIFIER
-			}
-			return rs
+			})
 		}
 
 		// Not a varlist, just a grouped expression with leading identifier
-		expr := prs.parseExpressionWithIdentifier(0, ident)
+		expr := prs.parseExpressionWithIdentifier(0, identSym)
 		if expr == nil {
 			return nil
 		}
@@ -355,10 +488,22 @@ func parenNud(prs *parser, prec *precDefn, sym *symType) *symType {
 	return expr
 }
 
+// Section 13.6, the unary expression cannot be the base of the exponent
+// operator (-2 ** 2 is ambiguous), parentheses are required
+func (prs *parser) checkUnaryExponent() bool {
+	if prs.ctx.sym.token == GTOK_EXP {
+		prs.addError("Unary operator used immediately before exponentiation " +
+			"expression, parentheses are required")
+		return false
+	}
+	return true
+}
+
 func unaryNud(prs *parser, prec *precDefn, sym *symType) *symType {
 	// Parse/push the operand with unary precedence onto the stack
-	expr := prs.parseExpression(prec.lbp)
-	if expr == nil || !prs.pushEvalExpression(expr) {
+	expr := prs.parseExpression(RBP_UNARY)
+	if expr == nil || !prs.checkUnaryExponent() ||
+		!prs.pushEvalExpression(expr) {
 		return nil
 	}
 
@@ -382,7 +527,8 @@ func unaryNud(prs *parser, prec *precDefn, sym *symType) *symType {
 func typeofNud(prs *parser, prec *precDefn, sym *symType) *symType {
 	// Parse the operand with unary precedence
 	expr := prs.parseExpression(prec.lbp)
-	if expr == nil || !prs.pushEvalExpression(expr) {
+	if expr == nil || !prs.checkUnaryExponent() ||
+		!prs.pushEvalExpression(expr) {
 		return nil
 	}
 
@@ -396,7 +542,7 @@ func typeofNud(prs *parser, prec *precDefn, sym *symType) *symType {
 func deleteNud(prs *parser, prec *precDefn, sym *symType) *symType {
 	// Parse the operand - should be a property or element reference
 	expr := prs.parseExpression(prec.lbp)
-	if expr == nil {
+	if expr == nil || !prs.checkUnaryExponent() {
 		return nil
 	}
 
@@ -407,6 +553,23 @@ func deleteNud(prs *parser, prec *precDefn, sym *symType) *symType {
 		op.OpData = expr.identifier
 	case PARSED_ARRAY_REFERENCE:
 		prs.pushOpCode(engine.DeleteElementOperation, -1)
+	case PARSED_IDENTIFIER, PARSED_GLOBAL_REFERENCE, PARSED_CAPTURE_REFERENCE:
+		if prs.strict {
+			prs.addError("Delete of an unqualified identifier in strict mode")
+			return nil
+		}
+
+		// Within a with statement the property of the object is deleted
+		withOps := prs.pushWithOps(expr.identifier,
+			engine.WithDeleteOperation, 0)
+		scopes := prs.withScopes
+		prs.withScopes = nil
+		prs.pushEvalExpression(expr)
+		prs.withScopes = scopes
+		prs.pushOpCode(engine.PopOperation, -1)
+		op := prs.pushOpCode(engine.PushLiteralValue, 1)
+		op.OpData = types.BooleanType(true)
+		prs.patchWithLookups(withOps)
 	default:
 		// Deleting non-reference returns true (no-op per spec)
 		prs.pushEvalExpression(expr)
@@ -420,6 +583,74 @@ func deleteNud(prs *parser, prec *precDefn, sym *symType) *symType {
 	return &rs
 }
 
+// Increment/decrement of a variable, a local slot is updated directly but
+// captures, globals and with scope candidates use the load/store sequences
+func (prs *parser) pushVariableIncrDecr(ref *symType, incr, prefix bool) bool {
+	if !prs.checkStrictTarget(ref) {
+		return false
+	}
+	if ref.parseType == PARSED_IDENTIFIER {
+		varDef := prs.block.resolveVariable(ref.identifier)
+		if varDef == nil {
+			prs.addError("Undefined variable '" + ref.identifier + "'")
+			return false
+		}
+		if varDef.declType == DECL_CONST {
+			prs.addError("Cannot modify constant '" + ref.identifier + "'")
+			return false
+		}
+
+		if len(prs.withScopes) == 0 {
+			var op *engine.OpCode
+			switch {
+			case incr && prefix:
+				op = prs.pushOpCode(engine.PreIncrementOperation, 1)
+			case prefix:
+				op = prs.pushOpCode(engine.PreDecrementOperation, 1)
+			case incr:
+				op = prs.pushOpCode(engine.PostIncrementOperation, 1)
+			default:
+				op = prs.pushOpCode(engine.PostDecrementOperation, 1)
+			}
+			op.OpData = varDef.slotIndex
+			return true
+		}
+	}
+
+	// Retain the original value for postfix, store the updated value
+	if !prs.pushEvalExpression(ref) {
+		return false
+	}
+	if !prefix {
+		prs.pushOpCode(engine.DupOperation, 1)
+	}
+	if incr {
+		prs.pushOpCode(engine.IncrementValueOperation, 0)
+	} else {
+		prs.pushOpCode(engine.DecrementValueOperation, 0)
+	}
+
+	withOps := prs.pushWithLookups(ref.identifier, true)
+	var op *engine.OpCode
+	switch ref.parseType {
+	case PARSED_IDENTIFIER:
+		op = prs.pushOpCode(engine.StoreVariableKeepOperation, 0)
+		op.OpData = prs.block.resolveVariable(ref.identifier).slotIndex
+	case PARSED_CAPTURE_REFERENCE:
+		op = prs.pushOpCode(engine.StoreCaptureKeepOperation, 0)
+		op.OpData = ref.assignOp
+	default:
+		op = prs.pushOpCode(engine.AssignGlobalOperation, 0)
+		op.OpData = ref.identifier
+	}
+	prs.patchWithLookups(withOps)
+
+	if !prefix {
+		prs.pushOpCode(engine.PopOperation, -1)
+	}
+	return true
+}
+
 func prefixIncrDecrNud(prs *parser, prec *precDefn, sym *symType) *symType {
 	// Parse precedence just below member/element to get correct target
 	operand := prs.parseExpression(84)
@@ -429,25 +660,10 @@ func prefixIncrDecrNud(prs *parser, prec *precDefn, sym *symType) *symType {
 
 	// Select appropriate operations based on parsed operand
 	switch operand.parseType {
-	case PARSED_IDENTIFIER:
-		// Standard variable increment/decrement
-		varDef := prs.block.resolveVariable(operand.identifier)
-		if varDef == nil {
-			prs.addError("Undefined variable '" + operand.identifier + "'")
+	case PARSED_IDENTIFIER, PARSED_CAPTURE_REFERENCE, PARSED_GLOBAL_REFERENCE:
+		if !prs.pushVariableIncrDecr(operand, sym.token == GTOK_INCR, true) {
 			return nil
 		}
-		if varDef.declType == DECL_CONST {
-			prs.addError("Cannot modify constant '" + operand.identifier + "'")
-			return nil
-		}
-
-		var op *engine.OpCode
-		if sym.token == GTOK_INCR {
-			op = prs.pushOpCode(engine.PreIncrementOperation, 1)
-		} else {
-			op = prs.pushOpCode(engine.PreDecrementOperation, 1)
-		}
-		op.OpData = varDef.slotIndex
 
 	case PARSED_ARRAY_REFERENCE:
 		// Element led has already stored target and index operations
@@ -486,26 +702,11 @@ func postfixIncrDecrLed(prs *parser, prec *precDefn, sym *symType,
 
 	// Select appropriate operations based on parsed lvalue
 	switch left.parseType {
-	case PARSED_IDENTIFIER:
-		// Standard variable increment/decrement
-		varDef := prs.block.resolveVariable(left.identifier)
-		if varDef == nil {
-			prs.addError("Undefined variable '" + left.identifier + "'")
-			return nil
-		}
-		if varDef.declType == DECL_CONST {
-			prs.addError("Cannot modify constant '" + left.identifier + "'")
+	case PARSED_IDENTIFIER, PARSED_CAPTURE_REFERENCE, PARSED_GLOBAL_REFERENCE:
+		if !prs.pushVariableIncrDecr(left, sym.token == GTOK_INCR, false) {
 			return nil
 		}
 
-		var op *engine.OpCode
-		if sym.token == GTOK_INCR {
-			op = prs.pushOpCode(engine.PostIncrementOperation, 1)
-		} else {
-			op = prs.pushOpCode(engine.PostDecrementOperation, 1)
-		}
-		op.OpData = varDef.slotIndex
-
 	case PARSED_ARRAY_REFERENCE:
 		// Element led has already stored target and index operations
 		if sym.token == GTOK_INCR {
@@ -604,81 +805,159 @@ func arrayLiteralNud(prs *parser, prec *precDefn, sym *symType) *symType {
 	return &rs
 }
 
+/*
+ * Section 13.2.5
+ *
+ * PropertyDefinition:
+ *     IdentifierReference
+ *     | PropertyName : AssignmentExpression
+ *     | MethodDefinition
+ *     | ... AssignmentExpression
+ *
+ * PropertyName:
+ *     LiteralPropertyName
+ *     | [ AssignmentExpression ]
+ *
+ * Note: accessor (get/set), generator and async methods are not supported.
+ */
 func objectLiteralNud(prs *parser, prec *precDefn, sym *symType) *symType {
 	// For object, track the keyset as a list for the operation
 	var keys []string
+	var computedMask, spreadMask []bool
+	hasExtended := false
+	valCount := 0
 
-	// Quickly handle the empty object case
-	if prs.ctx.sym.token == GTOK_RC {
-		// Discard and create an empty object declaration (empty keys)
-		if prs.lex() == GTOK_ERROR {
-			return nil
-		}
-		op := prs.pushOpCode(engine.NewObjectOperation, 1)
-		op.OpData = keys
-
-		rs := *sym
-		rs.parseType = PARSED_VALUE
-		return &rs
-	}
-
-	for {
-		// Parse key, appears as either identifier or string based on quotes
-		var keyName string
-		if prs.ctx.sym.token == GTOK_IDENTIFIER {
-			keyName = prs.ctx.sym.identifier
-		} else if prs.ctx.sym.token == GTOK_LITERAL {
-			if str, ok := prs.ctx.sym.literal.(types.StringType); ok {
-				keyName = string(str)
-			} else {
-				prs.addError("Object key must be identifier or string")
+	for prs.ctx.sym.token != GTOK_RC {
+		// Spread entry copies the properties of the expression value
+		if prs.ctx.sym.token == GTOK_ELLIPSIS {
+			if prs.lex() == GTOK_ERROR {
+				return nil
+			}
+			expr := prs.parseExpression(RBP_NO_COMMA)
+			if expr == nil || !prs.pushEvalExpression(expr) {
 				return nil
 			}
+			keys = append(keys, "")
+			computedMask = append(computedMask, false)
+			spreadMask = append(spreadMask, true)
+			hasExtended = true
+			valCount++
 		} else {
-			prs.addError("Expected property name in object literal")
-			return nil
-		}
-		keys = append(keys, keyName)
+			// Parse key, identifier (or reserved word), string, number or
+			// computed expression (on the stack ahead of the value)
+			keyStart := prs.ctx.sym.start
+			var keyName string
+			computed, isIdent := false, false
+			switch tok := prs.ctx.sym.token; tok {
+			case GTOK_IDENTIFIER:
+				keyName, isIdent = prs.ctx.sym.identifier, true
+			case GTOK_LITERAL:
+				if !prs.checkLegacyOctal(&prs.ctx.sym) {
+					return nil
+				}
+				switch lit := prs.ctx.sym.literal.(type) {
+				case types.StringType:
+					keyName = string(lit)
+				case types.IntegerType, types.NumberType, types.BigIntType:
+					keyName = types.ToString(lit)
+				default:
+					prs.addError("Invalid property name in object literal")
+					return nil
+				}
+			case GTOK_LB:
+				if prs.lex() == GTOK_ERROR {
+					return nil
+				}
+				expr := prs.parseExpression(RBP_NO_COMMA)
+				if expr == nil || !prs.pushEvalExpression(expr) {
+					return nil
+				}
+				if prs.ctx.sym.token != GTOK_RB {
+					prs.addError("Expected ']' after computed property name")
+					return nil
+				}
+				computed, hasExtended = true, true
+				valCount++
+			default:
+				if keyName = keywordName(tok); keyName == "" {
+					prs.addError("Expected property name in object literal")
+					return nil
+				}
+			}
+			if prs.lex() == GTOK_ERROR {
+				return nil
+			}
 
-		// Requires a colon, consume to value expression
-		if prs.lex() == GTOK_ERROR {
-			return nil
-		}
-		if prs.ctx.sym.token != GTOK_COLON {
-			prs.addError("Expected ':' after property name")
-			return nil
-		}
-		if prs.lex() == GTOK_ERROR {
-			return nil
-		}
+			switch prs.ctx.sym.token {
+			case GTOK_COLON:
+				// Process associated value expression (on stack for create)
+				if prs.lex() == GTOK_ERROR {
+					return nil
+				}
+				expr := prs.parseExpression(RBP_NO_COMMA)
+				if expr == nil || !prs.pushEvalExpression(expr) {
+					return nil
+				}
 
-		// Process associated value expression (on stack for create)
-		expr := prs.parseExpression(RBP_NO_COMMA)
-		if expr == nil || !prs.pushEvalExpression(expr) {
-			return nil
+			case GTOK_LP:
+				// Concise method, 'this' is bound by the method call
+				fn := prs.parseFunctionDecl(false, false, false, nil,
+					keyStart)
+				if fn == nil {
+					return nil
+				}
+				fn.Name = keyName
+				op := prs.pushOpCode(engine.PushFunctionOperation, 1)
+				op.OpData = types.DataType(fn)
+
+			case GTOK_COMMA, GTOK_RC:
+				// Shorthand property, value of the same-named variable
+				if !isIdent {
+					prs.addError("Expected ':' after property name")
+					return nil
+				}
+				ident := &symType{token: GTOK_IDENTIFIER, identifier: keyName}
+				expr := identifierNud(prs, nil, ident)
+				if expr == nil || !prs.pushEvalExpression(expr) {
+					return nil
+				}
+
+			default:
+				prs.addError("Expected ':' after property name")
+				return nil
+			}
+			keys = append(keys, keyName)
+			computedMask = append(computedMask, computed)
+			spreadMask = append(spreadMask, false)
+			valCount++
 		}
 
-		// Either continuation (comma) or end (right brace), discard
+		// Either continuation (comma, trailing allowed) or end (right brace)
 		if prs.ctx.sym.token == GTOK_COMMA {
 			if prs.lex() == GTOK_ERROR {
 				return nil
 			}
 			continue
 		}
-		if prs.ctx.sym.token == GTOK_RC {
-			if prs.lex() == GTOK_ERROR {
-				return nil
-			}
-			break
+		if prs.ctx.sym.token != GTOK_RC {
+			prs.addError("Expected ',' or '}' in object literal")
+			return nil
 		}
+	}
 
-		prs.addError("Expected ',' or '}' in object literal")
+	// Discard the closing brace
+	if prs.lex() == GTOK_ERROR {
 		return nil
 	}
 
 	// Push the object operation with the keyset (consumes all but one)
-	op := prs.pushOpCode(engine.NewObjectOperation, 1-len(keys))
-	op.OpData = keys
+	op := prs.pushOpCode(engine.NewObjectOperation, 1-valCount)
+	if hasExtended {
+		op.OpData = engine.ObjectSpreadInfo{Keys: keys,
+			Computed: computedMask, SpreadMask: spreadMask}
+	} else {
+		op.OpData = keys
+	}
 
 	rs := *sym
 	rs.parseType = PARSED_VALUE
@@ -720,12 +999,15 @@ func memberAccessLed(prs *parser, prec *precDefn, sym *symType,
 		return nil
 	}
 
-	// Current token must be the property name, save and discard
+	// Current token must be the property name (reserved words allowed)
+	propName := prs.ctx.sym.identifier
 	if prs.ctx.sym.token != GTOK_IDENTIFIER {
-		prs.addError("Expected property name after '.'")
-		return nil
+		propName = keywordName(prs.ctx.sym.token)
+		if propName == "" {
+			prs.addError("Expected property name after '.'")
+			return nil
+		}
 	}
-	propName := prs.ctx.sym.identifier
 	if prs.lex() == GTOK_ERROR {
 		return nil
 	}
@@ -747,6 +1029,28 @@ func callLed(prs *parser, prec *precDefn, sym *symType,
 		op := prs.pushOpCode(engine.GetPropertyOperation, 0)
 		op.OpData = left.identifier
 		isMethodCall = true
+	} else if left.parseType == PARSED_ARRAY_REFERENCE {
+		// Computed method call, the target is retained as 'this'
+		prs.pushOpCode(engine.GetMethodElementOperation, 0)
+		isMethodCall = true
+	} else if prs.isWithReference(left) {
+		// A function found on a with object is called with the object as
+		// 'this', which replaces the undefined placeholder
+		op := prs.pushOpCode(engine.PushLiteralValue, 1)
+		op.OpData = types.Undefined
+		withOps := prs.pushWithOps(left.identifier,
+			engine.WithCallLoadOperation, 0)
+
+		// Regular resolution, without the (already pushed) with lookups
+		scopes := prs.withScopes
+		prs.withScopes = nil
+		ok := prs.pushEvalExpression(left)
+		prs.withScopes = scopes
+		if !ok {
+			return nil
+		}
+		prs.patchWithLookups(withOps)
+		isMethodCall = true
 	} else {
 		// Regular function call - evaluate the function expression
 		if !prs.pushEvalExpression(left) {
@@ -755,7 +1059,49 @@ func callLed(prs *parser, prec *precDefn, sym *symType,
 	}
 
 	// Parse set of argument expressions, including spread prefixes
-	argCount := 0
+	argData, argCount, ok := prs.parseArgumentList()
+	if !ok {
+		return nil
+	}
+
+	// Generate appropriate call operation based on original call type
+	var op *engine.OpCode
+	if left.parseType == PARSED_GLOBAL_REFERENCE &&
+		left.identifier == "eval" {
+		// Possible direct eval, with the variables visible to the code
+		info := engine.DirectEvalInfo{
+			ArgCount: argCount,
+			Scope:    prs.evalScope(),
+			Strict:   prs.strict,
+			Global:   prs.atScriptTop(),
+			HasThis:  isMethodCall,
+		}
+		if spread, ok := argData.(engine.CallSpreadInfo); ok {
+			info.SpreadMask = spread.SpreadMask
+		}
+		adjust := -argCount
+		if isMethodCall {
+			adjust--
+		}
+		op = prs.pushOpCode(engine.DirectEvalOperation, adjust)
+		op.OpData = info
+	} else if isMethodCall {
+		// Note that there is the extra 'this' on the stack
+		op = prs.pushOpCode(engine.MethodCallOperation, -(argCount + 1))
+		op.OpData = argData
+	} else {
+		op = prs.pushOpCode(engine.CallOperation, -(argCount))
+		op.OpData = argData
+	}
+
+	rs := *sym
+	rs.parseType = PARSED_VALUE
+	return &rs
+}
+
+// Shared parsing of call/new arguments, enter after '(', exit after ')'
+func (prs *parser) parseArgumentList() (argData interface{}, argCount int,
+	ok bool) {
 	var spreadMask []bool
 	hasSpread := false
 
@@ -767,13 +1113,13 @@ func callLed(prs *parser, prec *precDefn, sym *symType,
 				isSpread = true
 				hasSpread = true
 				if prs.lex() == GTOK_ERROR {
-					return nil
+					return nil, 0, false
 				}
 			}
 
 			arg := prs.parseExpression(RBP_NO_COMMA)
 			if arg == nil || !prs.pushEvalExpression(arg) {
-				return nil
+				return nil, 0, false
 			}
 			argCount++
 			spreadMask = append(spreadMask, isSpread)
@@ -781,7 +1127,7 @@ func callLed(prs *parser, prec *precDefn, sym *symType,
 			// Repeat until argument list is complete
 			if prs.ctx.sym.token == GTOK_COMMA {
 				if prs.lex() == GTOK_ERROR {
-					return nil
+					return nil, 0, false
 				}
 				continue
 			}
@@ -790,36 +1136,171 @@ func callLed(prs *parser, prec *precDefn, sym *symType,
 			}
 
 			prs.addError("Expected ',' or ')' in argument list")
-			return nil
+			return nil, 0, false
 		}
 	}
 
 	// Consume closing parenthesis
 	if prs.lex() == GTOK_ERROR {
+		return nil, 0, false
+	}
+
+	// Opcode data differs based on spread
+	if hasSpread {
+		return engine.CallSpreadInfo{ArgCount: argCount,
+			SpreadMask: spreadMask}, argCount, true
+	}
+	return argCount, argCount, true
+}
+
+/*
+ * Section 13.3.5
+ *
+ * NewExpression:
+ *     MemberExpression
+ *     | new NewExpression
+ *
+ * MemberExpression:
+ *     ...
+ *     | new MemberExpression Arguments
+ *
+ * Note that the member expression cannot consume the arguments as a call.
+ */
+func newNud(prs *parser, prec *precDefn, sym *symType) *symType {
+	// Primary expression without any led (binding all)
+	callee := prs.parseExpression(85)
+	for callee != nil {
+		tsym := prs.ctx.sym
+		if tsym.token != GTOK_DOT && tsym.token != GTOK_LB {
+			break
+		}
+		if prs.lex() == GTOK_ERROR {
+			return nil
+		}
+		if tsym.token == GTOK_DOT {
+			callee = memberAccessLed(prs, prec, &tsym, callee)
+		} else {
+			callee = elementAccessLed(prs, prec, &tsym, callee)
+		}
+	}
+	if callee == nil || !prs.pushEvalExpression(callee) {
 		return nil
 	}
 
-	// Generate appropriate call operation based on original call type
-	var op *engine.OpCode
-	if isMethodCall {
-		// Note that there is the extra 'this' on the stack
-		op = prs.pushOpCode(engine.MethodCallOperation, -(argCount + 1))
-	} else {
-		op = prs.pushOpCode(engine.CallOperation, -(argCount))
+	// Arguments are optional for the new operator
+	var argData interface{} = 0
+	argCount := 0
+	if prs.ctx.sym.token == GTOK_LP {
+		if prs.lex() == GTOK_ERROR {
+			return nil
+		}
+		var ok bool
+		argData, argCount, ok = prs.parseArgumentList()
+		if !ok {
+			return nil
+		}
 	}
 
-	if hasSpread {
-		op.OpData = engine.CallSpreadInfo{ArgCount: argCount,
-			SpreadMask: spreadMask}
-	} else {
-		op.OpData = argCount
+	op := prs.pushOpCode(engine.NewOperation, -argCount)
+	op.OpData = argData
+
+	rs := *sym
+	rs.parseType = PARSED_VALUE
+	return &rs
+}
+
+/*
+ * Section 15.8
+ *
+ * AwaitExpression:
+ *     await UnaryExpression
+ */
+func awaitNud(prs *parser, prec *precDefn, sym *symType) *symType {
+	if !prs.inAsync {
+		prs.addError("await is only valid in async functions")
+		return nil
+	}
+
+	// Parse the operand with unary precedence
+	expr := prs.parseExpression(prec.lbp)
+	if expr == nil || !prs.checkUnaryExponent() ||
+		!prs.pushEvalExpression(expr) {
+		return nil
 	}
 
+	prs.pushOpCode(engine.AwaitOperation, 0)
+
 	rs := *sym
 	rs.parseType = PARSED_VALUE
 	return &rs
 }
 
+/*
+ * Section 15.8
+ *
+ * AsyncFunctionExpression:
+ *     async function BindingIdentifier[opt] ( FormalParameters )
+ *                                                   { AsyncFunctionBody }
+ *
+ * AsyncArrowFunction:
+ *     async AsyncArrowBindingIdentifier => AsyncConciseBody
+ *     | async ArrowFormalParameters => AsyncConciseBody
+ *
+ * Note: async is contextual, called from identifier nud on the next token.
+ */
+func (prs *parser) parseAsyncExpression(sym *symType) *symType {
+	var paramNames []string
+	switch prs.ctx.sym.token {
+	case GTOK_FUNCTION:
+		prs.lex()
+		fn := prs.parseFunctionDecl(false, false, true, nil, sym.start)
+		if fn == nil {
+			return nil
+		}
+		op := prs.pushOpCode(engine.PushFunctionOperation, 1)
+		op.OpData = types.DataType(fn)
+
+		rs := *sym
+		rs.parseType = PARSED_VALUE
+		return &rs
+
+	case GTOK_IDENTIFIER:
+		// Single parameter arrow form
+		paramNames = append(paramNames, prs.ctx.sym.identifier)
+		prs.lex()
+
+	case GTOK_LP:
+		// Parenthesized parameter list (no support for async() calls)
+		tok := prs.lex()
+		for tok != GTOK_RP {
+			if tok != GTOK_IDENTIFIER {
+				prs.addError("Expected identifier in async parameter list")
+				return nil
+			}
+			paramNames = append(paramNames, prs.ctx.sym.identifier)
+			tok = prs.lex()
+			if tok == GTOK_COMMA {
+				tok = prs.lex()
+			} else if tok != GTOK_RP {
+				prs.addError("Expected ',' or ')' in async parameter list")
+				return nil
+			}
+		}
+		prs.lex()
+	}
+
+	// Remaining forms are arrow functions, consume arrow and parse body
+	if prs.ctx.sym.token != GTOK_ARROW {
+		prs.addError("Expected '=>' for async arrow function")
+		return nil
+	}
+	if !prs.checkArrowLine() {
+		return nil
+	}
+	prs.lex()
+	return prs.parseArrowFunctionBody(paramNames, true, sym.start)
+}
+
 func logicalAndLed(prs *parser, prec *precDefn, sym *symType,
 	left *symType) *symType {
 	// Push left operand
@@ -898,8 +1379,8 @@ func ternaryLed(prs *parser, prec *precDefn, sym *symType,
 		return nil
 	}
 
-	// Parse the false/else expression
-	elseExpr := prs.parseExpression(prec.lbp)
+	// Parse the false/else expression (right-associative, a ? b : c ? d : e)
+	elseExpr := prs.parseExpression(prec.lbp - 1)
 	if elseExpr == nil || !prs.pushEvalExpression(elseExpr) {
 		return nil
 	}
@@ -919,20 +1400,35 @@ func infixLed(prs *parser, prec *precDefn, sym *symType,
 		return nil
 	}
 
-	// Evaluate the right side, push onto stack
-	right := prs.parseExpression(prec.lbp)
+	// Evaluate the right side, push onto stack (exponent is right associative)
+	rbp := prec.lbp
+	if sym.token == GTOK_EXP {
+		rbp--
+	}
+	right := prs.parseExpression(rbp)
 	if right == nil || (!prs.pushEvalExpression(right)) {
 		return nil
 	}
 
-	// Lots of operations to consume the two arguments and leave one result
-	switch sym.token {
+	prs.pushBinaryOperation(sym.token)
+
+	rs := *sym
+	rs.parseType = PARSED_VALUE
+	return &rs
+}
+
+// Lots of operations to consume the two arguments and leave one result (also
+// used for the operation of the compound assignments)
+func (prs *parser) pushBinaryOperation(token int) {
+	switch token {
 	case GTOK_ADD:
 		prs.pushOpCode(engine.AdditionOperation, -1)
 	case GTOK_SUB:
 		prs.pushOpCode(engine.SubtractionOperation, -1)
 	case GTOK_MULT:
 		prs.pushOpCode(engine.MultiplicationOperation, -1)
+	case GTOK_EXP:
+		prs.pushOpCode(engine.ExponentOperation, -1)
 	case GTOK_DIV:
 		prs.pushOpCode(engine.DivisionOperation, -1)
 	case GTOK_MOD:
@@ -970,10 +1466,6 @@ func infixLed(prs *parser, prec *precDefn, sym *symType,
 	case GTOK_INSTANCEOF:
 		prs.pushOpCode(engine.InstanceofOperation, -1)
 	}
-
-	rs := *sym
-	rs.parseType = PARSED_VALUE
-	return &rs
 }
 
 // Comma expression, only rightmost value is the result (discard left)
@@ -1033,6 +1525,11 @@ func prec(token int) *precDefn {
 		p := precDefn{lbp: 0, nud: thisNud, led: nil}
 		return &p
 
+	// Module meta-property (import.meta)
+	case GTOK_IMPORT:
+		p := precDefn{lbp: 0, nud: importNud, led: nil}
+		return &p
+
 	// Object literal
 	case GTOK_LC:
 		p := precDefn{lbp: 0, nud: objectLiteralNud, led: nil}
@@ -1072,11 +1569,26 @@ func prec(token int) *precDefn {
 		p := precDefn{lbp: 70, nud: deleteNud, led: nil}
 		return &p
 
+	// As is await (unary operation on the awaited value)
+	case GTOK_AWAIT:
+		p := precDefn{lbp: 70, nud: awaitNud, led: nil}
+		return &p
+
+	// Constructor invocation, arguments are handled by the nud
+	case GTOK_NEW:
+		p := precDefn{lbp: 0, nud: newNud, led: nil}
+		return &p
+
 	// Increment/decrement - both prefix and postfix
 	case GTOK_INCR, GTOK_DECR:
 		p := precDefn{lbp: 75, nud: prefixIncrDecrNud, led: postfixIncrDecrLed}
 		return &p
 
+	// Exponent operator (right-associative)
+	case GTOK_EXP:
+		p := precDefn{lbp: 65, nud: nil, led: infixLed}
+		return &p
+
 	// Multiplicative operators
 	case GTOK_MULT, GTOK_DIV, GTOK_MOD:
 		p := precDefn{lbp: 60, nud: nil, led: infixLed}
@@ -1137,6 +1649,11 @@ func prec(token int) *precDefn {
 		p := precDefn{lbp: 10, nud: nil, led: assignmentLed}
 		return &p
 
+	// Compound assignment (e.g. +=), ditto
+	case GTOK_ASSIGNOP:
+		p := precDefn{lbp: 10, nud: nil, led: compoundAssignmentLed}
+		return &p
+
 	// Arrow function (same as assignment, right-associative)
 	case GTOK_ARROW:
 		p := precDefn{lbp: 10, nud: nil, led: arrowLed}
@@ -1153,13 +1670,9 @@ func prec(token int) *precDefn {
 
 // Parse an expression when we have read-ahead an identifier
 func (prs *parser) parseExpressionWithIdentifier(rbp int,
-	identName string) *symType {
-	// Create a symType for the identifier and reuse identifierNud logic
-	sym := &symType{
-		token:      GTOK_IDENTIFIER,
-		identifier: identName,
-	}
-	left := identifierNud(prs, nil, sym)
+	ident symType) *symType {
+	// Reuse the identifierNud logic for the (read-ahead) identifier symbol
+	left := identifierNud(prs, nil, &ident)
 	if left == nil {
 		return nil
 	}
@@ -1220,11 +1733,20 @@ func (prs *parser) completeExpression(rbp int, left *symType) *symType {
 	// Loop while right binding power is less than left binding power
 	// Note that the precedence lookup returns nil for terminating tokens
 	// Use tsym.token not tok since nud/led may have advanced the lexer
+	if left == nil {
+		return nil
+	}
 	tsym := prs.ctx.sym
 	tprec := prec(tsym.token)
 	for (tprec != nil) && (rbp < tprec.lbp) {
 		if tprec.led == nil {
-			// TODO - insert automatic semicolon? or error?
+			// Statement parsing determines if the termination is valid
+			break
+		}
+
+		// Postfix increment/decrement is restricted to the same line
+		if (tsym.token == GTOK_INCR || tsym.token == GTOK_DECR) &&
+			tsym.newline {
 			break
 		}
 
@@ -14,6 +14,7 @@ import (
 
 	"github.com/heisz/gescript/internal/engine"
 	"github.com/heisz/gescript/internal/native"
+	"github.com/heisz/gescript/types"
 )
 
 func TestEmptyWithComments(tst *testing.T) {
@@ -202,6 +203,69 @@ func TestUnaryExpressions(tst *testing.T) {
 	checkExpr(tst, "~~5", int64(5))
 }
 
+func TestExponentExpressions(tst *testing.T) {
+	// Integer results are retained where exact, right associative
+	checkExpr(tst, "2 ** 10", int64(1024))
+	checkExpr(tst, "2 ** 3 ** 2", int64(512))
+	checkExpr(tst, "(2 ** 3) ** 2", int64(64))
+	checkExpr(tst, "(-3) ** 3", int64(-27))
+	checkExpr(tst, "2 * 3 ** 2", int64(18))
+	checkExpr(tst, "-(2 ** 2)", int64(-4))
+	checkExpr(tst, "2 ** 52", int64(4503599627370496))
+	checkExpr(tst, "2 ** 53", float64(9007199254740992))
+	checkExpr(tst, "3 ** 40", float64(12157665459056928801))
+	checkExpr(tst, "2 ** -2", float64(0.25))
+	checkExpr(tst, "4 ** 0.5", float64(2))
+	checkExpr(tst, "1.5 ** 2", float64(2.25))
+
+	// Special cases that differ from math.Pow
+	checkExpr(tst, "var n = 1 ** (0 / 0); n !== n", true)
+	checkExpr(tst, "var n = (-1) ** (1 / 0); n !== n", true)
+	checkExpr(tst, "var n = (-8) ** (1 / 3); n !== n", true)
+	checkExpr(tst, "(0 / 0) ** 0", float64(1))
+
+	// Unary operand must be parenthesized (but is allowed as the exponent)
+	checkExpr(tst, "2 ** -1", float64(0.5))
+	for _, src := range []string{
+		"-2 ** 2",
+		"+2 ** 2",
+		"!1 ** 2",
+		"typeof 2 ** 2",
+		"2 ** -2 ** 2",
+	} {
+		if _, errs := Parse(src); len(errs) == 0 {
+			tst.Errorf("Expected error parsing '%s'", src)
+		}
+	}
+}
+
+func TestCompoundAssignment(tst *testing.T) {
+	checkExpr(tst, "var x = 3; x **= 2; x", int64(9))
+	checkExpr(tst, "var x = 10; x -= 3; x /= 2; x %= 2; x", float64(1.5))
+	checkExpr(tst, `var s = 'a'; s += 'b'; s += 1; s`, "ab1")
+	checkExpr(tst, `var b = 5; b <<= 2; b >>= 1; b |= 1; b &= 7; b ^= 6;
+                    b >>>= 1; b`, int64(2))
+	checkExpr(tst, "var c = 1; (c += 2) + (c *= 2)", int64(9))
+	checkExpr(tst, "var x = 2, y = 3; x += y *= 2; x + ':' + y", "8:6")
+
+	// Targets (and index expressions) are only evaluated once
+	checkExpr(tst, "var o = {v: 2}; o.v **= 3; o.v", int64(8))
+	checkExpr(tst, `var a = [1, 2], k = 0; a[k++] += 10; a[k] *= 5;
+                    a[0] + ':' + a[1] + ':' + k`, "11:10:1")
+	checkExpr(tst, `function f() { var q = 2; return () => { q **= 3; return q; }; }
+                    f()()`, int64(8))
+
+	for _, src := range []string{
+		"const z = 1; z += 1",
+		"1 += 2",
+		"var x; x + 1 -= 2",
+	} {
+		if _, errs := Parse(src); len(errs) == 0 {
+			tst.Errorf("Expected error parsing '%s'", src)
+		}
+	}
+}
+
 func TestIncrDecrExpression(tst *testing.T) {
 	checkExpr(tst, "var x = 5; ++x", int64(6))
 	checkExpr(tst, "var x = 12; ++x; x", int64(13))
@@ -519,6 +583,9 @@ func TestVariableExpressions(tst *testing.T) {
 	checkExpr(tst, "var x = 1; { var y = 2 }; y + 1", int64(3))
 	checkExpr(tst, "var x = 1; { { var x = 3 } }; x", int64(3))
 	checkExpr(tst, "var x = 1; { let y = 2 }; x", int64(1))
+	checkExpr(tst, "var r; { let x = 1; var y = 2; r = x }; r + y", int64(3))
+	checkExpr(tst, `var f; { let x = 1; f = () => x }; var z = 5;
+	                f() + z`, int64(6))
 }
 
 func TestArrayExpressions(tst *testing.T) {
@@ -597,6 +664,60 @@ func TestObjectExpressions(tst *testing.T) {
 
 	checkExpr(tst, `var obj = {tag: {val: 0}};
                     obj.tag.val = 42; obj.tag.val`, int64(42))
+
+	// Numeric and reserved word keys, trailing comma
+	checkExpr(tst, "var obj = {1: 'a', 0x10: 'b', 1.5: 'c', if: 'd',}; "+
+		"obj[1] + obj['16'] + obj['1.5'] + obj.if", "abcd")
+
+	// Shorthand properties and computed keys
+	checkExpr(tst, "var id = 7, name = 'x', obj = {id, name}; obj.name + obj.id",
+		"x7")
+	checkExpr(tst, `var k = 'b', i = 0;
+                    var obj = {['a' + k]: 1, [i++]: 2, [i++]: 3};
+                    obj.ab + obj[0] + obj[1] + i`, int64(8))
+
+	// Concise methods, with 'this' for method calls
+	checkExpr(tst, `var order = {qty: 3, price: 4,
+                                 total() { return this.qty * this.price; },
+                                 'scaled'(f) { return this.total() * f; }};
+                    order.total() + ':' + order.scaled(2) + ':' +
+                        order.total.name`, "12:24:total")
+
+	// Spread copies the own properties, later entries take precedence
+	checkExpr(tst, `var defaults = {a: 1, b: 2, deep: {x: 1}};
+                    var obj = {z: 0, ...defaults, ...{b: 3}, ...null,
+                               ...undefined, c: 4};
+                    [obj.z, obj.a, obj.b, obj.c, obj.deep === defaults.deep]
+                        .join(',')`, "0,1,3,4,true")
+	checkExpr(tst, `var obj = {...'hi', ...[9], ...5}; obj[0] + obj[1]`, "9i")
+	checkExpr(tst, `var obj = {...'ab'}, cnt = 0;
+                    for (var k in obj) cnt++;
+                    cnt + ':' + obj[0] + obj[1]`, "2:ab")
+
+	// Duplicate keys take the last definition, on either literal path
+	checkExpr(tst, `var b = 3, obj = {b: 1, a: 2, b}, cnt = 0;
+                    for (var k in obj) cnt++;
+                    obj.b + ':' + cnt`, "3:2")
+	checkExpr(tst, `var obj = {m() { return 1; }, m() { return 2; }}; obj.m()`,
+		int64(2))
+	checkExpr(tst, `var obj = {b: 1, ['b']: 2, b: 3, ...{}}; obj.b`, int64(3))
+
+	// Computed method calls bind 'this' like member calls
+	checkExpr(tst, `var k = 'get', obj = {v: 5, get() { return this.v; }};
+                    obj['get']() + obj[k]() + [function() {
+                        return this.length; }][0]()`, int64(11))
+
+	for _, src := range []string{
+		"var obj = {'a'}",
+		"var obj = {if}",
+		"var obj = {a b}",
+		"var obj = {[1: 2}",
+		"var obj = {true: 1, , }",
+	} {
+		if _, errs := Parse(src); len(errs) == 0 {
+			tst.Errorf("Expected error parsing '%s'", src)
+		}
+	}
 }
 
 func TestStringExpressions(tst *testing.T) {
@@ -827,3 +948,101 @@ func TestCommaExpressions(tst *testing.T) {
 	checkExpr(tst, `var fns = [x => x + 1, x => x * 2];
 	                fns[0](5) + fns[1](5)`, int64(16))
 }
+
+func TestNewExpressions(tst *testing.T) {
+	checkExpr(tst, `function Point(x, y) {
+                        this.x = x;
+                        this.y = y;
+                    }
+                    var pt = new Point(3, 4); pt.x + pt.y`, int64(7))
+	checkExpr(tst, `function Make() { return [1, 2]; }
+                    new Make().length`, int64(2))
+	checkExpr(tst, `var ns = { Ctor: function(v) { this.v = v; } };
+                    new ns.Ctor(5).v`, int64(5))
+	checkExpr(tst, `function Empty() { this.ok = true; }
+                    var e = new Empty; e.ok`, true)
+}
+
+func TestAsyncSyntax(tst *testing.T) {
+	for _, src := range []string{
+		"async function f() { return await 1; }",
+		"var f = async function() { await 1; }",
+		"var f = async x => await x",
+		"var f = async (a, b) => { return await a + b; }",
+		"async function f(l) { for await (const v of l) {} }",
+	} {
+		if _, errs := Parse(src); len(errs) != 0 {
+			tst.Errorf("Unexpected error parsing '%s': %v", src, errs)
+		}
+	}
+
+	// Await is only valid within the async function bodies
+	for _, src := range []string{
+		"await 1",
+		"function f() { await 1; }",
+		"async function f() { return () => await 1; }",
+		"function f(l) { for await (const v of l) {} }",
+		"async function f(o) { for await (const k in o) {} }",
+	} {
+		if _, errs := Parse(src); len(errs) == 0 {
+			tst.Errorf("Expected error parsing '%s'", src)
+		}
+	}
+}
+
+func TestAutomaticSemicolons(tst *testing.T) {
+	checkExpr(tst, "var a = 1\nvar b = 2\na + b", int64(3))
+	checkExpr(tst, "var a = 1; { a = 2 } a", int64(2))
+	checkExpr(tst, "var a = 1 // note\nvar b = a /* and\n more */ a", int64(1))
+	checkExpr(tst, `var x = 0
+	                do x++
+	                while (x < 5) x`, int64(5))
+	checkExpr(tst, `var s = 0
+	                for (var i = 0; i < 5; i++) {
+	                    if (i == 1) continue
+	                    if (i == 4) break
+	                    s += i }
+	                s`, int64(5))
+	checkExpr(tst, "var f = x =>\n    x * 2\nf(3)", int64(6))
+	checkExpr(tst, "debugger\n1", int64(1))
+
+	// Restricted productions, newline terminates the statement
+	checkExpr(tst, "var a = 1, b = 2\na\n++b\na * 10 + b", int64(13))
+	checkExpr(tst, "function f() { return\n42 }\nf() === undefined", true)
+	checkExpr(tst, `var n = 0
+	                lbl: for (var i = 0; i < 3; i++) {
+	                    for (;;) { n++; break
+	                               lbl }
+	                }
+	                n`, int64(3))
+
+	for _, src := range []string{
+		"var a = 1 var b = 2",
+		"1 2",
+		"var a = 1; if (a) a = 2 else a = 3",
+		"throw\nnew Error('x')",
+		"var f = x\n=> 1",
+		"var f = (a, b)\n=> 1",
+	} {
+		if _, errs := Parse(src); len(errs) == 0 {
+			tst.Errorf("Expected error parsing '%s'", src)
+		}
+	}
+
+	// And the house style that requires all of them
+	opts := types.ParseOptions{DisableASI: true}
+	for _, src := range []string{
+		"var a = 1\nvar b = 2",
+		"var a = 1; { a }",
+		"var x = 0; do x++; while (x < 5) x;",
+		"function f() { return }",
+	} {
+		if _, errs := ParseWithOptions(src, opts); len(errs) == 0 {
+			tst.Errorf("Expected error parsing '%s' without ASI", src)
+		}
+	}
+	src := "var a = 1; { a; } do a++; while (a < 5); function f() { return; }"
+	if _, errs := ParseWithOptions(src, opts); len(errs) != 0 {
+		tst.Errorf("Unexpected error parsing '%s': %v", src, errs)
+	}
+}
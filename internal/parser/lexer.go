@@ -10,7 +10,10 @@ package parser
 
 import (
 	"bytes"
+	"errors"
+	"math/big"
 	"strconv"
+	"strings"
 
 	"github.com/heisz/gescript/types"
 )
@@ -22,6 +25,16 @@ type symType struct {
 	identifier string
 	literal    types.DataType
 	assignOp   int
+
+	// Line terminator preceded the token (for automatic semicolons)
+	newline bool
+
+	// Source offset of the start of the token (for function source text)
+	start int
+
+	// Numeric literal with a leading zero or string literal with an octal
+	// escape sequence (legacy octal), not strict
+	legacyOctal bool
 }
 
 // Lexing source/position tracking object
@@ -33,6 +46,9 @@ type lexer struct {
 	regexValid bool
 	error      *string
 
+	// Source offset of the end of the previous token (function source text)
+	prevEnd int
+
 	// Last working symbol read (for loop reference)
 	sym symType
 }
@@ -152,6 +168,7 @@ const (
 	GTOK_ADD
 	GTOK_SUB
 	GTOK_MULT
+	GTOK_EXP
 	GTOK_DIV
 	GTOK_MOD
 	GTOK_INCR
@@ -231,6 +248,25 @@ var keywords = []struct {
 	{"false", GTOK_FALSE},
 }
 
+// Reverse lookup of the keyword text for a token (empty if not a keyword),
+// for contexts where reserved words are allowed as names (IdentifierName)
+func keywordName(token int) string {
+	for _, keywd := range keywords {
+		if keywd.token == token {
+			return keywd.word
+		}
+	}
+	return ""
+}
+
+// Determine if the character is a digit in the (up to hexadecimal) radix
+func isRadixDigit(ch byte, radix int) bool {
+	if radix == 16 {
+		return isHex(ch)
+	}
+	return (ch >= '0') && (ch < '0'+byte(radix))
+}
+
 // Platform-independent hex handling
 func isHex(ch byte) bool {
 	if ((ch >= '0') && (ch <= '9')) ||
@@ -256,8 +292,176 @@ func hexToInt(ch byte) int64 {
 }
 
 // Wrapped internal function parses the raw lexical element
+// Scan the digits of the radix from the offset, returning the end offset and
+// the digits (less any numeric separators, only allowed between digits)
+func (ctx *lexer) scanDigits(offset int, radix int) (int, string, error) {
+	var digits []byte
+	for {
+		ch := ctx.source[offset]
+		if ch == '_' {
+			if (len(digits) == 0) ||
+				!isRadixDigit(ctx.source[offset+1], radix) {
+				return offset, "", parserError(ctx,
+					"Numeric separators are only allowed between digits")
+			}
+			offset++
+			continue
+		}
+		if !isRadixDigit(ch, radix) {
+			return offset, string(digits), nil
+		}
+		digits = append(digits, ch)
+		offset++
+	}
+}
+
+// Complete an integer literal (or BigInt with the 'n' suffix) from the
+// digits, where values outside of the 64-bit range become floating point
+func (ctx *lexer) integerLiteral(lval *symType, digits string, radix int,
+	end int) (int, error) {
+	bval, ok := new(big.Int).SetString(digits, radix)
+	if !ok {
+		return GTOK_ERROR, parserError(ctx, "Invalid literal integer: "+
+			digits)
+	}
+	lval.parseType = PARSED_LITERAL
+	if ctx.source[end] == 'n' {
+		lval.literal = types.NewBigIntFromBig(bval)
+		end++
+	} else if bval.IsInt64() {
+		lval.literal = types.IntegerType(bval.Int64())
+	} else {
+		fval, _ := new(big.Float).SetInt(bval).Float64()
+		lval.literal = types.NumberType(fval)
+	}
+	ctx.offset = end
+	return GTOK_LITERAL, nil
+}
+
+// Lex the numeric literal at the current offset, decimal (integer/float),
+// the 0x/0o/0b prefixed forms and legacy octal, including BigInt literals
+func (ctx *lexer) lexNumber(lval *symType) (int, error) {
+	src := ctx.source
+	offset := ctx.offset
+
+	// Prefixed (radix) integers
+	radix := 10
+	if src[offset] == '0' {
+		switch src[offset+1] {
+		case 'x', 'X':
+			radix = 16
+		case 'o', 'O':
+			radix = 8
+		case 'b', 'B':
+			radix = 2
+		}
+	}
+	if radix != 10 {
+		end, digits, err := ctx.scanDigits(offset+2, radix)
+		if err != nil {
+			return GTOK_ERROR, err
+		}
+		if digits == "" {
+			if radix != 16 {
+				return GTOK_ERROR, parserError(ctx,
+					"Invalid numeric literal, missing digits")
+			}
+
+			// Looked like a hexidecimal but it isn't, just a plain old zero
+			lval.parseType = PARSED_LITERAL
+			lval.literal = types.IntegerType(0)
+			ctx.offset = offset + 1
+			return GTOK_LITERAL, nil
+		}
+		return ctx.integerLiteral(lval, digits, radix, end)
+	}
+
+	// Legacy octal (leading zero), no separators or BigInt and is actually
+	// decimal if it contains 8 or 9 digits
+	if (src[offset] == '0') && (src[offset+1] >= '0') &&
+		(src[offset+1] <= '9') {
+		end := offset + 1
+		for (src[end] >= '0') && (src[end] <= '9') {
+			end++
+		}
+		switch src[end] {
+		case '_':
+			return GTOK_ERROR, parserError(ctx,
+				"Numeric separators are not allowed in legacy octal literals")
+		case 'n':
+			return GTOK_ERROR, parserError(ctx,
+				"Invalid BigInt literal, octal not supported")
+		}
+		lval.legacyOctal = true
+		digits := string(src[offset:end])
+		if !strings.ContainsAny(digits, "89") {
+			return ctx.integerLiteral(lval, digits, 8, end)
+		}
+	} else if (src[offset] == '0') && (src[offset+1] == '_') {
+		return GTOK_ERROR, parserError(ctx,
+			"Numeric separators are not allowed after a leading zero")
+	}
+
+	// Decimal integer (or BigInt) or floating point value
+	end, sval, err := ctx.scanDigits(offset, 10)
+	if err != nil {
+		return GTOK_ERROR, err
+	}
+	isFloat := false
+	if src[end] == '.' {
+		var frac string
+		if src[end+1] != '_' {
+			end, frac, err = ctx.scanDigits(end+1, 10)
+		} else {
+			err = parserError(ctx,
+				"Numeric separators are only allowed between digits")
+		}
+		if err != nil {
+			return GTOK_ERROR, err
+		}
+		sval, isFloat = sval+"."+frac, true
+	}
+	if (src[end] == 'e') || (src[end] == 'E') {
+		eso, sign := end+1, ""
+		if (src[eso] == '+') || (src[eso] == '-') {
+			sign = string(src[eso])
+			eso++
+		}
+		if (src[eso] < '0') || (src[eso] > '9') {
+			return GTOK_ERROR, parserError(ctx,
+				"Invalid numeric literal, missing exponent")
+		}
+		var exp string
+		end, exp, err = ctx.scanDigits(eso, 10)
+		if err != nil {
+			return GTOK_ERROR, err
+		}
+		sval, isFloat = sval+"e"+sign+exp, true
+	}
+	if !isFloat {
+		if (src[end] == 'n') && (sval != "0") && (sval[0] == '0') {
+			return GTOK_ERROR, parserError(ctx,
+				"Invalid BigInt literal, octal not supported")
+		}
+		return ctx.integerLiteral(lval, sval, 10, end)
+	}
+
+	// Out of range values are infinite or zero, not errors
+	dval, err := strconv.ParseFloat(sval, 64)
+	if err != nil && !errors.Is(err, strconv.ErrRange) {
+		return GTOK_ERROR, parserError(ctx,
+			"Invalid literal float: "+err.Error())
+	}
+	lval.parseType = PARSED_LITERAL
+	lval.literal = types.NumberType(dval)
+	ctx.offset = end
+	return GTOK_LITERAL, nil
+}
+
 func (ctx *lexer) _lex(lval *symType) (int, error) {
 	lval.parseType = PARSED_UNDEFINED
+	lval.newline = false
+	lval.legacyOctal = false
 	for true {
 		ch := ctx.source[ctx.offset]
 		if ch == 0 {
@@ -278,6 +482,7 @@ func (ctx *lexer) _lex(lval *symType) (int, error) {
 		if (ch == '\r') || (ch == '\n') {
 			ctx.lineNumber++
 			ctx.offset++
+			lval.newline = true
 			continue
 		}
 
@@ -296,6 +501,7 @@ func (ctx *lexer) _lex(lval *symType) (int, error) {
 			if ctx.source[ctx.offset] != 0 {
 				ctx.lineNumber++
 				ctx.offset++
+				lval.newline = true
 			}
 			continue
 		}
@@ -308,12 +514,14 @@ func (ctx *lexer) _lex(lval *symType) (int, error) {
 					(ctx.source[ctx.offset+1] != '/')) {
 				if ctx.source[ctx.offset] == '\n' {
 					ctx.lineNumber++
+					lval.newline = true
 				}
 				if ctx.source[ctx.offset] == '\r' {
 					if ctx.source[ctx.offset+1] == '\n' {
 						ctx.offset++
 					}
 					ctx.lineNumber++
+					lval.newline = true
 				}
 				ctx.offset++
 			}
@@ -326,6 +534,9 @@ func (ctx *lexer) _lex(lval *symType) (int, error) {
 			continue
 		}
 
+		// Token starts here (past the white space and comments)
+		lval.start = ctx.offset
+
 		// Identifer/keywords (NOTE: Unicode escape names not supported)
 		if ((ch >= 'a') && (ch <= 'z')) || ((ch >= 'A') && (ch <= 'Z')) ||
 			(ch == '$') || (ch == '_') {
@@ -364,92 +575,7 @@ func (ctx *lexer) _lex(lval *symType) (int, error) {
 		// Numeric literals
 		if ((ch >= '0') && (ch <= '9')) ||
 			((ch == '.') && ((nch >= '0') && (nch <= '9'))) {
-			ival := int64(0)
-
-			// Assume decimal until told otherwise
-			radix := 10
-			if ch == '0' {
-				ctx.offset++
-				ch = ctx.source[ctx.offset]
-				if (nch == 'x') || (nch == 'X') {
-					ctx.offset++
-					ch = ctx.source[ctx.offset]
-					radix = 16
-					ival = 0
-				} else if (ch >= '0') && (ch <= '9') {
-					radix = 8
-					ival = 0
-				}
-			}
-
-			// Process digits (potentially including hex)
-			eso := ctx.offset
-			for isHex(ch) {
-				if radix == 16 {
-					ival = (ival << 4) | hexToInt(ch)
-				} else if radix == 8 {
-					if (ch >= '0') && (ch <= '7') {
-						ival = ival<<3 | (int64(ch) - 48)
-					} else {
-						break
-					}
-				} else {
-					if (ch < '0') || (ch > '9') {
-						break
-					}
-				}
-
-				eso++
-				ch = ctx.source[eso]
-			}
-			if eso == ctx.offset {
-				if radix == 16 {
-					// Looked like a hexidecimal but it isn't, rollback...
-					ctx.offset -= 2
-					eso--
-					radix = 10
-					ch = 'x'
-				} else {
-					// Just a plain old zero
-					ctx.offset--
-				}
-			}
-
-			if (radix == 8) || (radix == 16) {
-				ctx.offset = eso
-				lval.parseType = PARSED_LITERAL
-				lval.literal = types.IntegerType(ival)
-				return GTOK_LITERAL, nil
-			}
-			if (ch != '.') && (ch != 'e') && (ch != 'E') {
-				sval := string(ctx.source[ctx.offset:eso])
-				ival, err := strconv.ParseInt(sval, 10, 64)
-				if err != nil {
-					return GTOK_ERROR, parserError(ctx,
-						"Invalid literal integer: "+err.Error())
-				}
-				lval.parseType = PARSED_LITERAL
-				lval.literal = types.IntegerType(ival)
-				ctx.offset = eso
-				return GTOK_LITERAL, nil
-			}
-
-			// Assume slightly well-behaved code...
-			for ((ch >= '0') && (ch <= '9')) || (ch == '.') ||
-				(ch == 'e') || (ch == 'E') || (ch == '-') || (ch == '+') {
-				eso++
-				ch = ctx.source[eso]
-			}
-			sval := string(ctx.source[ctx.offset:eso])
-			dval, err := strconv.ParseFloat(sval, 64)
-			if err != nil {
-				return GTOK_ERROR, parserError(ctx,
-					"Invalid literal float: "+err.Error())
-			}
-			lval.parseType = PARSED_LITERAL
-			lval.literal = types.NumberType(dval)
-			ctx.offset = eso
-			return GTOK_LITERAL, nil
+			return ctx.lexNumber(lval)
 		}
 
 		// String and template literals, latter in lexical context appears to
@@ -508,7 +634,6 @@ func (ctx *lexer) _lex(lval *symType) (int, error) {
 						// Escaped newlines in ECMA are discarded
 						ctx.lineNumber++
 						break
-						/* Note: always strict so no legacy octal sequence */
 					case 'x':
 						fallthrough
 					case 'X':
@@ -524,21 +649,43 @@ func (ctx *lexer) _lex(lval *symType) (int, error) {
 					case 'u':
 						fallthrough
 					case 'U':
-						if (!isHex(ctx.source[eso+1])) ||
-							(!isHex(ctx.source[eso+2])) ||
-							(!isHex(ctx.source[eso+3])) ||
-							(!isHex(ctx.source[eso+4])) {
-							return GTOK_ERROR, parserError(ctx,
-								"Invalid Unicode character sequence")
+						if ctx.source[eso+1] == '{' {
+							// Code point escape, up to 10FFFF
+							wch = 0
+							eso += 2
+							for isHex(ctx.source[eso]) && (wch <= 0x10FFFF) {
+								wch = (wch << 4) |
+									int32(hexToInt(ctx.source[eso]))
+								eso++
+							}
+							if (ctx.source[eso] != '}') ||
+								(ctx.source[eso-1] == '{') ||
+								(wch > 0x10FFFF) {
+								return GTOK_ERROR, parserError(ctx,
+									"Invalid Unicode code point escape")
+							}
+						} else {
+							if (!isHex(ctx.source[eso+1])) ||
+								(!isHex(ctx.source[eso+2])) ||
+								(!isHex(ctx.source[eso+3])) ||
+								(!isHex(ctx.source[eso+4])) {
+								return GTOK_ERROR, parserError(ctx,
+									"Invalid Unicode character sequence")
+							}
+							wch = int32((hexToInt(ctx.source[eso+1]) << 12) |
+								(hexToInt(ctx.source[eso+2]) << 8) |
+								(hexToInt(ctx.source[eso+3]) << 4) |
+								hexToInt(ctx.source[eso+4]))
+							eso += 4
 						}
-						wch = int32((hexToInt(ctx.source[eso+1]) << 12) |
-							(hexToInt(ctx.source[eso+2]) << 8) |
-							(hexToInt(ctx.source[eso+3]) << 4) |
-							hexToInt(ctx.source[eso+4]))
-						eso += 4
 						break
 					default:
 						if (ch >= '0') && (ch <= '7') {
+							// Legacy octal (other than \0), not strict
+							nch := ctx.source[eso+1]
+							if (ch != '0') || ((nch >= '0') && (nch <= '9')) {
+								lval.legacyOctal = true
+							}
 							wch = int32(ch - 48)
 							ch = ctx.source[eso+1]
 							if (ch >= '0') && (ch <= '7') {
@@ -555,7 +702,13 @@ func (ctx *lexer) _lex(lval *symType) (int, error) {
 								"Invalid escape character sequence")
 						}
 					}
-					str = append(str, []byte(string(wch))...)
+					if (wch >= 0xD800) && (wch <= 0xDFFF) {
+						// Surrogate escapes are UTF-16 units, pair if able
+						str = []byte(types.ConcatStrings(string(str),
+							types.FromUTF16([]uint16{uint16(wch)})))
+					} else {
+						str = append(str, []byte(string(wch))...)
+					}
 				} else {
 					str = append(str, ch)
 				}
@@ -759,6 +912,16 @@ func (ctx *lexer) _lex(lval *symType) (int, error) {
 				eso++
 				lval.assignOp = GTOK_MULT
 				token = GTOK_ASSIGNOP
+			} else if nch == '*' {
+				eso++
+				nch = ctx.source[eso]
+				if nch == '=' {
+					eso++
+					lval.assignOp = GTOK_EXP
+					token = GTOK_ASSIGNOP
+				} else {
+					token = GTOK_EXP
+				}
 			} else {
 				token = GTOK_MULT
 			}
@@ -829,6 +992,7 @@ func (ctx *lexer) _lex(lval *symType) (int, error) {
 // Exposed lexer function (for yacc parser) wraps for contextual parsing
 func (ctx *lexer) lex(lval *symType) (int, error) {
 	origRegValid := ctx.regexValid
+	ctx.prevEnd = ctx.offset
 	token, err := ctx._lex(lval)
 	lval.token = token
 
@@ -9,7 +9,10 @@
 package parser
 
 import (
+	"math"
 	"testing"
+
+	"github.com/heisz/gescript/types"
 )
 
 func TestComments(tst *testing.T) {
@@ -130,6 +133,31 @@ func TestNumerics(tst *testing.T) {
 		tst.Fatalf("Invalid Lex return for eof")
 	}
 
+	lex = newLexer("0b1010 0O755 0x_1 1_000_000 0.000_1e1_0 0b 1__0 1_ 0_1 " +
+		"01_2 1._5 1e_5 089 0xFFFFFFFFFFFFFFFF " +
+		"123456789012345678901 1e400")
+	for _, expected := range []interface{}{int64(10), int64(493), nil,
+		int64(1000000), 1e6, nil, nil, nil, nil, nil, nil, nil, int64(89),
+		18446744073709551615.0, 123456789012345678901.0, math.Inf(1)} {
+		tok, err := lex.lex(&lval)
+		if expected == nil {
+			if (tok != GTOK_ERROR) || (err == nil) {
+				tst.Fatalf("Expected error for invalid numeric literal")
+			}
+			// Skip over the remainder of the invalid literal
+			for (lex.source[lex.offset] != ' ') &&
+				(lex.source[lex.offset] != 0) {
+				lex.offset++
+			}
+			continue
+		}
+		if (tok != GTOK_LITERAL) || (err != nil) ||
+			(lval.literal.Native() != expected) {
+			tst.Fatalf("Incorrect numeric literal %v for %v", lval.literal,
+				expected)
+		}
+	}
+
 	lex = newLexer("0. 12.0 1.234e3")
 
 	tok, err = lex.lex(&lval)
@@ -153,6 +181,34 @@ func TestNumerics(tst *testing.T) {
 	}
 }
 
+func TestBigIntLiterals(tst *testing.T) {
+	var lval symType
+	lex := newLexer("0n 123n 0xFFFFFFFFFFFFFFFFFn 2")
+
+	for _, expected := range []string{"0", "123",
+		"295147905179352825855"} {
+		tok, err := lex.lex(&lval)
+		if (tok != GTOK_LITERAL) || (err != nil) {
+			tst.Fatalf("Failed to parse BigInt %s token", expected)
+		}
+		if bval, ok := lval.literal.(types.BigIntType); !ok ||
+			bval.String() != expected {
+			tst.Fatalf("Incorrect BigInt literal %v for %s",
+				lval.literal, expected)
+		}
+	}
+	tok, err := lex.lex(&lval)
+	if (tok != GTOK_LITERAL) || (err != nil) ||
+		(lval.literal.Native().(int64) != 2) {
+		tst.Fatalf("Failed to parse integer following BigInt")
+	}
+
+	lex = newLexer("017n")
+	if tok, err = lex.lex(&lval); (tok != GTOK_ERROR) || (err == nil) {
+		tst.Fatalf("Expected error for legacy octal BigInt")
+	}
+}
+
 func TestStrings(tst *testing.T) {
 	var lval symType
 	lex := newLexer("'abc' \"def\"")
@@ -208,6 +264,27 @@ func TestStrings(tst *testing.T) {
 	if (tok != GTOK_EOF) || (err != nil) {
 		tst.Fatalf("Invalid Lex return for eof")
 	}
+
+	// Code point escapes and surrogate pairs (lone surrogates are retained)
+	lex = newLexer("'\\u{1F600}=\\uD83D\\uDE00' '\\uD800'")
+
+	tok, err = lex.lex(&lval)
+	if (tok != GTOK_LITERAL) || (err != nil) ||
+		(lval.literal.Native().(string) != "\U0001F600=\U0001F600") {
+		tst.Fatalf("Failed to parse '<code point>' token")
+	}
+	tok, err = lex.lex(&lval)
+	if (tok != GTOK_LITERAL) || (err != nil) ||
+		(types.UTF16Length(lval.literal.Native().(string)) != 1) {
+		tst.Fatalf("Failed to parse '<lone surrogate>' token")
+	}
+
+	for _, src := range []string{"'\\u{}'", "'\\u{110000}'", "'\\u{41'"} {
+		lex = newLexer(src)
+		if tok, err = lex.lex(&lval); (tok != GTOK_ERROR) || (err == nil) {
+			tst.Fatalf("Expected error for invalid escape %s", src)
+		}
+	}
 }
 
 func TestTemplates(tst *testing.T) {
@@ -406,7 +483,7 @@ func TestOperators(tst *testing.T) {
 		tst.Fatalf("Invalid Lex return for eof")
 	}
 
-	lex = newLexer("+ ++ += - -- -= * *= / /=")
+	lex = newLexer("+ ++ += - -- -= * *= ** **= / /=")
 
 	tok, err = lex.lex(&lval)
 	if (tok != GTOK_ADD) || (err != nil) {
@@ -441,6 +518,14 @@ func TestOperators(tst *testing.T) {
 		tst.Fatalf("Failed to parse '*=' token")
 	}
 	tok, err = lex.lex(&lval)
+	if (tok != GTOK_EXP) || (err != nil) {
+		tst.Fatalf("Failed to parse '**' token")
+	}
+	tok, err = lex.lex(&lval)
+	if (tok != GTOK_ASSIGNOP) || (lval.assignOp != GTOK_EXP) || (err != nil) {
+		tst.Fatalf("Failed to parse '**=' token")
+	}
+	tok, err = lex.lex(&lval)
 	if (tok != GTOK_DIV) || (err != nil) {
 		tst.Fatalf("Failed to parse '/' token")
 	}
@@ -510,14 +595,7 @@ func TestErrors(tst *testing.T) {
 		tst.Fatalf("Failed to error for unterminated comment")
 	}
 
-	lex = newLexer("9999999999999999999999999999999999999999999999999999999999")
-
-	tok, err = lex.lex(&lval)
-	if (tok != GTOK_ERROR) || (err == nil) {
-		tst.Fatalf("Failed to error for integer overflow")
-	}
-
-	lex = newLexer("9.9.e1.2")
+	lex = newLexer("9.9e+.2")
 
 	tok, err = lex.lex(&lval)
 	if (tok != GTOK_ERROR) || (err == nil) {
@@ -0,0 +1,554 @@
+/*
+ * Parsing methods for the module (import/export) declarations.
+ *
+ * Copyright (C) 2005-2026 J.M. Heisz.  All Rights Reserved.
+ * See the LICENSE file accompanying the distribution your rights to use
+ * this software.
+ */
+
+package parser
+
+import (
+	"github.com/heisz/gescript/internal/engine"
+	"github.com/heisz/gescript/types"
+)
+
+// Name of the hidden variable for the default export (per the specification)
+const defaultExportVar = "*default*"
+
+// Name of the hidden variable holding the import.meta object
+const importMetaVar = "import.meta"
+
+// Tracking context for the module elements during the parse
+type moduleContext struct {
+	code *engine.ModuleCode
+
+	// Local exports are resolved to variables at the end of the parse
+	localExports []localExport
+
+	// Names declared by the last declaration statement (for export)
+	declared []string
+}
+
+type localExport struct {
+	name  string
+	local string
+}
+
+// Entry point to parse a module, returning the module code or errors
+func ParseModule(source string) (code *engine.ModuleCode, err []error) {
+	return ParseModuleWithOptions(source, types.ParseOptions{})
+}
+
+// Identical to the above, with the specified parsing options
+func ParseModuleWithOptions(source string,
+	opts types.ParseOptions) (code *engine.ModuleCode, err []error) {
+	blk := newBlock(nil)
+	prs := parser{
+		ctx:       newLexer(source),
+		body:      engine.NewFunction("_"),
+		rootBlock: blk,
+		block:     blk,
+		opts:      opts,
+		// Module code is always strict mode code
+		strict: true,
+		module: &moduleContext{
+			code: &engine.ModuleCode{},
+		},
+	}
+	code = prs.module.code
+	code.Body = prs.body
+
+	metaVar, _ := blk.defineVariable(&prs, importMetaVar, DECL_CONST)
+	metaVar.initialized = true
+	code.MetaSlot = metaVar.slotIndex
+
+	prs.enterScope(true)
+	prs.parseStatementList()
+	prs.exitScope()
+	prs.body.Strict = true
+	prs.resolveLocalExports()
+	return code, prs.errors
+}
+
+// Determine if the parse is at the top level of a module (declarations)
+func (prs *parser) atModuleTop() bool {
+	return prs.module != nil && prs.outerScope == nil &&
+		prs.block == prs.rootBlock
+}
+
+// Record a declared name (for export declarations)
+func (prs *parser) noteDeclaration(name string) {
+	if prs.atModuleTop() {
+		prs.module.declared = append(prs.module.declared, name)
+	}
+}
+
+// Add a requested module specifier (unique, in order of appearance)
+func (prs *parser) addModuleRequest(specifier string) {
+	for _, req := range prs.module.code.Requests {
+		if req == specifier {
+			return
+		}
+	}
+	prs.module.code.Requests = append(prs.module.code.Requests, specifier)
+}
+
+// Read an IdentifierName (identifier or reserved word) at the current token
+func (prs *parser) identifierName() (string, bool) {
+	if prs.ctx.sym.token == GTOK_IDENTIFIER {
+		return prs.ctx.sym.identifier, true
+	}
+	if name := keywordName(prs.ctx.sym.token); name != "" {
+		return name, true
+	}
+	return "", false
+}
+
+// Check for a contextual keyword (from/as) at the current token
+func (prs *parser) isContextual(word string) bool {
+	return prs.ctx.sym.token == GTOK_IDENTIFIER &&
+		prs.ctx.sym.identifier == word
+}
+
+// Parse the 'from' clause specifier, exit on the token following
+func (prs *parser) parseFromClause() (string, bool) {
+	if !prs.isContextual("from") {
+		prs.addError("Expected 'from' before module specifier")
+		return "", false
+	}
+	return prs.parseModuleSpecifier()
+}
+
+// Parse the (string literal) module specifier, exit on the token following
+func (prs *parser) parseModuleSpecifier() (string, bool) {
+	if prs.lex() != GTOK_LITERAL {
+		prs.addError("Expected string literal for module specifier")
+		return "", false
+	}
+	spec, ok := prs.ctx.sym.literal.(types.StringType)
+	if !ok {
+		prs.addError("Expected string literal for module specifier")
+		return "", false
+	}
+	prs.addModuleRequest(string(spec))
+	prs.lex()
+	return string(spec), true
+}
+
+// Define the local (constant) binding for an imported name
+func (prs *parser) defineImport(local string, specifier string, name string) {
+	varDef, ok := prs.rootBlock.defineVariable(prs, local, DECL_CONST)
+	if !ok {
+		prs.addError("Cannot redeclare '" + local + "' in this scope")
+		return
+	}
+	varDef.initialized = true
+	prs.module.code.Imports = append(prs.module.code.Imports,
+		engine.ImportEntry{
+			Specifier: specifier,
+			Name:      name,
+			Slot:      varDef.slotIndex,
+		})
+}
+
+/*
+ * Section 16.2.2
+ *
+ * ImportDeclaration:
+ *     import ImportClause FromClause ;
+ *     | import ModuleSpecifier ;
+ *
+ * ImportClause:
+ *     ImportedDefaultBinding
+ *     | NameSpaceImport
+ *     | NamedImports
+ *     | ImportedDefaultBinding , NameSpaceImport
+ *     | ImportedDefaultBinding , NamedImports
+ *
+ * Also handles the import.meta expression (statement) for the import token.
+ *
+ * Enter: lexer on 'import', exit on terminating semicolon.
+ */
+func (prs *parser) parseImportDeclaration() {
+	tok := prs.lex()
+	if tok == GTOK_DOT {
+		sym := symType{token: GTOK_IMPORT}
+		expr := importNud(prs, nil, &sym)
+		if expr != nil {
+			expr = prs.completeExpression(0, expr)
+		}
+		if expr != nil {
+			prs.pushEvalExpression(expr)
+			if prs.blockDepth > 0 {
+				prs.pushOpCode(engine.PopOperation, -1)
+			}
+			prs.endStatement("expression")
+		}
+		return
+	}
+
+	if prs.module == nil {
+		prs.addError("Cannot use import statement outside a module")
+		return
+	}
+	if !prs.atModuleTop() {
+		prs.addError("Import declarations may only appear at top level " +
+			"of a module")
+		return
+	}
+
+	// Side-effect only import (no bindings)
+	if tok == GTOK_LITERAL {
+		spec, ok := prs.ctx.sym.literal.(types.StringType)
+		if !ok {
+			prs.addError("Expected string literal for module specifier")
+			return
+		}
+		prs.addModuleRequest(string(spec))
+		prs.lex()
+		prs.endStatement("import declaration")
+		return
+	}
+
+	// Collect the bindings, specifier is not known until the end
+	type binding struct {
+		local string
+		name  string
+	}
+	var bindings []binding
+	if tok == GTOK_IDENTIFIER {
+		bindings = append(bindings, binding{prs.ctx.sym.identifier, "default"})
+		tok = prs.lex()
+		if tok == GTOK_COMMA {
+			tok = prs.lex()
+		} else if !prs.isContextual("from") {
+			prs.addError("Expected ',' or 'from' after default import")
+			return
+		}
+	}
+	switch {
+	case tok == GTOK_MULT:
+		prs.lex()
+		if !prs.isContextual("as") {
+			prs.addError("Expected 'as' after '*' in import")
+			return
+		}
+		if prs.lex() != GTOK_IDENTIFIER {
+			prs.addError("Expected identifier for namespace import")
+			return
+		}
+		bindings = append(bindings, binding{prs.ctx.sym.identifier, "*"})
+		prs.lex()
+	case tok == GTOK_LC:
+		for prs.lex() != GTOK_RC {
+			name, ok := prs.identifierName()
+			if !ok {
+				prs.addError("Expected name in import list")
+				return
+			}
+			local := name
+			tok = prs.lex()
+			if prs.isContextual("as") {
+				if prs.lex() != GTOK_IDENTIFIER {
+					prs.addError("Expected identifier after 'as'")
+					return
+				}
+				local = prs.ctx.sym.identifier
+				tok = prs.lex()
+			} else if prs.ctx.sym.identifier != name {
+				prs.addError("Unexpected reserved word '" + name +
+					"' in import list")
+				return
+			}
+			bindings = append(bindings, binding{local, name})
+			if tok == GTOK_RC {
+				break
+			}
+			if tok != GTOK_COMMA {
+				prs.addError("Expected ',' or '}' in import list")
+				return
+			}
+		}
+		prs.lex()
+	case len(bindings) == 0:
+		prs.addError("Unexpected token in import declaration")
+		return
+	}
+
+	spec, ok := prs.parseFromClause()
+	if !ok {
+		return
+	}
+	for _, bnd := range bindings {
+		prs.defineImport(bnd.local, spec, bnd.name)
+	}
+	prs.endStatement("import declaration")
+}
+
+/*
+ * Section 16.2.3
+ *
+ * ExportDeclaration:
+ *     export ExportFromClause FromClause ;
+ *     | export NamedExports ;
+ *     | export VariableStatement
+ *     | export Declaration
+ *     | export default HoistableDeclaration
+ *     | export default AssignmentExpression ;
+ *
+ * ExportFromClause:
+ *     *
+ *     | * as ModuleExportName
+ *     | NamedExports
+ *
+ * Enter: lexer on 'export', exit on terminating semicolon (or declaration).
+ */
+func (prs *parser) parseExportDeclaration() {
+	if prs.module == nil {
+		prs.addError("Cannot use export statement outside a module")
+		return
+	}
+	if !prs.atModuleTop() {
+		prs.addError("Export declarations may only appear at top level " +
+			"of a module")
+		return
+	}
+
+	mod := prs.module
+	mod.declared = nil
+	tok := prs.lex()
+	switch tok {
+	case GTOK_VAR, GTOK_LET, GTOK_CONST:
+		declType := DECL_VAR
+		if tok == GTOK_LET {
+			declType = DECL_LET
+		} else if tok == GTOK_CONST {
+			declType = DECL_CONST
+		}
+		prs.parseVariableDeclaration(declType)
+	case GTOK_FUNCTION:
+		prs.parseFunctionStatement(false, prs.ctx.sym.start)
+	case GTOK_IDENTIFIER:
+		start := prs.ctx.sym.start
+		if prs.ctx.sym.identifier != "async" || prs.lex() != GTOK_FUNCTION {
+			prs.addError("Unexpected token in export declaration")
+			return
+		}
+		prs.parseFunctionStatement(true, start)
+	case GTOK_DEFAULT:
+		prs.parseExportDefault()
+		return
+	case GTOK_MULT:
+		prs.lex()
+		name := "*"
+		if prs.isContextual("as") {
+			prs.lex()
+			var ok bool
+			if name, ok = prs.identifierName(); !ok {
+				prs.addError("Expected name after 'as' in export")
+				return
+			}
+			prs.lex()
+		}
+		spec, ok := prs.parseFromClause()
+		if !ok {
+			return
+		}
+		exp := engine.ExportEntry{Name: name, Slot: -1, Specifier: spec}
+		if name != "*" {
+			exp.ImportName = "*"
+		}
+		mod.code.Exports = append(mod.code.Exports, exp)
+		prs.endStatement("export declaration")
+		return
+	case GTOK_LC:
+		prs.parseExportList()
+		return
+	default:
+		prs.addError("Unexpected token in export declaration")
+		return
+	}
+
+	// Declarations export each of the declared names
+	for _, name := range mod.declared {
+		mod.localExports = append(mod.localExports, localExport{name, name})
+	}
+}
+
+// Parse the export list (and optional from clause), lexer on opening brace
+func (prs *parser) parseExportList() {
+	var locals []localExport
+	for prs.lex() != GTOK_RC {
+		local, ok := prs.identifierName()
+		if !ok {
+			prs.addError("Expected name in export list")
+			return
+		}
+		name := local
+		tok := prs.lex()
+		if prs.isContextual("as") {
+			prs.lex()
+			if name, ok = prs.identifierName(); !ok {
+				prs.addError("Expected name after 'as' in export list")
+				return
+			}
+			tok = prs.lex()
+		}
+		locals = append(locals, localExport{name, local})
+		if tok == GTOK_RC {
+			break
+		}
+		if tok != GTOK_COMMA {
+			prs.addError("Expected ',' or '}' in export list")
+			return
+		}
+	}
+	prs.lex()
+
+	// Without a from clause, these are local bindings (resolved at the end)
+	if !prs.isContextual("from") {
+		prs.module.localExports = append(prs.module.localExports, locals...)
+		prs.endStatement("export declaration")
+		return
+	}
+	spec, ok := prs.parseFromClause()
+	if !ok {
+		return
+	}
+	for _, exp := range locals {
+		prs.module.code.Exports = append(prs.module.code.Exports,
+			engine.ExportEntry{
+				Name:       exp.name,
+				Slot:       -1,
+				Specifier:  spec,
+				ImportName: exp.local,
+			})
+	}
+	prs.endStatement("export declaration")
+}
+
+// Parse the default export, lexer on 'default'
+func (prs *parser) parseExportDefault() {
+	tok := prs.lex()
+	start := prs.ctx.sym.start
+	isAsync := false
+	if tok == GTOK_IDENTIFIER && prs.ctx.sym.identifier == "async" {
+		// Need to look ahead for an async function declaration
+		saved := *prs.ctx
+		if prs.lex() == GTOK_FUNCTION {
+			isAsync = true
+			tok = GTOK_FUNCTION
+		} else {
+			*prs.ctx = saved
+		}
+	}
+
+	// Function declarations are hoistable, either named or anonymous
+	if tok == GTOK_FUNCTION {
+		prs.lex()
+		fn := prs.parseFunctionDecl(false, false, isAsync, nil, start)
+		if fn == nil {
+			return
+		}
+		if fn.Name != "" {
+			prs.declareFunction(fn)
+			prs.module.localExports = append(prs.module.localExports,
+				localExport{"default", fn.Name})
+			return
+		}
+		op := prs.pushOpCode(engine.PushFunctionOperation, 1)
+		op.OpData = types.DataType(fn)
+	} else {
+		expr := prs.parseExpression(RBP_NO_COMMA)
+		if expr == nil || !prs.pushEvalExpression(expr) {
+			return
+		}
+		prs.endStatement("export declaration")
+	}
+
+	// Anonymous values are stored in the hidden default variable
+	varDef, ok := prs.rootBlock.defineVariable(prs, defaultExportVar,
+		DECL_CONST)
+	if !ok {
+		prs.addError("Duplicate export of 'default'")
+		return
+	}
+	varDef.initialized = true
+	op := prs.pushOpCode(engine.StoreVariableOperation, -1)
+	op.OpData = varDef.slotIndex
+	prs.module.localExports = append(prs.module.localExports,
+		localExport{"default", defaultExportVar})
+}
+
+// Resolve the local export names to variables (after the full parse), where
+// re-exported imports become indirect exports (Section 16.2.1.6.1)
+func (prs *parser) resolveLocalExports() {
+	code := prs.module.code
+	for _, exp := range prs.module.localExports {
+		varDef, ok := prs.rootBlock.variables[exp.local]
+		if !ok {
+			prs.addError("Export '" + exp.local + "' is not defined")
+			continue
+		}
+
+		entry := engine.ExportEntry{Name: exp.name, Slot: varDef.slotIndex,
+			Lexical: varDef.declType != DECL_VAR && !varDef.function}
+		for _, imp := range code.Imports {
+			if imp.Slot == varDef.slotIndex {
+				entry = engine.ExportEntry{
+					Name:       exp.name,
+					Slot:       -1,
+					Specifier:  imp.Specifier,
+					ImportName: imp.Name,
+				}
+			}
+		}
+		code.Exports = append(code.Exports, entry)
+	}
+
+	// Last check for duplicate export names
+	seen := make(map[string]bool)
+	for _, exp := range code.Exports {
+		if exp.Name == "*" {
+			continue
+		}
+		if seen[exp.Name] {
+			prs.addError("Duplicate export of '" + exp.Name + "'")
+		}
+		seen[exp.Name] = true
+	}
+}
+
+/*
+ * Section 13.3.12
+ *
+ * ImportMeta:
+ *     import . meta
+ *
+ * Note: dynamic import() is not supported.
+ */
+func importNud(prs *parser, prec *precDefn, sym *symType) *symType {
+	if prs.ctx.sym.token != GTOK_DOT {
+		prs.addError("Dynamic import() is not supported")
+		return nil
+	}
+	if prs.lex() != GTOK_IDENTIFIER || prs.ctx.sym.identifier != "meta" {
+		prs.addError("Expected 'meta' after 'import.'")
+		return nil
+	}
+	if prs.lex() == GTOK_ERROR {
+		return nil
+	}
+
+	// The module meta object is a hidden (possibly captured) variable
+	rs := identifierNud(prs, prec, &symType{
+		token:      GTOK_IDENTIFIER,
+		identifier: importMetaVar,
+	})
+	if rs != nil && rs.parseType == PARSED_GLOBAL_REFERENCE {
+		prs.addError("Cannot use 'import.meta' outside a module")
+		return nil
+	}
+	return rs
+}
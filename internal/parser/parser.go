@@ -9,9 +9,12 @@
 package parser
 
 import (
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/heisz/gescript/internal/engine"
+	"github.com/heisz/gescript/types"
 )
 
 // Originally the tracking context for the Yacc parser, now a hand-built version
@@ -23,8 +26,17 @@ type parser struct {
 	blockDepth    int
 	loopSwitchCtx *loopSwitchContext
 	pendingLabel  string
+	inAsync       bool
+	strict        bool
+	prologue      bool
+	prologueOctal bool
+	withScopes    []*withScope
+	withCount     int
 	outerScope    *outerScopeContext
 	captures      []captureEntry
+	globalEval    *outerScopeContext
+	module        *moduleContext
+	opts          types.ParseOptions
 	errors        []error
 }
 
@@ -84,6 +96,12 @@ type variable struct {
 	initialized bool
 	isCapture   bool
 	captureIdx  int
+	// Declared by the scope scan, prior to the actual declaration
+	predeclared bool
+	// Function declaration (lexical in blocks) and hoisted initialization
+	function   bool
+	hoisted    bool
+	catchParam bool
 }
 
 // Declare a variable in the block, checking for (illegal) redeclarations
@@ -97,20 +115,17 @@ func (blk *blockContext) defineVariable(prs *parser, name string,
 		return existing, true
 	}
 
+	// Slots are unique within the function, as var declarations in nested
+	// blocks (and closures) can otherwise overlap the block variables
 	vr := &variable{
 		name:      name,
 		declType:  declType,
-		slotIndex: blk.slotBase + blk.slotCount,
+		slotIndex: prs.body.VarCount,
 		// var declarations are auto-initialized to undefined
 		initialized: declType == DECL_VAR,
 	}
 	blk.variables[name] = vr
-	blk.slotCount++
-
-	// Track maximum slot usage for function allocation
-	if (blk.slotBase + blk.slotCount) > prs.body.VarCount {
-		prs.body.VarCount = blk.slotBase + blk.slotCount
-	}
+	prs.body.VarCount++
 
 	return vr, true
 }
@@ -248,26 +263,189 @@ func (prs *parser) resolveCapture(name string) int {
 	return -1
 }
 
+// Collect the captures accumulated in the body for the function definition
+func (prs *parser) captureInfo() []engine.CaptureInfo {
+	var captures []engine.CaptureInfo
+	for _, cap := range prs.captures {
+		captures = append(captures, engine.CaptureInfo{
+			Name:      cap.name,
+			SlotIndex: cap.slotIndex,
+			IsCapture: cap.isCapture,
+		})
+	}
+	return captures
+}
+
+// Section 19.2.1.1, collect the variables visible to a direct eval, where
+// the variables of the enclosing functions are captured (by this function)
+// so that the eval code compiled at runtime can reference all of them
+func (prs *parser) evalScope() []engine.CaptureInfo {
+	var scope []engine.CaptureInfo
+	seen := make(map[string]bool)
+	collect := func(blk *blockContext, outer bool) {
+		for ; blk != nil; blk = blk.parent {
+			// Hidden variables (duplicate parameters) excluded, but the with
+			// objects are included for the lookups of the code
+			var names []string
+			for name := range blk.variables {
+				if seen[name] || (strings.Contains(name, "*") &&
+					!strings.HasPrefix(name, withVarPrefix)) {
+					continue
+				}
+				names = append(names, name)
+			}
+			sort.Strings(names)
+
+			for _, name := range names {
+				seen[name] = true
+				if !outer {
+					scope = append(scope, engine.CaptureInfo{
+						Name:      name,
+						SlotIndex: blk.variables[name].slotIndex,
+					})
+				} else if capIdx := prs.resolveCapture(name); capIdx >= 0 {
+					scope = append(scope, engine.CaptureInfo{
+						Name:      name,
+						SlotIndex: capIdx,
+						IsCapture: true,
+					})
+				}
+			}
+		}
+	}
+
+	collect(prs.block, false)
+	for outer := prs.outerScope; outer != nil; outer = outer.parent {
+		collect(outer.block, true)
+	}
+	return scope
+}
+
 // Blocks are a lexical 'scope', stored as a tree to the root function block
 type blockContext struct {
 	parent    *blockContext
 	variables map[string]*variable
-	slotBase  int
-	slotCount int
+	// Jump to the hoisted function initialization (and return position)
+	hoistJump   *engine.OpCode
+	hoistResume int
+	hoisted     []hoistedFunction
 }
 
 // Create a new block with the given parent
 func newBlock(parent *blockContext) *blockContext {
-	base := 0
-	if parent != nil {
-		base = parent.slotBase + parent.slotCount
-	}
-
 	return &blockContext{
 		parent:    parent,
 		variables: make(map[string]*variable),
-		slotBase:  base,
-		slotCount: 0,
+	}
+}
+
+// Prefix of the hidden variables holding the objects of the with statements
+const withVarPrefix = "*with*"
+
+// Active with statement scope, where the object is held in a hidden variable
+type withScope struct {
+	varName string
+	block   *blockContext
+	// Function nesting level of the statement (for closure references)
+	depth int
+}
+
+// Current function nesting level (number of enclosing functions)
+func (prs *parser) functionDepth() int {
+	depth := 0
+	for outer := prs.outerScope; outer != nil; outer = outer.parent {
+		depth++
+	}
+	return depth
+}
+
+// Determine if the block is nested within (not the same as) the other
+func (blk *blockContext) within(ancestor *blockContext) bool {
+	for b := blk.parent; b != nil; b = b.parent {
+		if b == ancestor {
+			return true
+		}
+	}
+	return false
+}
+
+// Push the dynamic lookups of the identifier against the objects of the
+// enclosing with statements (innermost first), where a found property skips
+// the subsequent regular resolution (patched by the method below)
+func (prs *parser) pushWithLookups(name string,
+	store bool) []*engine.OpCode {
+	if store {
+		return prs.pushWithOps(name, engine.WithStoreOperation, -1)
+	}
+	return prs.pushWithOps(name, engine.WithLoadOperation, 0)
+}
+
+// Common lookup sequence from above, for the given with operation (load,
+// store, call or delete) against each applicable object
+func (prs *parser) pushWithOps(name string, opFn engine.OpCodeFn,
+	stackAdjust int) []*engine.OpCode {
+	if len(prs.withScopes) == 0 || name == "" || name == "this" {
+		return nil
+	}
+
+	// Find the declaration of the identifier (locals, then enclosing scopes)
+	var defBlock *blockContext
+	defDepth := prs.functionDepth()
+	for blk := prs.block; blk != nil && defBlock == nil; blk = blk.parent {
+		if vr, ok := blk.variables[name]; ok && !vr.isCapture {
+			defBlock = blk
+		}
+	}
+	for outer := prs.outerScope; outer != nil && defBlock == nil; {
+		defDepth--
+		for blk := outer.block; blk != nil; blk = blk.parent {
+			if vr, ok := blk.variables[name]; ok && !vr.isCapture {
+				defBlock = blk
+				break
+			}
+		}
+		outer = outer.parent
+	}
+
+	// Only with statements inside the scope of the declaration apply
+	var ops []*engine.OpCode
+	for idx := len(prs.withScopes) - 1; idx >= 0; idx-- {
+		scope := prs.withScopes[idx]
+		if defBlock != nil && (scope.depth < defDepth ||
+			(scope.depth == defDepth && !scope.block.within(defBlock))) {
+			continue
+		}
+
+		prs.pushEvalExpression(identifierNud(prs, nil, &symType{
+			token:      GTOK_IDENTIFIER,
+			identifier: scope.varName,
+		}))
+		op := prs.pushOpCode(opFn, stackAdjust)
+		op.OpData = engine.WithInfo{Name: name}
+		ops = append(ops, op)
+	}
+	return ops
+}
+
+// Determine if the identifier reference is within a with statement (and is
+// therefore possibly resolved against the object)
+func (prs *parser) isWithReference(ref *symType) bool {
+	if len(prs.withScopes) == 0 {
+		return false
+	}
+	switch ref.parseType {
+	case PARSED_IDENTIFIER, PARSED_CAPTURE_REFERENCE, PARSED_GLOBAL_REFERENCE:
+		return true
+	}
+	return false
+}
+
+// Complete the with lookups from above, skipping to the current position
+func (prs *parser) patchWithLookups(ops []*engine.OpCode) {
+	for _, op := range ops {
+		info := op.OpData.(engine.WithInfo)
+		info.Target = len(prs.body.Code)
+		op.OpData = info
 	}
 }
 
@@ -317,17 +495,130 @@ func (prs *parser) popLoopContext() {
 // Note: this goes against 'best' practice for an errorlist return but the
 // top-level wrapper will do it properly (avoiding circular import)
 func Parse(source string) (body *engine.Function, err []error) {
+	return ParseWithOptions(source, types.ParseOptions{})
+}
+
+// Identical to the above, with the specified parsing options
+func ParseWithOptions(source string,
+	opts types.ParseOptions) (body *engine.Function, err []error) {
 	blk := newBlock(nil)
 	prs := parser{
 		ctx:       newLexer(source),
 		body:      engine.NewFunction("_"),
 		rootBlock: blk,
 		block:     blk,
+		opts:      opts,
+		strict:    opts.Strict,
+		prologue:  true,
 	}
+	prs.enterScope(true)
 	prs.parseStatementList()
+	prs.exitScope()
+	prs.body.Strict = prs.strict
 	return prs.body, prs.errors
 }
 
+// Parse the source of eval code, compiled as a function that returns the
+// completion value.  For a direct eval, the scope is the names of the visible
+// variables of the caller, which are captured as from an enclosing function
+// (the code of an indirect eval is in the global scope).  The global flag
+// indicates a direct eval from the top level of a script.
+func ParseEval(source string, scope []string, global bool,
+	opts types.ParseOptions) (fn *engine.ScriptFunction, err []error) {
+	blk := newBlock(nil)
+	prs := parser{
+		ctx:       newLexer(source),
+		body:      engine.NewFunction("eval"),
+		rootBlock: blk,
+		block:     blk,
+		opts:      opts,
+		strict:    opts.Strict,
+		prologue:  true,
+	}
+	if scope != nil {
+		// Names are innermost first, the with statements of the caller only
+		// apply to the variables declared outside of them (the later names)
+		outer := newBlock(nil)
+		for idx := len(scope) - 1; idx >= 0; idx-- {
+			name := scope[idx]
+			if strings.HasPrefix(name, withVarPrefix) {
+				outer = newBlock(outer)
+				prs.withScopes = append(prs.withScopes, &withScope{
+					varName: name,
+					block:   outer,
+				})
+
+				// Those of the eval code itself must be distinct
+				num, _ := strconv.Atoi(name[len(withVarPrefix):])
+				prs.withCount = max(prs.withCount, num+1)
+			}
+			outer.variables[name] = &variable{
+				name:        name,
+				slotIndex:   idx,
+				initialized: true,
+			}
+		}
+		prs.outerScope = &outerScopeContext{block: outer}
+		if global {
+			prs.globalEval = prs.outerScope
+		}
+	}
+
+	prs.enterScope(true)
+	prs.parseStatementList()
+	prs.exitScope()
+	op := prs.pushOpCode(engine.EvalReturnOperation, 0)
+	op.OpData = true
+	prs.body.Strict = prs.strict
+
+	return &engine.ScriptFunction{
+		Name:          "eval",
+		Body:          prs.body,
+		VarCount:      prs.body.VarCount,
+		ArgumentsSlot: -1,
+		ThisSlot:      -1,
+		IsArrowFunc:   true,
+		Captures:      prs.captureInfo(),
+	}, prs.errors
+}
+
+// Section 20.2.1.1.1, parse the parameter and body source of the Function
+// constructor as an (anonymous) function expression in the global scope
+func ParseFunction(params, body string,
+	opts types.ParseOptions) (fn *engine.ScriptFunction, err []error) {
+	source := "function anonymous(" + params + "\n) {\n" + body + "\n}"
+	blk := newBlock(nil)
+	prs := parser{
+		ctx:       newLexer(source),
+		body:      engine.NewFunction("_"),
+		rootBlock: blk,
+		block:     blk,
+		opts:      opts,
+		strict:    opts.Strict,
+	}
+
+	// Lexer on the name (after the keyword), the parse includes the name
+	prs.lex()
+	prs.lex()
+	fn = prs.parseFunctionDecl(true, false, false, nil, 0)
+
+	// Neither the parameters nor the body can close the function early
+	if len(prs.errors) == 0 && prs.ctx.sym.token != GTOK_EOF {
+		prs.addError("Unexpected content following the function body")
+	}
+	return fn, prs.errors
+}
+
+// Extract the source text from the offset to the end of the last token read
+// (prior to the current token)
+func (prs *parser) sourceText(start int) string {
+	end := prs.ctx.prevEnd
+	if start < 0 || end < start {
+		return ""
+	}
+	return string(prs.ctx.source[start:end])
+}
+
 // Direct wrapper for regular lex, with automatic error recording
 func (prs *parser) lex() (token int) {
 	token, err := prs.ctx.lex(&prs.ctx.sym)
@@ -358,6 +649,12 @@ func (prs *parser) parseStatementList() {
 			return
 		}
 
+		// Directive prologue is the leading (string literal) statements
+		if _, isStr := prs.ctx.sym.literal.(types.StringType); !isStr ||
+			token != GTOK_LITERAL {
+			prs.prologue = false
+		}
+
 		// Track lexer position to detect infinite parse loops
 		startOffset := prs.ctx.offset
 
@@ -413,13 +710,17 @@ func (prs *parser) pushEvalExpression(expr *symType) bool {
 			prs.addError("Undefined variable '" + expr.identifier + "'")
 			return false
 		}
+		withOps := prs.pushWithLookups(expr.identifier, false)
 		op := prs.pushOpCode(engine.LoadVariableOperation, 1)
 		op.OpData = varDef.slotIndex
+		prs.patchWithLookups(withOps)
 		return true
 	case PARSED_GLOBAL_REFERENCE:
 		// Read value from global context (native/builtin functions)
+		withOps := prs.pushWithLookups(expr.identifier, false)
 		op := prs.pushOpCode(engine.LoadGlobalOperation, 1)
 		op.OpData = expr.identifier
+		prs.patchWithLookups(withOps)
 		return true
 	case PARSED_ARRAY_REFERENCE:
 		// Target and index already parsed, push the element retrieve op
@@ -432,8 +733,10 @@ func (prs *parser) pushEvalExpression(expr *symType) bool {
 		return true
 	case PARSED_CAPTURE_REFERENCE:
 		// Captured variable reference from closure
+		withOps := prs.pushWithLookups(expr.identifier, false)
 		op := prs.pushOpCode(engine.LoadCaptureOperation, 1)
 		op.OpData = expr.assignOp
+		prs.patchWithLookups(withOps)
 		return true
 	}
 
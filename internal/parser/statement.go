@@ -9,6 +9,8 @@
 package parser
 
 import (
+	"strconv"
+
 	"github.com/heisz/gescript/internal/engine"
 	"github.com/heisz/gescript/types"
 )
@@ -93,7 +95,11 @@ func (prs *parser) parseStatementListItem(token int) {
 		prs.parseBreakStatement()
 		return
 	case GTOK_WITH:
-		// TODO - not supported in strict, maybe add
+		if prs.strict {
+			prs.addError("Strict mode code may not include a with statement")
+			return
+		}
+		prs.parseWithStatement()
 		return
 	case GTOK_THROW:
 		prs.parseThrowStatement()
@@ -102,15 +108,30 @@ func (prs *parser) parseStatementListItem(token int) {
 		prs.parseTryStatement()
 		return
 	case GTOK_DEBUGGER:
-		// TODO - what to do?
+		// No debugging support, just a (validated) empty statement
+		prs.lex()
+		prs.endStatement("debugger statement")
 		return
 	case GTOK_FUNCTION:
-		prs.parseFunctionStatement()
+		prs.parseFunctionStatement(false, prs.ctx.sym.start)
+		return
+	case GTOK_IMPORT:
+		prs.parseImportDeclaration()
+		return
+	case GTOK_EXPORT:
+		prs.parseExportDeclaration()
 		return
 	case GTOK_IDENTIFIER:
 		// Check for a labelled statement (colon after identifier)
-		identName := prs.ctx.sym.identifier
+		identSym := prs.ctx.sym
+		identName := identSym.identifier
 		nextTok := prs.lex()
+		if nextTok == GTOK_FUNCTION && identName == "async" &&
+			!prs.ctx.sym.newline {
+			// Contextual keyword, async function declaration
+			prs.parseFunctionStatement(true, identSym.start)
+			return
+		}
 		if nextTok == GTOK_COLON {
 			// It's a label, store and read ahead to determine context
 			prs.pendingLabel = identName
@@ -131,13 +152,14 @@ func (prs *parser) parseStatementListItem(token int) {
 		}
 
 		// Not a label, use the special method since we've read ahead
-		expr := prs.parseExpressionWithIdentifier(0, identName)
+		expr := prs.parseExpressionWithIdentifier(0, identSym)
 		if expr != nil {
 			prs.pushEvalExpression(expr)
 			// Discard all but top level expression results (stack overflow)
 			if prs.blockDepth > 0 {
 				prs.pushOpCode(engine.PopOperation, -1)
 			}
+			prs.endStatement("expression")
 		}
 		return
 	}
@@ -145,12 +167,61 @@ func (prs *parser) parseStatementListItem(token int) {
 	// Fallthrough for other expressions (literals, for example)
 	expr := prs.parseExpression(0)
 	if expr != nil {
+		if prs.prologue {
+			prs.checkDirective(expr)
+		}
 		prs.pushEvalExpression(expr)
 		// Discard all but top level expression results (stack overflow)
 		if prs.blockDepth > 0 {
 			prs.pushOpCode(engine.PopOperation, -1)
 		}
+		prs.endStatement("expression")
+	}
+}
+
+/*
+ * Section 11.2.1
+ *
+ * Directive prologue, the string literal expression statements at the start
+ * of a script or function body, where "use strict" selects strict mode.
+ */
+func (prs *parser) checkDirective(expr *symType) {
+	if expr.parseType != PARSED_LITERAL {
+		prs.prologue = false
+		return
+	}
+	if expr.legacyOctal {
+		prs.prologueOctal = true
+	}
+	if str, ok := expr.literal.(types.StringType); ok && str == "use strict" {
+		// An earlier directive with an octal escape is then an error
+		if prs.prologueOctal && !prs.strict {
+			prs.addError(
+				"Octal escape sequences are not allowed in strict mode")
+		}
+		prs.strict = true
+	}
+}
+
+/*
+ * Section 12.10
+ *
+ * Automatic semicolon insertion, a statement that is not explicitly
+ * terminated by a semicolon is implicitly terminated by the next token if
+ * that token is '}', the end of the input or is separated by a newline.
+ *
+ * Enter: lexer on token following the statement, which is not consumed.
+ */
+func (prs *parser) endStatement(desc string) {
+	sym := &prs.ctx.sym
+	if sym.token == GTOK_SEMI || sym.token == GTOK_ERROR {
+		return
 	}
+	if !prs.opts.DisableASI && (sym.token == GTOK_RC ||
+		sym.token == GTOK_EOF || sym.newline) {
+		return
+	}
+	prs.addError("Expected ';' after " + desc)
 }
 
 /*
@@ -165,21 +236,14 @@ func (prs *parser) parseStatementListItem(token int) {
  * Enter: lexer on left brace, exit on right brace.
  */
 func (prs *parser) parseBlockStatement() {
-	// Push new parser block instance for variable/exit scoping
-	prs.block = newBlock(prs.block)
-	prs.blockDepth++
-
-	prs.parseStatementList()
+	// Parse in a new parser block instance for variable/exit scoping
+	prs.parseScopedStatementList()
 	if prs.ctx.sym.token != GTOK_RC {
 		prs.addError("Unterminated block statement (missing '}')")
 	} else {
 		// Advance past the closing brace for the caller
 		prs.lex()
 	}
-
-	// Return to parent block context on completion
-	prs.blockDepth--
-	prs.block = prs.block.parent
 }
 
 /*
@@ -217,17 +281,11 @@ func (prs *parser) parseVariableDeclaration(declType varDeclType) {
 		name := prs.ctx.sym.identifier
 
 		// Var hoists to function, let/const stay within current block
-		targetBlock := prs.block
-		if declType == DECL_VAR {
-			targetBlock = prs.rootBlock
-		}
-
-		// Define variable in target block, capture disallowed overlap
-		varDef, ok := targetBlock.defineVariable(prs, name, declType)
-		if !ok {
-			prs.addError("Cannot redeclare '" + name + "' in this scope")
+		varDef := prs.declareVariable(name, declType)
+		if varDef == nil {
 			return
 		}
+		prs.noteDeclaration(name)
 
 		// Check for initializer (assignment)
 		tok = prs.lex()
@@ -238,26 +296,38 @@ func (prs *parser) parseVariableDeclaration(declType varDeclType) {
 				return
 			}
 
-			// Add the initialization value store operation
-			op := prs.pushOpCode(engine.StoreVariableOperation, -1)
-			op.OpData = varDef.slotIndex
+			// Add the initialization value store operation (var initializers
+			// within a with statement assign to the object if present)
+			if declType == DECL_VAR && len(prs.withScopes) != 0 {
+				withOps := prs.pushWithLookups(name, true)
+				op := prs.pushOpCode(engine.StoreVariableKeepOperation, 0)
+				op.OpData = varDef.slotIndex
+				prs.patchWithLookups(withOps)
+				prs.pushOpCode(engine.PopOperation, -1)
+			} else {
+				op := prs.pushOpCode(engine.StoreVariableOperation, -1)
+				op.OpData = varDef.slotIndex
+			}
 			varDef.initialized = true
 			tok = prs.ctx.sym.token
 		} else if declType == DECL_CONST {
 			// Const variables must have initializer
 			prs.addError("Missing initializer in const declaration")
 			return
+		} else if declType == DECL_LET {
+			// Otherwise undefined, leaving the temporal dead zone
+			op := prs.pushOpCode(engine.PushLiteralValue, 1)
+			op.OpData = types.Undefined
+			op = prs.pushOpCode(engine.StoreVariableOperation, -1)
+			op.OpData = varDef.slotIndex
+			varDef.initialized = true
 		}
 
 		// Comma for more declarations, semicolon (implicit) ends declaration
 		if tok == GTOK_COMMA {
 			continue
 		}
-		if tok == GTOK_SEMI || tok == GTOK_EOF || tok == GTOK_RC {
-			return
-		}
-
-		prs.addError("Expected ';' after variable declaration")
+		prs.endStatement("variable declaration")
 		return
 	}
 }
@@ -336,7 +406,7 @@ func (prs *parser) parseIfStatement() {
  * IterationStatement:
  *     do Statement while ( Expression ) ;
  *
- * Enter: lexer on do, exit after semicolon.
+ * Enter: lexer on do, exit on (optional) semicolon.
  */
 func (prs *parser) parseDoStatement() {
 	// Push loop context for break/continue
@@ -402,6 +472,11 @@ func (prs *parser) parseDoStatement() {
 	jmpLoop := prs.pushOpCode(engine.JumpIfTrueOperation, -1)
 	jmpLoop.OpData = loopStart
 
+	// Semicolon is always inserted here, unless explicitly required
+	if prs.opts.DisableASI {
+		prs.endStatement("do-while statement")
+	}
+
 	// Pop loop context (patches break jumps)
 	prs.popLoopContext()
 }
@@ -475,6 +550,7 @@ func (prs *parser) parseWhileStatement() {
  *     | for ( LexicalDeclaration Expression[opt] ; Expression[opt] ) Statement
  *     | for ( ForDeclaration in Expression ) Statement
  *     | for ( ForDeclaration in AssignmentExpression ) Statement
+ *     | for await ( ForDeclaration of AssignmentExpression ) Statement
  *
  * Enter: lexer on 'for', exit after statement.
  */
@@ -482,8 +558,19 @@ func (prs *parser) parseForStatement() {
 	// Push loop context for break/continue
 	loopSwitchCtx := prs.pushLoopSwitchContext(false)
 
+	// Check for the asynchronous iteration form (only valid for of)
+	tok := prs.lex()
+	isAwait := false
+	if tok == GTOK_AWAIT {
+		if !prs.inAsync {
+			prs.addError("for await is only valid in async functions")
+		}
+		isAwait = true
+		tok = prs.lex()
+	}
+
 	// Require opening parenthesis
-	if prs.lex() != GTOK_LP {
+	if tok != GTOK_LP {
 		prs.addError("Expected '(' after 'for'")
 		prs.popLoopContext()
 		return
@@ -493,9 +580,10 @@ func (prs *parser) parseForStatement() {
 	prs.block = newBlock(prs.block)
 
 	// Parse initializer/declaration, capturing in/of form
-	tok := prs.lex()
+	tok = prs.lex()
 	var forDeclSlot int = -1
 	var forDeclName string
+	var iterSlots []int
 
 	if tok == GTOK_VAR || tok == GTOK_LET || tok == GTOK_CONST {
 		// ForDeclaration possible, check for in/of keywords
@@ -518,28 +606,32 @@ func (prs *parser) parseForStatement() {
 		// Check for in/of keywords for that form (TODO - of context keyword?)
 		nextTok := prs.lex()
 		if nextTok == GTOK_IN || nextTok == GTOK_OF {
-			varDef, ok := prs.block.defineVariable(prs, forDeclName, declType)
-			if !ok {
-				prs.addError("Cannot redeclare '" + forDeclName + "'")
+			varDef := prs.declareVariable(forDeclName, declType)
+			if varDef == nil {
 				prs.block = prs.block.parent
 				prs.popLoopContext()
 				return
 			}
 			varDef.initialized = true
 			forDeclSlot = varDef.slotIndex
-			prs.parseForInOfStatement(loopSwitchCtx, forDeclSlot,
-				nextTok == GTOK_IN)
+			if declType != DECL_VAR {
+				iterSlots = append(iterSlots, forDeclSlot)
+			}
+			prs.parseForInOfStatement(loopSwitchCtx, forDeclSlot, iterSlots,
+				nextTok == GTOK_IN, isAwait)
 			return
 		}
 
 		// 'Conventional' for, regular declaration with possible initializer
-		varDef, ok := prs.block.defineVariable(prs, forDeclName, declType)
-		if !ok {
-			prs.addError("Cannot redeclare '" + forDeclName + "'")
+		varDef := prs.declareVariable(forDeclName, declType)
+		if varDef == nil {
 			prs.block = prs.block.parent
 			prs.popLoopContext()
 			return
 		}
+		if declType == DECL_LET {
+			iterSlots = append(iterSlots, varDef.slotIndex)
+		}
 
 		// Handle variable initialization if discovered
 		if nextTok == GTOK_ASSIGN {
@@ -560,11 +652,13 @@ func (prs *parser) parseForStatement() {
 				break
 			}
 			name := prs.ctx.sym.identifier
-			nextVarDef, ok := prs.block.defineVariable(prs, name, declType)
-			if !ok {
-				prs.addError("Cannot redeclare '" + name + "'")
+			nextVarDef := prs.declareVariable(name, declType)
+			if nextVarDef == nil {
 				break
 			}
+			if declType == DECL_LET {
+				iterSlots = append(iterSlots, nextVarDef.slotIndex)
+			}
 			if prs.lex() == GTOK_ASSIGN {
 				prs.lex()
 				expr := prs.parseExpression(RBP_NO_COMMA)
@@ -578,10 +672,16 @@ func (prs *parser) parseForStatement() {
 		}
 	} else if tok == GTOK_IDENTIFIER {
 		// Similar to prior, no declaration but look for in/of form (TODO)
-		forDeclName = prs.ctx.sym.identifier
+		forDeclSym := prs.ctx.sym
+		forDeclName = forDeclSym.identifier
 		nextTok := prs.lex()
 		if nextTok == GTOK_IN || nextTok == GTOK_OF {
 			// Again in/of form but in this case variable must be declared
+			if !prs.checkStrictTarget(&forDeclSym) {
+				prs.block = prs.block.parent
+				prs.popLoopContext()
+				return
+			}
 			varDef := prs.block.resolveVariable(forDeclName)
 			if varDef == nil {
 				prs.addError("Undefined variable '" + forDeclName + "'")
@@ -590,13 +690,13 @@ func (prs *parser) parseForStatement() {
 				return
 			}
 			forDeclSlot = varDef.slotIndex
-			prs.parseForInOfStatement(loopSwitchCtx, forDeclSlot,
-				nextTok == GTOK_IN)
+			prs.parseForInOfStatement(loopSwitchCtx, forDeclSlot, nil,
+				nextTok == GTOK_IN, isAwait)
 			return
 		}
 
 		// 'Conventional' for, regular expression with possible initializer
-		expr := prs.parseExpressionWithIdentifier(0, forDeclName)
+		expr := prs.parseExpressionWithIdentifier(0, forDeclSym)
 		if expr != nil {
 			prs.pushEvalExpression(expr)
 			prs.pushOpCode(engine.PopOperation, -1)
@@ -612,6 +712,9 @@ func (prs *parser) parseForStatement() {
 	}
 
 	// After initializer, should be on semicolon
+	if isAwait {
+		prs.addError("for await requires an of iteration")
+	}
 	if prs.ctx.sym.token != GTOK_SEMI {
 		prs.addError("Expected ';' after for initializer")
 		prs.block = prs.block.parent
@@ -619,6 +722,13 @@ func (prs *parser) parseForStatement() {
 		return
 	}
 
+	// Section 14.7.4.2, let declarations are bound for each iteration (copied
+	// prior to the first test and before each update)
+	if len(iterSlots) != 0 {
+		op := prs.pushOpCode(engine.RenewBindingsOperation, 0)
+		op.OpData = iterSlots
+	}
+
 	// Record condition start position and parse it (optional)
 	condStart := len(prs.body.Code)
 	tok = prs.lex()
@@ -649,6 +759,10 @@ func (prs *parser) parseForStatement() {
 
 	// Continue jumps to the update expression
 	loopSwitchCtx.continueTarget = updateStart
+	if len(iterSlots) != 0 {
+		op := prs.pushOpCode(engine.RenewBindingsOperation, 0)
+		op.OpData = iterSlots
+	}
 
 	// Parse the update expression (optional)
 	tok = prs.lex()
@@ -702,10 +816,15 @@ func (prs *parser) parseForStatement() {
 }
 
 /*
- * Parse for...in loop body, called from above with lexer after 'in'.
+ * Parse for...in loop body, called from above with lexer after 'in'.  Lexical
+ * declarations (iterSlots) are bound fresh for each iteration.
  */
 func (prs *parser) parseForInOfStatement(loopSwitchCtx *loopSwitchContext,
-	varSlot int, isInLoop bool) {
+	varSlot int, iterSlots []int, isInLoop bool, isAwait bool) {
+	if isAwait && isInLoop {
+		prs.addError("for await requires an of iteration")
+	}
+
 	// Parse the object expression to iterate over
 	prs.lex()
 	iterExpr := prs.parseExpression(0)
@@ -727,13 +846,21 @@ func (prs *parser) parseForInOfStatement(loopSwitchCtx *loopSwitchContext,
 	if isInLoop {
 		prs.pushOpCode(engine.ForInKeysOperation, 1)
 	} else {
-		prs.pushOpCode(engine.ForOfIteratorOperation, 1)
+		op := prs.pushOpCode(engine.ForOfIteratorOperation, 1)
+		op.OpData = isAwait
 	}
 
 	// Mark loop start for continue and iteration looping
 	loopStart := len(prs.body.Code)
 	loopSwitchCtx.continueTarget = loopStart
 
+	// For await, the next result of an async iterator is awaited first
+	if isAwait {
+		prs.pushOpCode(engine.ForOfAsyncNextOperation, 1)
+		prs.pushOpCode(engine.AwaitOperation, 0)
+		prs.pushOpCode(engine.ForOfAsyncResultOperation, -1)
+	}
+
 	// And exit condition in this case is the iterator exhaustion
 	if isInLoop {
 		prs.pushOpCode(engine.ForInHasMoreOperation, 1)
@@ -744,6 +871,10 @@ func (prs *parser) parseForInOfStatement(loopSwitchCtx *loopSwitchContext,
 
 	// Net body starts with retrieval of next iteration value into variable
 	var op *engine.OpCode
+	if len(iterSlots) != 0 {
+		op = prs.pushOpCode(engine.RenewBindingsOperation, 0)
+		op.OpData = iterSlots
+	}
 	if isInLoop {
 		op = prs.pushOpCode(engine.ForInNextOperation, 0)
 	} else {
@@ -751,6 +882,15 @@ func (prs *parser) parseForInOfStatement(loopSwitchCtx *loopSwitchContext,
 	}
 	op.OpData = varSlot
 
+	// For await, the iteration value is resolved before the body
+	if isAwait {
+		ldOp := prs.pushOpCode(engine.LoadVariableOperation, 1)
+		ldOp.OpData = varSlot
+		prs.pushOpCode(engine.AwaitOperation, 0)
+		stOp := prs.pushOpCode(engine.StoreVariableOperation, -1)
+		stOp.OpData = varSlot
+	}
+
 	// Parse loop/body statement (add depth to discard expression value)
 	tok := prs.lex()
 	if tok == GTOK_ERROR || tok == GTOK_EOF {
@@ -833,6 +973,10 @@ func (prs *parser) parseSwitchStatement() {
 		return
 	}
 
+	// Case clauses share a block scope
+	prs.block = newBlock(prs.block)
+	prs.enterScope(false)
+
 	// Track pending jump from failed case comparison and default block
 	var caseSkipJmp *engine.OpCode
 	var defaultBodyStart = -1
@@ -914,10 +1058,13 @@ func (prs *parser) parseSwitchStatement() {
 
 		} else {
 			prs.addError("Expected 'case' or 'default' in switch")
+			prs.block = prs.block.parent
 			prs.popLoopContext()
 			return
 		}
 	}
+	prs.exitScope()
+	prs.block = prs.block.parent
 
 	// Update remaining case skip jump to default or end
 	if caseSkipJmp != nil {
@@ -953,11 +1100,14 @@ func (prs *parser) parseSwitchStatement() {
  */
 func (prs *parser) parseReturnStatement() {
 	// Check for optional return expression
+	// (restricted production, newline terminates the statement)
 	tok := prs.lex()
-	if tok == GTOK_SEMI || tok == GTOK_RC || tok == GTOK_EOF {
+	if tok == GTOK_SEMI || tok == GTOK_RC || tok == GTOK_EOF ||
+		prs.ctx.sym.newline {
 		// No return expression, returns undefined
 		op := prs.pushOpCode(engine.ReturnOperation, 0)
 		op.OpData = false
+		prs.endStatement("return statement")
 		return
 	}
 
@@ -970,6 +1120,60 @@ func (prs *parser) parseReturnStatement() {
 	// Return consuming value (not relevant as return will collapse stack)
 	op := prs.pushOpCode(engine.ReturnOperation, -1)
 	op.OpData = true
+	prs.endStatement("return statement")
+}
+
+/*
+ * Section 13.11
+ *
+ * WithStatement:
+ *     with ( Expression ) Statement
+ *
+ * Enter: lexer on with, exit after statement.
+ */
+func (prs *parser) parseWithStatement() {
+	// Require opening parenthesis
+	if prs.lex() != GTOK_LP {
+		prs.addError("Expected '(' after with statement")
+		return
+	}
+
+	// Parse the scope object expression
+	expr := prs.parseExpression(-1)
+	if expr == nil || !prs.pushEvalExpression(expr) {
+		return
+	}
+
+	// Require closing parenthesis
+	if prs.ctx.sym.token != GTOK_RP {
+		prs.addError("Expected ')' after with object")
+		return
+	}
+	prs.pushOpCode(engine.WithScopeOperation, 0)
+
+	// Object is held in a hidden variable of a wrapping block (for closures)
+	prs.block = newBlock(prs.block)
+	varName := withVarPrefix + strconv.Itoa(prs.withCount)
+	prs.withCount++
+	varDef, _ := prs.block.defineVariable(prs, varName, DECL_LET)
+	varDef.initialized = true
+	op := prs.pushOpCode(engine.StoreVariableOperation, -1)
+	op.OpData = varDef.slotIndex
+
+	// Parse the statement with the identifier lookups against the object
+	prs.withScopes = append(prs.withScopes, &withScope{
+		varName: varName,
+		block:   prs.block,
+		depth:   prs.functionDepth(),
+	})
+	tok := prs.lex()
+	if tok != GTOK_ERROR && tok != GTOK_EOF {
+		prs.blockDepth++
+		prs.parseStatementListItem(tok)
+		prs.blockDepth--
+	}
+	prs.withScopes = prs.withScopes[:len(prs.withScopes)-1]
+	prs.block = prs.block.parent
 }
 
 /*
@@ -1007,11 +1211,12 @@ func (prs *parser) parseReturnStatement() {
  *     StatementList[Return][opt]
  *
  * Note: the root method is shared between standard and arrow function parsers.
+ * The start is the source offset of the definition (for the source text).
  *
  * Enter: lexer on name (optional) or body, exit after end of body/expression.
  */
 func (prs *parser) parseFunctionDecl(nameReq bool, isArrow bool,
-	paramNames []string) *engine.ScriptFunction {
+	isAsync bool, paramNames []string, start int) *engine.ScriptFunction {
 	var fnName string
 	var hasRestParam bool
 
@@ -1098,9 +1303,17 @@ func (prs *parser) parseFunctionDecl(nameReq bool, isArrow bool,
 		captures: outerCaptures,
 	}
 	prs.captures = nil
+	prs.inAsync = isAsync
 
 	// All parameters become defined variables in the function block scope
-	for _, paramName := range paramNames {
+	// Duplicates (non-strict only) are hidden so that the last one binds
+	dupParam := ""
+	for idx, paramName := range paramNames {
+		if prev, ok := fnBlock.variables[paramName]; ok {
+			delete(fnBlock.variables, paramName)
+			fnBlock.variables["*param*"+strconv.Itoa(idx)] = prev
+			dupParam = paramName
+		}
 		varDef, ok := fnBlock.defineVariable(prs, paramName, DECL_VAR)
 		if !ok {
 			prs.addError("Duplicate parameter name '" + paramName + "'")
@@ -1132,7 +1345,17 @@ func (prs *parser) parseFunctionDecl(nameReq bool, isArrow bool,
 		// Arrow can be block body or expression with implicit return
 		tok := prs.ctx.sym.token
 		if tok == GTOK_LC {
-			prs.parseBlockStatement()
+			prs.prologue, prs.prologueOctal = true, false
+			prs.blockDepth++
+			prs.enterScope(true)
+			prs.parseStatementList()
+			prs.exitScope()
+			prs.blockDepth--
+			if prs.ctx.sym.token != GTOK_RC {
+				prs.addError("Unterminated block statement (missing '}')")
+			} else {
+				prs.lex()
+			}
 			// Not optimized but ensure an undefined is returned if not explicit
 			op := prs.pushOpCode(engine.ReturnOperation, 0)
 			op.OpData = false
@@ -1147,7 +1370,10 @@ func (prs *parser) parseFunctionDecl(nameReq bool, isArrow bool,
 		}
 	} else {
 		// Regular function is only a block body
+		prs.prologue, prs.prologueOctal = true, false
+		prs.enterScope(true)
 		prs.parseStatementList()
+		prs.exitScope()
 		if prs.ctx.sym.token != GTOK_RC {
 			prs.addError("Expected '}' at end of function body")
 			// It's broken but we do have a function of sorts, continue
@@ -1159,15 +1385,19 @@ func (prs *parser) parseFunctionDecl(nameReq bool, isArrow bool,
 		op.OpData = false
 	}
 
-	// Collect the captures accumulated in the body for the function
-	var captures []engine.CaptureInfo
-	for _, cap := range prs.captures {
-		captures = append(captures, engine.CaptureInfo{
-			Name:      cap.name,
-			SlotIndex: cap.slotIndex,
-			IsCapture: cap.isCapture,
-		})
+	// Strictness is known after the body (directive), check the parameters
+	fnBody.Strict = prs.strict
+	if dupParam != "" && (prs.strict || isArrow) {
+		prs.addError("Duplicate parameter name '" + dupParam +
+			"' not allowed in this context")
 	}
+	prs.checkStrictBinding(fnName)
+	for _, paramName := range paramNames {
+		prs.checkStrictBinding(paramName)
+	}
+
+	// Collect the captures accumulated in the body for the function
+	captures := prs.captureInfo()
 
 	// Restore original parser context (captures may have been modified)
 	prs.restoreContext(&savedCtx, outerCaptures)
@@ -1181,7 +1411,9 @@ func (prs *parser) parseFunctionDecl(nameReq bool, isArrow bool,
 		ArgumentsSlot: argumentsSlot,
 		ThisSlot:      thisSlot,
 		IsArrowFunc:   isArrow,
+		IsAsync:       isAsync,
 		Captures:      captures,
+		Source:        prs.sourceText(start),
 	}
 }
 
@@ -1191,6 +1423,9 @@ func (prs *parser) restoreContext(savedCtx *parser, captures *[]captureEntry) {
 	prs.rootBlock = savedCtx.rootBlock
 	prs.block = savedCtx.block
 	prs.outerScope = savedCtx.outerScope
+	prs.inAsync = savedCtx.inAsync
+	prs.strict = savedCtx.strict
+	prs.prologue = false
 	if captures != nil {
 		prs.captures = *captures
 	} else {
@@ -1200,32 +1435,15 @@ func (prs *parser) restoreContext(savedCtx *parser, captures *[]captureEntry) {
 
 /*
  * Wrapper function declaration statement, parses and stores local/global.
+ * Also used for async function declarations (lexer on 'function' for both).
  */
-func (prs *parser) parseFunctionStatement() {
+func (prs *parser) parseFunctionStatement(isAsync bool, start int) {
 	prs.lex()
-	fn := prs.parseFunctionDecl(true, false, nil)
+	fn := prs.parseFunctionDecl(true, false, isAsync, nil, start)
 	if fn == nil {
 		return
 	}
-
-	// Functions are first-class variables, in open declaration also global
-	varDef, ok := prs.rootBlock.defineVariable(prs, fn.Name, DECL_VAR)
-	if !ok {
-		prs.addError("Cannot redeclare '" + fn.Name + "' in this scope")
-		return
-	}
-	varDef.initialized = true
-
-	// Generate function as value, store in global table and as local variable
-	fnVal := types.DataType(fn)
-	op := prs.pushOpCode(engine.PushFunctionOperation, 1)
-	op.OpData = fnVal
-
-	globalOp := prs.pushOpCode(engine.StoreGlobalOperation, 0)
-	globalOp.OpData = fn.Name
-
-	storeOp := prs.pushOpCode(engine.StoreVariableOperation, -1)
-	storeOp.OpData = varDef.slotIndex
+	prs.declareFunction(fn)
 }
 
 /*
@@ -1244,8 +1462,9 @@ func (prs *parser) parseFunctionStatement() {
  *
  * Called on body (next token after =>) ends on body/expression end.
  */
-func (prs *parser) parseArrowFunctionBody(paramNames []string) *symType {
-	fn := prs.parseFunctionDecl(false, true, paramNames)
+func (prs *parser) parseArrowFunctionBody(paramNames []string,
+	isAsync bool, start int) *symType {
+	fn := prs.parseFunctionDecl(false, true, isAsync, paramNames, start)
 	if fn == nil {
 		return nil
 	}
@@ -1270,9 +1489,9 @@ func (prs *parser) parseArrowFunctionBody(paramNames []string) *symType {
 func (prs *parser) parseContinueStatement() {
 	var lsCtx *loopSwitchContext
 
-	// Check for optional label
+	// Check for optional label (restricted production, same line only)
 	tok := prs.lex()
-	if tok == GTOK_IDENTIFIER {
+	if tok == GTOK_IDENTIFIER && !prs.ctx.sym.newline {
 		// Labelled continue, find target loop context
 		label := prs.ctx.sym.identifier
 		for lsCtx = prs.loopSwitchCtx; lsCtx != nil; lsCtx = lsCtx.parent {
@@ -1286,7 +1505,7 @@ func (prs *parser) parseContinueStatement() {
 		}
 
 		// Consume the label identifier (move to next)
-		tok = prs.lex()
+		prs.lex()
 	} else {
 		// Unlabelled continue, find innermost loop context
 		for lsCtx = prs.loopSwitchCtx; lsCtx != nil; lsCtx = lsCtx.parent {
@@ -1310,6 +1529,7 @@ func (prs *parser) parseContinueStatement() {
 	}
 
 	// Already on semicolon or next statement token
+	prs.endStatement("continue statement")
 }
 
 /*
@@ -1324,9 +1544,9 @@ func (prs *parser) parseContinueStatement() {
 func (prs *parser) parseBreakStatement() {
 	var lsCtx *loopSwitchContext
 
-	// Check for optional label
+	// Check for optional label (restricted production, same line only)
 	tok := prs.lex()
-	if tok == GTOK_IDENTIFIER {
+	if tok == GTOK_IDENTIFIER && !prs.ctx.sym.newline {
 		// Labelled break, find target loop context
 		label := prs.ctx.sym.identifier
 		for lsCtx = prs.loopSwitchCtx; lsCtx != nil; lsCtx = lsCtx.parent {
@@ -1338,7 +1558,7 @@ func (prs *parser) parseBreakStatement() {
 			prs.addError("Undefined label '" + label + "' for break")
 			return
 		}
-		tok = prs.lex() // Consume the label
+		prs.lex() // Consume the label
 	} else {
 		// Unlabelled break, find innermost loop or switch context
 		lsCtx = prs.loopSwitchCtx
@@ -1353,6 +1573,7 @@ func (prs *parser) parseBreakStatement() {
 	lsCtx.breakJumps = append(lsCtx.breakJumps, jmp)
 
 	// Already on semicolon or next statement token
+	prs.endStatement("break statement")
 }
 
 /*
@@ -1364,8 +1585,17 @@ func (prs *parser) parseBreakStatement() {
  * Enter: lexer on 'throw', exit on semicolon.
  */
 func (prs *parser) parseThrowStatement() {
-	// Parse the expression to throw
-	expr := prs.parseExpression(-1)
+	// Parse the expression to throw (which must be on the same line)
+	tok := prs.lex()
+	if tok == GTOK_EOF || tok == GTOK_ERROR {
+		prs.addError("Expected expression after throw")
+		return
+	}
+	if prs.ctx.sym.newline {
+		prs.addError("Illegal newline after throw")
+		return
+	}
+	expr := prs.parseExpression(0)
 	if expr == nil {
 		prs.addError("Expected expression after throw")
 		return
@@ -1376,6 +1606,7 @@ func (prs *parser) parseThrowStatement() {
 
 	// If you want your boomerang to come back, first you've got to...
 	prs.pushOpCode(engine.ThrowOperation, -1)
+	prs.endStatement("throw statement")
 }
 
 /*
@@ -1440,6 +1671,9 @@ func (prs *parser) parseTryStatement() {
 				return
 			}
 			catchVarName = prs.ctx.sym.identifier
+			if !prs.checkStrictBinding(catchVarName) {
+				return
+			}
 
 			// Create a block for the catch variable
 			prs.block = newBlock(prs.block)
@@ -1452,6 +1686,7 @@ func (prs *parser) parseTryStatement() {
 				return
 			}
 			varDef.initialized = true
+			varDef.catchParam = true
 			tryCtx.CatchVarSlot = varDef.slotIndex
 
 			if prs.lex() != GTOK_RP {
@@ -1471,10 +1706,18 @@ func (prs *parser) parseTryStatement() {
 			return
 		}
 
-		// Parse catch block (add depth to discard expression value)
-		prs.blockDepth++
-		prs.parseStatementList()
-		prs.blockDepth--
+		// Parse catch block (add depth to discard expression value), where
+		// the body shares the block of the parameter so that redeclaring it
+		// as a lexical binding is an error (Section 14.15.1)
+		if catchVarName != "" {
+			prs.blockDepth++
+			prs.enterScope(false)
+			prs.parseStatementList()
+			prs.exitScope()
+			prs.blockDepth--
+		} else {
+			prs.parseScopedStatementList()
+		}
 
 		if prs.ctx.sym.token != GTOK_RC {
 			prs.addError("Expected '}' at end of catch block")
@@ -1505,9 +1748,7 @@ func (prs *parser) parseTryStatement() {
 		}
 
 		// Parse finally block (add depth to discard expression value)
-		prs.blockDepth++
-		prs.parseStatementList()
-		prs.blockDepth--
+		prs.parseScopedStatementList()
 
 		if prs.ctx.sym.token != GTOK_RC {
 			prs.addError("Expected '}' at end of finally block")
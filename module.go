@@ -0,0 +1,173 @@
+/*
+ * Host integration for ES modules (loading, caching and synthetic modules).
+ *
+ * Copyright (C) 2005-2026 J.M. Heisz.  All Rights Reserved.
+ * See the LICENSE file accompanying the distribution your rights to use
+ * this software.
+ */
+
+package gescript
+
+import (
+	"fmt"
+
+	"github.com/heisz/gescript/internal/engine"
+	"github.com/heisz/gescript/internal/parser"
+	"github.com/heisz/gescript/types"
+)
+
+// Source for a loaded module, either the script text or a precompiled module
+// (from ParseModule), the latter taking precedence if provided
+type ModuleSource struct {
+	Source string
+	Script *Script
+}
+
+/*
+ * ModuleLoader is provided by the host to locate modules for import.  The
+ * specifier (as written in the import) is first resolved against the name of
+ * the importing module (empty for the initial script) to a unique module
+ * name, which is used to cache the module instance within the context.  The
+ * module source is then loaded by the resolved name (once per context).
+ */
+type ModuleLoader interface {
+	Resolve(specifier, referrer string) (string, error)
+	Load(name string) (ModuleSource, error)
+}
+
+// Simple loader implementation for a fixed set of module sources, where the
+// specifiers are the names (no relative resolution)
+type MapModuleLoader map[string]string
+
+func (ldr MapModuleLoader) Resolve(specifier, referrer string) (string,
+	error) {
+	if _, ok := ldr[specifier]; !ok {
+		return "", fmt.Errorf("Cannot find module '%s'", specifier)
+	}
+	return specifier, nil
+}
+
+func (ldr MapModuleLoader) Load(name string) (ModuleSource, error) {
+	return ModuleSource{Source: ldr[name]}, nil
+}
+
+// Parse the source as an ES module (allowing import/export declarations)
+func ParseModule(source string) (prg *Script, err error) {
+	return ParseModuleWithOptions(source, types.ParseOptions{})
+}
+
+// Parse the source as an ES module with the specified parsing rules
+func ParseModuleWithOptions(source string,
+	opts types.ParseOptions) (prg *Script, err error) {
+	code, errs := parser.ParseModuleWithOptions(source, opts)
+	if len(errs) > 0 {
+		return nil, errs[0]
+	}
+
+	return &Script{
+		body:   code.Body,
+		module: code,
+	}, nil
+}
+
+// Determine if the script was parsed as a module
+func (prg *Script) IsModule() bool {
+	return prg.module != nil
+}
+
+// Define the loader used to resolve the imports of modules in the context
+func (ctx *ScriptContext) SetModuleLoader(loader ModuleLoader) {
+	ctx.loader = loader
+}
+
+// Register a host-defined (synthetic) module for the specifier with the
+// given exports, these are resolved directly (ahead of the module loader)
+func (ctx *ScriptContext) RegisterModule(specifier string,
+	exports map[string]types.DataType) {
+	if ctx.hostModules == nil {
+		ctx.hostModules = make(map[string]*engine.ModuleInstance)
+	}
+	ctx.hostModules[specifier] = engine.NewSyntheticModule(specifier, exports)
+}
+
+// Import the module for the specifier, evaluating it (once) if required and
+// returning the namespace of exports for host access
+func (ctx *ScriptContext) Import(specifier string) (*types.ModuleNamespace,
+	error) {
+	mod, err := ctx.loadModule(specifier, "")
+	if err != nil {
+		return nil, err
+	}
+	if _, err = mod.Evaluate(ctx.newProcess); err != nil {
+		return nil, err
+	}
+	return mod.Namespace(), nil
+}
+
+// Run the parsed module as the initial (unnamed) module in the context
+func (ctx *ScriptContext) runModule(prg *Script) (types.DataType, error) {
+	mod := engine.NewModuleInstance("", prg.module)
+	if err := ctx.loadDependencies(mod); err != nil {
+		return types.Undefined, err
+	}
+	return mod.Evaluate(ctx.newProcess)
+}
+
+// Locate the module instance for the specifier, loading/parsing it and all
+// dependent modules on first use
+func (ctx *ScriptContext) loadModule(specifier,
+	referrer string) (*engine.ModuleInstance, error) {
+	if mod, ok := ctx.hostModules[specifier]; ok {
+		return mod, nil
+	}
+	if ctx.loader == nil {
+		return nil, fmt.Errorf("Cannot find module '%s' (no module loader)",
+			specifier)
+	}
+
+	name, err := ctx.loader.Resolve(specifier, referrer)
+	if err != nil {
+		return nil, err
+	}
+	if mod, ok := ctx.modules[name]; ok {
+		return mod, nil
+	}
+
+	src, err := ctx.loader.Load(name)
+	if err != nil {
+		return nil, err
+	}
+	prg := src.Script
+	if prg == nil {
+		if prg, err = ParseModuleWithOptions(src.Source,
+			ctx.parseOpts); err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+	} else if !prg.IsModule() {
+		return nil, fmt.Errorf("Script for '%s' is not a module", name)
+	}
+
+	// Cache ahead of the dependencies to handle circular imports
+	mod := engine.NewModuleInstance(name, prg.module)
+	if ctx.modules == nil {
+		ctx.modules = make(map[string]*engine.ModuleInstance)
+	}
+	ctx.modules[name] = mod
+	if err := ctx.loadDependencies(mod); err != nil {
+		delete(ctx.modules, name)
+		return nil, err
+	}
+	return mod, nil
+}
+
+// Load the modules requested by the module instance
+func (ctx *ScriptContext) loadDependencies(mod *engine.ModuleInstance) error {
+	for _, spec := range mod.Code.Requests {
+		dep, err := ctx.loadModule(spec, mod.Name)
+		if err != nil {
+			return err
+		}
+		mod.Dependencies[spec] = dep
+	}
+	return nil
+}
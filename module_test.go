@@ -0,0 +1,248 @@
+/*
+ * Test methods for ES modules (import/export and host loading).
+ *
+ * Copyright (C) 2005-2026 J.M. Heisz.  All Rights Reserved.
+ * See the LICENSE file accompanying the distribution your rights to use
+ * this software.
+ */
+
+package gescript
+
+import (
+	"errors"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/heisz/gescript/types"
+)
+
+// Helper to run a module in the context and verify the (native) result
+func checkModule(tst *testing.T, ctx *ScriptContext, src string,
+	expected interface{}) {
+	prg, err := ParseModule(src)
+	if err != nil {
+		tst.Fatalf("Unexpected error parsing module '%s': %v", src, err)
+	}
+	res, err := prg.RunWithContext(ctx)
+	if err != nil {
+		tst.Fatalf("Unexpected error running module '%s': %v", src, err)
+	}
+	if actual := res.Native(); actual != expected {
+		tst.Fatalf("Module '%s': expected %v (%T), got %v (%T)",
+			src, expected, expected, actual, actual)
+	}
+}
+
+// And the corresponding helper for expected errors (parse or run)
+func checkModuleError(tst *testing.T, ctx *ScriptContext, src string,
+	expected string) {
+	prg, err := ParseModule(src)
+	if err == nil {
+		_, err = prg.RunWithContext(ctx)
+	}
+	if err == nil {
+		tst.Fatalf("Expected error for module '%s'", src)
+	}
+	if !strings.Contains(err.Error(), expected) {
+		tst.Fatalf("Module '%s': expected error '%s', got '%v'",
+			src, expected, err)
+	}
+}
+
+func TestModuleImportExport(tst *testing.T) {
+	ctx := NewScriptContext()
+	ctx.SetModuleLoader(MapModuleLoader{
+		"math": `export const pi = 3;
+                 export function square(x) { return x * x; }
+                 export default function cube(x) { return x * x * x; }`,
+		"anon": `export default 6 * 7;`,
+		"list": `var a = 1, b = 2;
+                 export { a, b as two };`,
+		"re": `export * from 'math';
+                 export { two as deux } from 'list';
+                 export * as list from 'list';
+                 export { default as answer } from 'anon';`,
+	})
+
+	checkModule(tst, ctx, `import { pi, square } from 'math';
+                           square(pi)`, int64(9))
+	checkModule(tst, ctx, `import cube, { pi as p } from 'math';
+                           cube(p)`, int64(27))
+	checkModule(tst, ctx, `import answer from 'anon';
+                           answer`, int64(42))
+	checkModule(tst, ctx, `import { default as d } from 'anon';
+                           d`, int64(42))
+	checkModule(tst, ctx, `import { a, two } from 'list';
+                           a + two`, int64(3))
+	checkModule(tst, ctx, `import * as m from 'math';
+                           typeof m + m.square(2) + ('pi' in m) +
+                               Object.keys(m).length`, "object4true3")
+	checkModule(tst, ctx, `import { square, deux, list, answer } from 're';
+                           square(deux) + list.a + answer`, int64(47))
+	checkModule(tst, ctx, `import * as re from 're';
+                           re.default`, nil)
+	checkModule(tst, ctx, `import 'math';
+                           export const local = 1;
+                           local`, int64(1))
+}
+
+func TestModuleLiveBindings(tst *testing.T) {
+	ctx := NewScriptContext()
+	ctx.SetModuleLoader(MapModuleLoader{
+		"counter": `export let count = 0;
+                    export function incr() { count++; }`,
+		"a": `import { b } from 'b';
+              export function a() { return 'a' + b(); }`,
+		"b": `import { a } from 'a';
+              export function b() { return 'b'; }
+              export function ab() { return a(); }`,
+		"c": `import { d } from 'd';
+              export var c = 'c' + d;`,
+		"d": `import { c } from 'c';
+              export var d = 'd' + c;`,
+		"e": `import { f } from 'f';
+              export let e = 'e';`,
+		"f": `import { e } from 'e';
+              export const f = 1;
+              export let g;
+              try { g = e; } catch (x) { g = x.name; }`,
+	})
+
+	// Imports are live views of the exporting module (evaluated once)
+	checkModule(tst, ctx, `import { count, incr } from 'counter';
+                           import * as ns from 'counter';
+                           incr(); incr();
+                           count + ns.count`, int64(4))
+	checkModule(tst, ctx, `import { count } from 'counter';
+                           count`, int64(2))
+
+	// Circular imports, bindings are resolved when called
+	checkModule(tst, ctx, `import { ab } from 'b';
+                           ab()`, "ab")
+
+	// Or the value at time of evaluation for the cycle (not yet assigned)
+	checkModule(tst, ctx, `import { c } from 'c';
+                           c`, "cdundefined")
+
+	// Unless lexical, which are in the temporal dead zone until evaluated
+	checkModule(tst, ctx, `import { e } from 'e';
+                           import { g } from 'f';
+                           e + ':' + g`, "e:ReferenceError")
+
+	// Local updates of exported and captured bindings
+	checkModule(tst, ctx, `export let n = 1;
+                           n++; --n;
+                           (() => { n += 10; n--; return ++n; })()`,
+		int64(11))
+}
+
+func TestModuleHostIntegration(tst *testing.T) {
+	ctx := NewScriptContext()
+	ctx.RegisterModule("host", map[string]types.DataType{
+		"version": types.StringType("1.0"),
+		"twice": &types.NativeFunction{Name: "twice",
+			Fn: func(prc types.Process,
+				args []types.DataType) (types.DataType, error) {
+				return types.IntegerType(2 * types.ToInt(args[0])), nil
+			}},
+	})
+	precompiled, err := ParseModule(`export const name = import.meta.url;`)
+	if err != nil {
+		tst.Fatalf("Unexpected error parsing precompiled module: %v", err)
+	}
+	ctx.SetModuleLoader(&testLoader{precompiled: precompiled})
+
+	checkModule(tst, ctx, `import { version, twice } from 'host';
+                           version + twice(4)`, "1.08")
+	checkModule(tst, ctx, `import { name } from './lib/pre';
+                           name`, "/lib/pre")
+	checkModule(tst, ctx, `import { name } from './sub';
+                           name`, "/lib/pre")
+	checkModule(tst, ctx, `const f = () => import.meta.url;
+                           typeof import.meta + f()`, "object")
+
+	// Host access to the exports of a module
+	ns, err := ctx.Import("./lib/pre")
+	if err != nil {
+		tst.Fatalf("Unexpected error importing module: %v", err)
+	}
+	if val, _ := ns.Get("name"); val.Native() != "/lib/pre" {
+		tst.Fatalf("Incorrect export value from imported namespace")
+	}
+	if _, err := ctx.Import("./nothing"); err == nil {
+		tst.Fatalf("Expected error importing missing module")
+	}
+
+	// Clones share the loader but not the module instances
+	clone := ctx.Clone()
+	checkModule(tst, clone, `import { version } from 'host';
+                             version`, "1.0")
+	if len(clone.modules) != 0 {
+		tst.Fatalf("Clone should not share loaded modules")
+	}
+}
+
+// Loader with relative path resolution and a precompiled module
+type testLoader struct {
+	precompiled *Script
+}
+
+func (ldr *testLoader) Resolve(specifier, referrer string) (string, error) {
+	name := "/lib/" + path.Base(specifier)
+	if name != "/lib/pre" && name != "/lib/sub" {
+		return "", errors.New("Cannot find module " + specifier)
+	}
+	return name, nil
+}
+
+func (ldr *testLoader) Load(name string) (ModuleSource, error) {
+	if name == "/lib/pre" {
+		return ModuleSource{Script: ldr.precompiled}, nil
+	}
+	return ModuleSource{Source: `export { name } from './pre';`}, nil
+}
+
+func TestModuleErrors(tst *testing.T) {
+	ctx := NewScriptContext()
+	ctx.SetModuleLoader(MapModuleLoader{
+		"ok":     `export var x = 1;`,
+		"broken": `import { y } from 'ok';`,
+		"throws": `throw 'failed';`,
+		"amb1":   `export var z = 1;`,
+		"amb2":   `export var z = 2;`,
+		"amb":    `export * from 'amb1'; export * from 'amb2';`,
+	})
+
+	checkModuleError(tst, ctx, `import { y } from 'ok';`,
+		"does not provide an export named 'y'")
+	checkModuleError(tst, ctx, `import 'broken';`,
+		"does not provide an export named 'y'")
+	checkModuleError(tst, ctx, `import { z } from 'amb';`,
+		"does not provide an export named 'z'")
+	checkModuleError(tst, ctx, `import 'missing';`,
+		"Cannot find module 'missing'")
+	checkModuleError(tst, ctx, `import 'throws';`, "Uncaught exception")
+	checkModuleError(tst, ctx, `import 'throws';`, "Uncaught exception")
+	checkModuleError(tst, ctx, `import { x } from 'ok'; x = 2;`,
+		"Cannot reassign constant 'x'")
+	checkModuleError(tst, ctx, `if (true) { import 'ok'; }`,
+		"only appear at top level")
+	checkModuleError(tst, ctx, `function f() { export var a; }`,
+		"only appear at top level")
+	checkModuleError(tst, ctx, `export { nothing };`,
+		"Export 'nothing' is not defined")
+	checkModuleError(tst, ctx, `export var a; export { a };`,
+		"Duplicate export of 'a'")
+
+	// Module syntax is not allowed in scripts
+	for _, src := range []string{"import 'ok';", "export var a;",
+		"import.meta"} {
+		if _, err := Parse(src); err == nil {
+			tst.Fatalf("Expected error for module syntax in script '%s'", src)
+		}
+	}
+	if _, err := NewScriptContext().Import("any"); err == nil {
+		tst.Fatalf("Expected error for import without loader")
+	}
+}
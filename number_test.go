@@ -0,0 +1,69 @@
+/*
+ * Test methods for the number to string conversions.
+ *
+ * Copyright (C) 2005-2026 J.M. Heisz.  All Rights Reserved.
+ * See the LICENSE file accompanying the distribution your rights to use
+ * this software.
+ */
+
+package gescript
+
+import (
+	"testing"
+)
+
+func TestNumberToString(tst *testing.T) {
+	ctx := NewScriptContext()
+
+	// Number::toString, with exponential notation beyond 1e21 and 1e-7
+	checkScript(tst, ctx, `[1e21, 1e20, 123e-20, 1e-7, 0.000001, -0, 0 / 0,
+                            1 / 0, -1 / 0, 0.1 + 0.2, 5e-324, 2 / 3,
+                            1.7976931348623157e308, -1.5e-9].join(' ')`,
+		"1e+21 100000000000000000000 1.23e-18 1e-7 0.000001 0 NaN "+
+			"Infinity -Infinity 0.30000000000000004 5e-324 "+
+			"0.6666666666666666 1.7976931348623157e+308 -1.5e-9")
+	checkScript(tst, ctx, "'' + 1e21 + ':' + String(-1e-7)", "1e+21:-1e-7")
+
+	// Radix conversion, including fractions
+	checkScript(tst, ctx, `[(255).toString(16), (-255).toString(2),
+                            (0.5).toString(2), (0.1).toString(3),
+                            (3.75).toString(16), (1e21).toString(36),
+                            (-0.3).toString(7), (0 / 0).toString(2),
+                            (12.5).toString(10)].join(' ')`,
+		"ff -11111111 0.1 0.0022002200220022002200220022002201 3.c "+
+			"5v1j4f4ds7c000 -0.2046204620462046205 NaN 12.5")
+
+	// Fixed, precision and exponential formats (rounding on exact values)
+	checkScript(tst, ctx, `[(2.5).toFixed(0), (-2.5).toFixed(0),
+                            (1.005).toFixed(2), (1.45).toFixed(1),
+                            (0.000001).toFixed(7), (1e21).toFixed(2),
+                            (123.456).toFixed(), (-0.0001).toFixed(2),
+                            (0.5).toFixed(20)].join(' ')`,
+		"3 -3 1.00 1.4 0.0000010 1e+21 123 -0.00 0.50000000000000000000")
+	checkScript(tst, ctx, `[(123.456).toPrecision(4), (0.00001).toPrecision(1),
+                            (0.000001234).toPrecision(2), (1e21).toPrecision(3),
+                            (999.99).toPrecision(3), (0).toPrecision(3),
+                            (25).toPrecision(1), (1.5).toPrecision()]
+                               .join(' ')`,
+		"123.5 0.00001 0.0000012 1.00e+21 1.00e+3 0.00 3e+1 1.5")
+	checkScript(tst, ctx, `[(123456).toExponential(2), (0.00015).toExponential(),
+                            (1.5).toExponential(0), (0).toExponential(2),
+                            (-9.995).toExponential(2), (1 / 0).toExponential(),
+                            (1e-7).toExponential(3)].join(' ')`,
+		"1.23e+5 1.5e-4 2e+0 0.00e+0 -9.99e+0 Infinity 1.000e-7")
+
+	for src, expected := range map[string]string{
+		"(1).toString(1)":                 "RangeError",
+		"(1).toString(37)":                "RangeError",
+		"(1).toFixed(101)":                "RangeError",
+		"(1).toFixed(-1)":                 "RangeError",
+		"(1).toPrecision(0)":              "RangeError",
+		"(1).toExponential(101)":          "RangeError",
+		"(0 / 0).toPrecision(0) + 'x'":    "",
+		"(1 / 0).toExponential(-1) + 'x'": "",
+	} {
+		checkScript(tst, ctx, `var r = '';
+                               try { `+src+`; } catch (e) { r = e.name; }
+                               r`, expected)
+	}
+}
@@ -0,0 +1,136 @@
+/*
+ * Test methods for operator support on host value types.
+ *
+ * Copyright (C) 2005-2026 J.M. Heisz.  All Rights Reserved.
+ * See the LICENSE file accompanying the distribution your rights to use
+ * this software.
+ */
+
+package gescript
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/heisz/gescript/types"
+)
+
+// Money value in cents, with arithmetic against money and scaling by numbers
+type opMoney int64
+
+func (mny opMoney) Native() interface{} { return int64(mny) }
+
+func (mny opMoney) ToPrimitive(pref any) types.DataType {
+	if mny < 0 {
+		return types.StringType("-" + types.ToString(-mny))
+	}
+	return types.StringType(fmt.Sprintf("$%d.%02d", mny/100, mny%100))
+}
+
+func (mny opMoney) BinaryOp(prc types.Process, op types.Operator,
+	other types.DataType, reversed bool) (types.DataType, error) {
+	if omny, ok := other.(opMoney); ok {
+		switch op {
+		case types.OpAdd:
+			return mny + omny, nil
+		case types.OpSubtract:
+			if reversed {
+				return omny - mny, nil
+			}
+			return mny - omny, nil
+		}
+		return nil, errors.New("TypeError: Invalid money operation " +
+			string(op))
+	}
+
+	// Numbers scale the amount, anything else (e.g. strings) is default
+	switch other.(type) {
+	case types.IntegerType, types.NumberType:
+	default:
+		return nil, types.ErrNoOperator
+	}
+	factor := types.ToNumber(other)
+	switch {
+	case op == types.OpMultiply:
+		return opMoney(float64(mny) * factor), nil
+	case op == types.OpDivide && !reversed:
+		if factor == 0 {
+			return nil, errors.New("RangeError: Division by zero")
+		}
+		return opMoney(float64(mny) / factor), nil
+	}
+	return nil, errors.New("TypeError: Cannot mix money and numbers")
+}
+
+func (mny opMoney) Compare(prc types.Process,
+	other types.DataType) (int, error) {
+	omny, ok := other.(opMoney)
+	if !ok {
+		return 0, types.ErrNoOperator
+	}
+	switch {
+	case mny < omny:
+		return -1, nil
+	case mny > omny:
+		return 1, nil
+	}
+	return 0, nil
+}
+
+func (mny opMoney) Equals(prc types.Process,
+	other types.DataType) (bool, error) {
+	omny, ok := other.(opMoney)
+	if !ok {
+		return false, types.ErrNoOperator
+	}
+	return mny == omny, nil
+}
+
+func (mny opMoney) Negate(prc types.Process) (types.DataType, error) {
+	return -mny, nil
+}
+
+func TestOperatorOverloading(tst *testing.T) {
+	ctx := NewScriptContext()
+	ctx.SetGlobal("price", opMoney(1250))
+	ctx.SetGlobal("tax", opMoney(163))
+	ctx.RegisterGoFunc("cents", func(val int64) opMoney {
+		return opMoney(val)
+	})
+
+	// Arithmetic with money and numbers (either side)
+	checkScript(tst, ctx, "'' + (price + tax)", "$14.13")
+	checkScript(tst, ctx, "'' + (price - tax) + ',' + (tax - price)",
+		"$10.87,-$10.87")
+	checkScript(tst, ctx, "'' + (price * 2) + ',' + (3 * tax)",
+		"$25.00,$4.89")
+	checkScript(tst, ctx, "'' + price / 5 + ',' + -tax", "$2.50,-$1.63")
+	checkScript(tst, ctx, "var t = price; t = t + tax; t = t - cents(13); '' + t",
+		"$14.00")
+
+	// Defaults still apply for unsupported operands (string concatenation)
+	checkScript(tst, ctx, "'Total: ' + price", "Total: $12.50")
+	checkScript(tst, ctx, "price + ' due'", "$12.50 due")
+	checkScript(tst, ctx, "price + true", "$12.50true")
+
+	// Comparison and equality (strict equality is identity, the same for a
+	// Go value type)
+	checkScript(tst, ctx, "(price > tax) + ':' + (price <= tax) + ':' + "+
+		"(tax < price) + ':' + (tax >= cents(163))", "true:false:true:true")
+	checkScript(tst, ctx, "(tax == cents(163)) + ':' + (tax != cents(163))"+
+		" + ':' + (tax === cents(163))", "true:false:true")
+
+	// Host errors are catchable
+	for src, expected := range map[string]string{
+		"price * tax": "TypeError: Invalid money operation *",
+		"price / 0":   "RangeError: Division by zero",
+		"10 / price":  "TypeError: Cannot mix money and numbers",
+	} {
+		checkScript(tst, ctx, `var r = '';
+                               try { `+src+`; } catch (e) {
+                                   r = e.name + ': ' + e.message;
+                               }
+                               r`, expected)
+	}
+}
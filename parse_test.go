@@ -0,0 +1,43 @@
+/*
+ * Test methods for the parse options, applied to scripts, eval and modules.
+ *
+ * Copyright (C) 2005-2026 J.M. Heisz.  All Rights Reserved.
+ * See the LICENSE file accompanying the distribution your rights to use
+ * this software.
+ */
+
+package gescript
+
+import (
+	"testing"
+
+	"github.com/heisz/gescript/types"
+)
+
+func TestParseOptions(tst *testing.T) {
+	src := "var a = 1\nvar b = 2\na + b"
+	if _, err := Parse(src); err != nil {
+		tst.Fatalf("Unexpected error parsing with semicolon insertion: %v", err)
+	}
+	opts := types.ParseOptions{DisableASI: true}
+	if _, err := ParseWithOptions(src, opts); err == nil {
+		tst.Fatalf("Expected error for missing semicolon without insertion")
+	}
+
+	// Options also apply to eval and the modules loaded by the context
+	ctx := NewScriptContext()
+	ctx.SetModuleLoader(MapModuleLoader{"lib": "export var x = 1\n"})
+	checkScript(tst, ctx, "eval('var q = 1\\nq + 1')", int64(2))
+	checkModule(tst, ctx, "import { x } from 'lib'; x;", int64(1))
+
+	ctx = NewScriptContext()
+	ctx.SetParseOptions(opts)
+	ctx.SetModuleLoader(MapModuleLoader{"lib": "export var x = 1\n"})
+	checkScript(tst, ctx, `var r;
+                           try { eval('var q = 1\nq + 1'); }
+                           catch (e) { r = e.name + ':' + e.message; }
+                           r.indexOf("SyntaxError:") == 0 &&
+                               r.indexOf("Expected ';'") > 0`, true)
+	checkModuleError(tst, ctx.Clone(), "import { x } from 'lib'; x;",
+		"Expected ';' after variable declaration")
+}
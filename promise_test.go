@@ -0,0 +1,213 @@
+/*
+ * Test methods for promises, async functions and the host job queue.
+ *
+ * Copyright (C) 2005-2026 J.M. Heisz.  All Rights Reserved.
+ * See the LICENSE file accompanying the distribution your rights to use
+ * this software.
+ */
+
+package gescript
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/heisz/gescript/types"
+)
+
+// Helper to run a script and await the (possibly promise) result
+func checkAsync(tst *testing.T, ctx *ScriptContext, src string,
+	expected interface{}) {
+	prg, err := Parse(src)
+	if err != nil {
+		tst.Fatalf("Unexpected error parsing '%s': %v", src, err)
+	}
+	res, err := prg.RunWithContext(ctx)
+	if err != nil {
+		tst.Fatalf("Unexpected error running '%s': %v", src, err)
+	}
+	res, err = ctx.Await(context.Background(), res)
+	if err != nil {
+		tst.Fatalf("Unexpected error awaiting '%s': %v", src, err)
+	}
+	if actual := res.Native(); actual != expected {
+		tst.Fatalf("Script '%s': expected %v (%T), got %v (%T)",
+			src, expected, expected, actual, actual)
+	}
+}
+
+func TestPromiseChains(tst *testing.T) {
+	ctx := NewScriptContext()
+	checkAsync(tst, ctx, "Promise.resolve(1).then(v => v + 1)", int64(2))
+	checkAsync(tst, ctx, `Promise.reject(2).then(v => 0)
+                              .catch(e => e * 3)`, int64(6))
+	checkAsync(tst, ctx, `new Promise((res, rej) => res(4))
+                              .then(v => Promise.resolve(v * 2))`, int64(8))
+	checkAsync(tst, ctx, `new Promise(() => { throw 5; })
+                              .catch(e => e)`, int64(5))
+	checkAsync(tst, ctx, `var seen = 0;
+                          Promise.reject(7).finally(() => { seen = 1; })
+                              .catch(e => e + seen)`, int64(8))
+	checkAsync(tst, ctx, `var r = Promise.withResolvers();
+                          r.resolve(9); r.promise`, int64(9))
+
+	// Reactions are only run when the jobs are drained
+	prg, _ := Parse("var log = []; Promise.resolve(1).then(v => log.push(v)); log")
+	res, err := prg.RunWithContext(ctx)
+	if err != nil {
+		tst.Fatalf("Unexpected error running then script: %v", err)
+	}
+	if len(res.(*types.ArrayType).Elements) != 0 {
+		tst.Fatalf("Promise reaction ran before job queue was drained")
+	}
+	if err = ctx.RunJobs(); err != nil {
+		tst.Fatalf("Unexpected error running jobs: %v", err)
+	}
+	if len(res.(*types.ArrayType).Elements) != 1 {
+		tst.Fatalf("Promise reaction did not run on job drain")
+	}
+}
+
+func TestPromiseCombinators(tst *testing.T) {
+	ctx := NewScriptContext()
+	checkAsync(tst, ctx, `Promise.all([1, Promise.resolve(2), 3])
+                              .then(v => v.join('-'))`, "1-2-3")
+	checkAsync(tst, ctx, `Promise.all([1, Promise.reject('bad')])
+                              .catch(e => e)`, "bad")
+	checkAsync(tst, ctx, `Promise.allSettled([1, Promise.reject(2)])
+                              .then(v => v[0].status + v[1].reason)`,
+		"fulfilled2")
+	checkAsync(tst, ctx, `Promise.any([Promise.reject(1), Promise.resolve(2)])`,
+		int64(2))
+	checkAsync(tst, ctx, `Promise.any([Promise.reject(1), Promise.reject(2)])
+                              .catch(e => e.name + e.errors.length)`,
+		"AggregateError2")
+	checkAsync(tst, ctx, `Promise.race([new Promise(() => 0),
+                                        Promise.resolve('first')])`, "first")
+}
+
+func TestAsyncFunctions(tst *testing.T) {
+	ctx := NewScriptContext()
+	checkAsync(tst, ctx, `async function add(a, b) {
+                              return await a + await b;
+                          }
+                          add(Promise.resolve(1), 2)`, int64(3))
+	checkAsync(tst, ctx, `var triple = async x => x * 3; triple(3)`, int64(9))
+	checkAsync(tst, ctx, `var log = [];
+                          async function f() {
+                              log.push(1); await null; log.push(3);
+                          }
+                          f(); log.push(2);
+                          Promise.resolve(0).then(() => log.join(''))`, "123")
+	checkAsync(tst, ctx, `async function f() {
+                              try {
+                                  await Promise.reject('oops');
+                              } catch (e) {
+                                  return 'caught ' + e;
+                              }
+                          }
+                          f()`, "caught oops")
+	checkAsync(tst, ctx, `async function f() { throw 4; }
+                          f().catch(e => e + 1)`, int64(5))
+	checkAsync(tst, ctx, `async function sum(list) {
+                              var total = 0;
+                              for await (const v of list) {
+                                  total = total + v;
+                              }
+                              return total;
+                          }
+                          sum([Promise.resolve(1), 2, Promise.resolve(3)])`,
+		int64(6))
+
+	// Async iterators are preferred, with the next results awaited
+	checkAsync(tst, ctx, `var src = {
+                              [Symbol.asyncIterator]() {
+                                  var i = 0;
+                                  return {next() {
+                                      i++;
+                                      return Promise.resolve({value: i * 10,
+                                                              done: i > 3});
+                                  }};
+                              },
+                              [Symbol.iterator]() { throw 'sync'; }
+                          };
+                          async function f() {
+                              var r = [];
+                              for await (const v of src) {
+                                  if (v == 20) continue;
+                                  r.push(v);
+                              }
+                              return r.join();
+                          }
+                          f()`, "10,30")
+	checkAsync(tst, ctx, `async function f() {
+                              var r = '';
+                              try {
+                                  for await (const v of {
+                                      [Symbol.asyncIterator]() {
+                                          return {next: () => Promise.reject(
+                                              'failed')};
+                                      }
+                                  }) r = v;
+                              } catch (e) { r = e; }
+                              return r;
+                          }
+                          f()`, "failed")
+
+	// Rejection is reported to the host as a distinct error type
+	prg, _ := Parse("async function f() { throw 'failed'; }; f()")
+	res, _ := prg.RunWithContext(ctx)
+	_, err := ctx.Await(context.Background(), res)
+	if rej, ok := err.(*RejectionError); !ok || rej.Reason.Native() != "failed" {
+		tst.Fatalf("Expected rejection error for async throw, got %v", err)
+	}
+}
+
+func TestHostPendingPromise(tst *testing.T) {
+	ctx := NewScriptContext()
+	ctx.RegisterFunction("fetch", func(prc types.Process,
+		args []types.DataType) (types.DataType, error) {
+		prm, hndl := types.NewPendingPromise(prc)
+		key := types.ToString(args[0])
+		go func() {
+			time.Sleep(5 * time.Millisecond)
+			if key == "bad" {
+				hndl.Reject(types.StringType("no " + key))
+			} else {
+				hndl.Resolve(types.StringType("data for " + key))
+			}
+		}()
+		return prm, nil
+	})
+
+	checkAsync(tst, ctx, `async function load(k) {
+                              return (await fetch(k)) + '!';
+                          }
+                          load('abc')`, "data for abc!")
+	checkAsync(tst, ctx, `fetch('bad').catch(e => e)`, "no bad")
+
+	// Waiting for the jobs also waits for outstanding handles
+	prg, _ := Parse("var out = []; fetch('x').then(v => out.push(v)); out")
+	res, _ := prg.RunWithContext(ctx)
+	if err := ctx.WaitJobs(context.Background()); err != nil {
+		tst.Fatalf("Unexpected error waiting for jobs: %v", err)
+	}
+	if len(res.(*types.ArrayType).Elements) != 1 {
+		tst.Fatalf("Pending host promise not settled by WaitJobs")
+	}
+}
+
+// Not strictly promises, but exceptions now unwind through callbacks/calls
+func TestCallbackExceptions(tst *testing.T) {
+	ctx := NewScriptContext()
+	checkAsync(tst, ctx, "[1, 2, 3].map(x => x * 2).join()", "2,4,6")
+	checkAsync(tst, ctx, `function f() { throw 1; }
+                          var r = 0;
+                          try { f(); } catch (e) { r = e + 1; }
+                          r`, int64(2))
+	checkAsync(tst, ctx, `function g() { [1].map(x => { throw 7; }); }
+                          var r = 0;
+                          try { g(); } catch (e) { r = e; }
+                          r`, int64(7))
+}
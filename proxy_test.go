@@ -0,0 +1,242 @@
+/*
+ * Test methods for the Proxy and Reflect metaprogramming support.
+ *
+ * Copyright (C) 2005-2026 J.M. Heisz.  All Rights Reserved.
+ * See the LICENSE file accompanying the distribution your rights to use
+ * this software.
+ */
+
+package gescript
+
+import (
+	"testing"
+
+	"github.com/heisz/gescript/types"
+)
+
+func TestProxy(tst *testing.T) {
+	ctx := NewScriptContext()
+
+	// Property access traps (lazy defaults, validation, hiding, logging)
+	checkScript(tst, ctx, `var log = [];
+                           var p = new Proxy({a: 1}, {
+                               get(t, k, r) {
+                                   log.push(k);
+                                   return k in t ? t[k] : 'def';
+                               }
+                           });
+                           [p.a, p.b, p['c'], log.join()].join()`,
+		"1,def,def,a,b,c")
+	checkScript(tst, ctx, `var p = new Proxy({}, {
+                               set(t, k, v) {
+                                   if (typeof v != 'number') throw 'num';
+                                   t[k] = v * 2;
+                                   return true;
+                               }
+                           });
+                           var r; p.x = 2;
+                           try { p['y'] = 's'; } catch (e) { r = e; }
+                           [p.x, typeof p.y, r].join()`, "4,undefined,num")
+	checkScript(tst, ctx, `var p = new Proxy({a: 1}, {
+                               has(t, k) { return k.startsWith('x'); }
+                           });
+                           ['xy' in p, 'a' in p].join()`, "true,false")
+	checkScript(tst, ctx, `var o = {a: 1, b: 2};
+                           var p = new Proxy(o, {
+                               deleteProperty(t, k) {
+                                   if (k == 'a') return false;
+                                   return delete t[k];
+                               }
+                           });
+                           [delete p.a, delete p['b'], JSON.stringify(o)]
+                               .join()`, `false,true,{"a":1}`)
+	checkScript(tst, ctx, `'use strict';
+                           var p = new Proxy({}, {set() { return false; }});
+                           var r = '';
+                           try { p.a = 1; } catch (e) { r = e.name; }
+                           r`, "TypeError")
+
+	// Forwarding to the target without traps
+	checkScript(tst, ctx, `var o = {v: 1, m() { return this.v; }};
+                           var p = new Proxy(o, {});
+                           p.x = 2; p.v++; p.v += 2;
+                           [p.m(), o.x, 'x' in p, {...p}.v].join()`,
+		"4,2,true,4")
+	checkScript(tst, ctx, `var p = new Proxy([1, 2, 3], {});
+                           [p.length, p[1], p.map(x => x * 2).join('|')]
+                               .join()`, "3,2,2|4|6")
+
+	// Enumeration through the ownKeys (and descriptor) traps
+	checkScript(tst, ctx, `var p = new Proxy({}, {
+                               ownKeys() { return ['x', 'y', 'z']; },
+                               getOwnPropertyDescriptor(t, k) {
+                                   if (k == 'z') return undefined;
+                                   return {value: k, enumerable: true,
+                                           configurable: true};
+                               },
+                               get(t, k) { return k.toUpperCase(); }
+                           });
+                           var r = [];
+                           for (var k in p) r.push(k + p[k]);
+                           [r.join(''), Object.keys(p).join(''),
+                            JSON.stringify(p)].join()`,
+		`xXyY,xy,{"x":"X","y":"Y"}`)
+
+	// Function and constructor traps
+	checkScript(tst, ctx, `var p = new Proxy(function(a, b) { return a + b; }, {
+                               apply(t, th, args) { return t(...args) * 10; }
+                           });
+                           [p(1, 2), typeof p, p.call(null, 2, 3)].join()`,
+		"30,function,5")
+	checkScript(tst, ctx, `function C(x) { this.x = x; }
+                           var P = new Proxy(C, {
+                               construct(t, args) { return new t(args[0] * 2); }
+                           });
+                           var c = new P(4), q = new Proxy(c, {});
+                           [c.x, c instanceof C, c instanceof P,
+                            q instanceof C].join()`, "8,true,true,true")
+	checkScript(tst, ctx, catchName("new Proxy({}, {})()"), "TypeError")
+	checkScript(tst, ctx, catchName("Proxy({}, {})"), "TypeError")
+	checkScript(tst, ctx, `var r = [];
+                           new Promise(() => {
+                               try { Proxy({}, {}); }
+                               catch (e) { r.push(e.name); }
+                           });
+                           r.join()`, "TypeError")
+
+	// Trap errors (and with scopes) through the natives and spread
+	for _, src := range []string{
+		"Object.keys(p)", "Object.entries(p)", "JSON.stringify(p)",
+		"({...p})", "Object.hasOwn(p, 'a')", "with (p) a",
+		"Object.assign({}, p)",
+	} {
+		checkScript(tst, ctx, `var p = new Proxy({a: 1}, {
+                                   ownKeys() { throw 'trap'; },
+                                   has() { throw 'trap'; }
+                               }), r;
+                               try { `+src+`; } catch (e) { r = e; } r`,
+			"trap")
+	}
+	checkScript(tst, ctx, `var o = {a: 1}, p = new Proxy(o, {
+                               has(t, k) { return k == 'a'; }
+                           }), r;
+                           with (p) { r = a; a = 2; } [r, o.a].join()`, "1,2")
+
+	// Object.assign reads and writes through the traps
+	checkScript(tst, ctx, `var log = [], src = new Proxy({a: 1, b: 2}, {
+                               ownKeys() { return ['b']; },
+                               get(t, k) { log.push('get ' + k); return 5; }
+                           });
+                           var dst = new Proxy({}, {
+                               set(t, k, v) {
+                                   log.push('set ' + k); t[k] = v * 2;
+                                   return true;
+                               }
+                           });
+                           var o = Object.assign({}, src);
+                           Object.assign(dst, {c: 3});
+                           [o.a, o.b, dst.c, log.join(' ')].join()`,
+		",5,6,get b set c")
+	checkScript(tst, ctx, catchName(`Object.assign(new Proxy({}, {
+                                         set() { return false; }
+                                     }), {a: 1})`), "TypeError")
+
+	// Revocation, and errors in the definition
+	checkScript(tst, ctx, `var r = Proxy.revocable({a: 1}, {});
+                           var v = r.proxy.a, e; r.revoke();
+                           try { r.proxy.a; } catch (x) { e = x.message; }
+                           [v, e].join()`,
+		"1,Cannot perform 'get' on a proxy that has been revoked")
+	checkScript(tst, ctx, catchName("new Proxy(1, {})"), "TypeError")
+	checkScript(tst, ctx, catchName("new Proxy({}, {get: 5}).a"),
+		"TypeError")
+	checkScript(tst, ctx, catchName(`var p = new Proxy({}, {
+                                         ownKeys() { return [1]; }
+                                     }); Reflect.ownKeys(p)`), "TypeError")
+
+	// Invariants of the frozen targets
+	checkScript(tst, ctx, catchName(`var p = new Proxy(Object.freeze({a: 1}), {
+                                         get() { return 2; }
+                                     }); p.a`), "TypeError")
+	checkScript(tst, ctx, catchName(`var p = new Proxy(Object.freeze({a: 1}), {
+                                         has() { return false; }
+                                     }); 'a' in p`), "TypeError")
+}
+
+func TestReflect(tst *testing.T) {
+	ctx := NewScriptContext()
+
+	checkScript(tst, ctx, `var o = {a: 1};
+                           [Reflect.get(o, 'a'), Reflect.set(o, 'b', 2), o.b,
+                            Reflect.has(o, 'b'),
+                            Reflect.deleteProperty(o, 'b'),
+                            Reflect.has(o, 'b'),
+                            Reflect.ownKeys(o).join()].join()`,
+		"1,true,2,true,true,false,a")
+	checkScript(tst, ctx, `[Reflect.apply((a, b) => a - b, null, [5, 2]),
+                            Reflect.construct(function(v) { this.v = v; },
+                                              [3]).v].join()`, "3,3")
+	checkScript(tst, ctx, `var f = Object.freeze({a: 1});
+                           [Reflect.set(f, 'a', 2), f.a,
+                            Reflect.deleteProperty(f, 'a'),
+                            Reflect.isExtensible(f), Reflect.isExtensible({}),
+                            JSON.stringify(
+                                Reflect.getOwnPropertyDescriptor(f, 'a')),
+                            typeof Reflect.getOwnPropertyDescriptor(f, 'b')]
+                               .join()`,
+		`false,1,false,false,true,{"configurable":false,"enumerable":true,`+
+			`"value":1,"writable":false},undefined`)
+	checkScript(tst, ctx, `var proto = {hi() { return 'hi'; }}, o = {};
+                           [Reflect.setPrototypeOf(o, proto), o.hi(),
+                            Reflect.getPrototypeOf(o) === proto,
+                            Reflect.getPrototypeOf({}) === null,
+                            Reflect.defineProperty(o, 'd', {value: 4}), o.d]
+                               .join()`, "true,hi,true,true,true,4")
+
+	// Reflect operations on a proxy go through the traps
+	checkScript(tst, ctx, `var p = new Proxy({}, {
+                               defineProperty(t, k, d) {
+                                   t[k] = d.value + '!'; return true;
+                               },
+                               set() { return false; }
+                           });
+                           [Reflect.defineProperty(p, 'a', {value: 'v'}), p.a,
+                            Reflect.set(p, 'b', 1)].join()`, "true,v!,false")
+	checkScript(tst, ctx, catchName("Reflect.get(1, 'a')"), "TypeError")
+	checkScript(tst, ctx, catchName("Reflect.apply({}, null, [])"),
+		"TypeError")
+}
+
+func TestHostProxy(tst *testing.T) {
+	ctx := NewScriptContext()
+
+	// Go native traps, validating the writes to a rule output
+	output := types.NewObject()
+	handler := types.NewObject()
+	handler.Set("set", &types.NativeFunction{Name: "set",
+		Fn: func(prc types.Process,
+			args []types.DataType) (types.DataType, error) {
+			if _, ok := args[2].(types.StringType); !ok {
+				return nil, types.ThrowError(prc, "TypeError",
+					"Output '"+types.ToString(args[1])+"' must be a string")
+			}
+			args[0].(*types.ObjectType).Set(types.ToString(args[1]), args[2])
+			return types.BooleanType(true), nil
+		}})
+	prx, err := NewProxy(output, handler)
+	if err != nil {
+		tst.Fatalf("Unexpected error creating proxy: %v", err)
+	}
+	ctx.SetGlobal("out", prx)
+	checkScript(tst, ctx, `out.grade = 'A';
+                           var r = ''; try { out.score = 1; }
+                           catch (e) { r = e.message; } r`,
+		"Output 'score' must be a string")
+	if output.Get("grade") != types.StringType("A") || output.Has("score") {
+		tst.Errorf("Incorrect proxy output state %v", output.Properties)
+	}
+
+	if _, err := NewProxy(types.IntegerType(1), handler); err == nil {
+		tst.Errorf("Expected error for non-object proxy target")
+	}
+}
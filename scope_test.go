@@ -0,0 +1,170 @@
+/*
+ * Test methods for declaration scoping, hoisting and the temporal dead zone.
+ *
+ * Copyright (C) 2005-2026 J.M. Heisz.  All Rights Reserved.
+ * See the LICENSE file accompanying the distribution your rights to use
+ * this software.
+ */
+
+package gescript
+
+import (
+	"testing"
+)
+
+func TestTemporalDeadZone(tst *testing.T) {
+	ctx := NewScriptContext()
+
+	// Access ahead of the declaration, including typeof and self-reference
+	checkScript(tst, ctx, catchName("x; let x = 1"), "ReferenceError")
+	checkScript(tst, ctx, catchName("typeof y; const y = 1"), "ReferenceError")
+	checkScript(tst, ctx, catchName("z = 2; let z"), "ReferenceError")
+	checkScript(tst, ctx, catchName("let w = w + 1"), "ReferenceError")
+	checkScript(tst, ctx, catchName("v++; let v = 1"), "ReferenceError")
+	checkScript(tst, ctx, `var r = ''; try { x; let x = 1; }
+                           catch (e) { r = e.message; } r`,
+		"Cannot access 'x' before initialization")
+
+	// Closures are checked when called, not when created
+	checkScript(tst, ctx, `var r; function g() { return q; }
+                           try { g(); } catch (e) { r = e.name; }
+                           let q = 1; r + ':' + g()`, "ReferenceError:1")
+	checkScript(tst, ctx, catchName("(() => { k = 2; })(); let k"),
+		"ReferenceError")
+	checkScript(tst, ctx, `var r; switch (1) {
+                               case 0: let y = 1; break;
+                               case 1: try { y; } catch (e) { r = e.name; }
+                           } r`, "ReferenceError")
+
+	// Each entry to a block has fresh (uninitialized) bindings
+	checkScript(tst, ctx, `var r = [];
+                           for (var i = 0; i < 2; i++) {
+                               let x; r.push(typeof x); x = 1;
+                           } r.join()`, "undefined,undefined")
+	checkScript(tst, ctx, `var fs = [];
+                           for (var i = 0; i < 3; i++) {
+                               let j = i; fs.push(() => j);
+                           } fs.map(f => f()).join()`, "0,1,2")
+	checkScript(tst, ctx, "let c = 0; const f = () => c; c++; f()", int64(1))
+}
+
+func TestDeclarationHoisting(tst *testing.T) {
+	ctx := NewScriptContext()
+
+	// Functions are initialized (and vars declared) at the start of the scope
+	checkScript(tst, ctx, "hoisted(); function hoisted() { return 1; }",
+		int64(1))
+	checkScript(tst, ctx, "function g() { return x; } var x = 1; g()",
+		int64(1))
+	checkScript(tst, ctx, `function h() {
+                               var r = [typeof x, g()];
+                               var x = 2;
+                               function g() { return 'g'; }
+                               return r.join();
+                           } h()`, "undefined,g")
+	checkScript(tst, ctx, `function o() {
+                               function fact(n) {
+                                   return n ? n * fact(n - 1) : 1;
+                               }
+                               return fact(4);
+                           } o() + ':' + typeof fact`, "24:undefined")
+	checkScript(tst, ctx, "for (var i = 0; i < 3; i++); i", int64(3))
+
+	// Block functions are hoisted in the block, and (sloppy) function vars
+	checkScript(tst, ctx, `var r; { r = typeof bf; function bf() {} } r`,
+		"function")
+	checkScript(tst, ctx, `var r = typeof bv;
+                           { function bv() { return 3; } }
+                           r + ':' + bv()`, "undefined:3")
+	checkScript(tst, ctx, `'use strict'; var r;
+                           { function sf() {} } r = typeof sf; r`,
+		"undefined")
+	checkScript(tst, ctx, `let lf = 1; { function lf() {} } typeof lf`,
+		"number")
+	checkScript(tst, ctx, "try {} catch (e) { var e = 1; } 'ok'", "ok")
+}
+
+func TestRedeclarationErrors(tst *testing.T) {
+	for _, src := range []string{
+		"let a; var a",
+		"var a; let a",
+		"let a; let a",
+		"const a = 1; let a",
+		"let a; { var a; }",
+		"{ var a; let a; }",
+		"{ let a; var a; }",
+		"function a() {} let a",
+		"{ function a() {} let a; }",
+		"'use strict'; { function a() {} function a() {} }",
+		"const k = 1; k = 2",
+		"try {} catch (e) { let e; }",
+		"try {} catch (e) { const e = 1; }",
+		"try {} catch (e) { function e() {} }",
+	} {
+		if _, err := Parse(src); err == nil {
+			tst.Errorf("Expected redeclaration error for '%s'", src)
+		}
+	}
+
+	// Allowed redeclarations
+	for _, src := range []string{
+		"var a; var a",
+		"function a() {} var a",
+		"{ function a() {} function a() {} }",
+		"let a; { let a; }",
+		"try {} catch (e) { { let e; } }",
+		"try {} catch { let e; }",
+	} {
+		if _, err := Parse(src); err != nil {
+			tst.Errorf("Unexpected error for '%s': %v", src, err)
+		}
+	}
+}
+
+func TestPerIterationBindings(tst *testing.T) {
+	ctx := NewScriptContext()
+
+	// Each iteration of the loop captures its own let binding (not var)
+	checkScript(tst, ctx, `var fs = [];
+                           for (let i = 0; i < 3; i++) fs.push(() => i);
+                           fs.map(f => f()).join()`, "0,1,2")
+	checkScript(tst, ctx, `var fs = [];
+                           for (var i = 0; i < 3; i++) fs.push(() => i);
+                           fs.map(f => f()).join()`, "3,3,3")
+	checkScript(tst, ctx, `var fs = [];
+                           for (let i = 0, j = 10; i < 2; i++, j--) {
+                               fs.push(() => i + ':' + j);
+                           } fs.map(f => f()).join()`, "0:10,1:9")
+	checkScript(tst, ctx, `function m() {
+                               var fs = [];
+                               for (let i = 0; i < 4; i++) {
+                                   if (i == 1) continue;
+                                   fs.push(() => i);
+                               }
+                               return fs;
+                           } m().map(f => f()).join()`, "0,2,3")
+
+	// Values are copied forward, changes in the body carry to the update
+	checkScript(tst, ctx, `var fs = [];
+                           for (let i = 0; i < 3; i++) {
+                               fs.push(() => i); i++;
+                           } fs.map(f => f()).join()`, "1,3")
+	checkScript(tst, ctx, `var fs = [];
+                           for (let i = 0, f = () => i; i < 3; i++) {
+                               fs.push(f);
+                           } fs.map(f => f()).join()`, "0,0,0")
+
+	// Similarly for the in/of forms
+	checkScript(tst, ctx, `var fs = [];
+                           for (let k in {a: 1, b: 2}) fs.push(() => k);
+                           fs.map(f => f()).sort().join()`, "a,b")
+	checkScript(tst, ctx, `var fs = [];
+                           for (const v of [1, 2, 3]) fs.push(() => v);
+                           fs.map(f => f()).join()`, "1,2,3")
+	checkScript(tst, ctx, `var fs = [];
+                           for (const c of 'ab') fs.push(() => c);
+                           fs.map(f => f()).join()`, "a,b")
+	checkScript(tst, ctx, `var fs = [];
+                           for (var v of [1, 2, 3]) fs.push(() => v);
+                           fs.map(f => f()).join()`, "3,3,3")
+}
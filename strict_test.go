@@ -0,0 +1,137 @@
+/*
+ * Test methods for strict mode and the non-strict (sloppy) differences.
+ *
+ * Copyright (C) 2005-2026 J.M. Heisz.  All Rights Reserved.
+ * See the LICENSE file accompanying the distribution your rights to use
+ * this software.
+ */
+
+package gescript
+
+import (
+	"testing"
+
+	"github.com/heisz/gescript/types"
+)
+
+// Wrap the script to return the name of the error thrown (if any)
+func catchName(src string) string {
+	return "var r = ''; try { " + src + "; } catch (e) { r = e.name; } r"
+}
+
+func TestSloppyMode(tst *testing.T) {
+	ctx := NewScriptContext()
+
+	// Undeclared assignments create globals, this is the global object
+	checkScript(tst, ctx, "implicit = 1; implicit += 2; implicit", int64(3))
+	checkScript(tst, ctx, "function f() { other = 2; } f(); other", int64(2))
+	checkScript(tst, ctx, `function g() { return this; }
+                           var o = { m() { return this; } }, m = o.m;
+                           [this === globalThis, g() === globalThis,
+                            m() === globalThis, o.m() === o].join()`,
+		"true,true,true,true")
+
+	// Writes to frozen/read-only values are (silently) ignored
+	checkScript(tst, ctx, `var o = Object.freeze({a: 1});
+                           o.a = 2; o['b'] = 3; delete o.a;
+                           [o.a, typeof o.b, Object.isFrozen(o),
+                            NaN = 1].join()`,
+		"1,undefined,true,1")
+	checkScript(tst, ctx, "typeof NaN", "number")
+	checkScript(tst, ctx, `var a = Object.freeze([1, 2]); a[0] = 5;
+                           a.join() + Object.isFrozen(a)`, "1,2true")
+	checkScript(tst, ctx, catchName("Object.freeze([1]).push(2)"), "TypeError")
+
+	// Duplicate parameters are allowed, last one wins
+	checkScript(tst, ctx, "function d(a, b, a) { return a + b; } d(1, 2, 3)",
+		int64(5))
+}
+
+func TestStrictMode(tst *testing.T) {
+	ctx := NewScriptContext()
+	ctx.SetGlobal("existing", types.IntegerType(1))
+
+	// Script level directive, undeclared assignments fail
+	checkScript(tst, ctx, "'use strict'; "+catchName("undeclared = 1"),
+		"ReferenceError")
+	checkScript(tst, ctx, "'use strict'; existing = 2; existing", int64(2))
+	checkScript(tst, ctx, "'use strict'; this === undefined", true)
+	checkScript(tst, ctx, "'other'; 'use strict'; "+catchName("nope = 1"),
+		"ReferenceError")
+	checkScript(tst, ctx, "var x; 'use strict'; late = 1; late", int64(1))
+
+	// Function level directive, inherited by the nested functions
+	checkScript(tst, ctx, `function s() { 'use strict'; inner = 1; }
+                           `+catchName("s()"), "ReferenceError")
+	checkScript(tst, ctx, `function t() {
+                               'use strict';
+                               return (function() { return this; })();
+                           }
+                           [t() === undefined, typeof inner].join()`,
+		"true,undefined")
+
+	// Read-only writes are errors
+	checkScript(tst, ctx, `'use strict';
+                           var o = Object.freeze({a: 1}), r = [];
+                           try { o.a = 2; } catch (e) { r.push(e.name); }
+                           try { o.b = 2; } catch (e) { r.push(e.name); }
+                           try { o['a'] = 2; } catch (e) { r.push(e.name); }
+                           try { delete o.a; } catch (e) { r.push(e.name); }
+                           try { NaN = 1; } catch (e) { r.push(e.name); }
+                           try { Object.freeze([1])[0] = 2; }
+                           catch (e) { r.push(e.name); }
+                           r.join() + ':' + o.a`,
+		"TypeError,TypeError,TypeError,TypeError,TypeError,TypeError:1")
+
+	// Duplicate parameters, with and delete of identifiers, legacy octal
+	// literals and escapes, eval/arguments bindings and assignment or update
+	// targets are syntax errors
+	for _, src := range []string{
+		"'use strict'; function f(a, a) {}",
+		"function f(a, a) { 'use strict'; }",
+		"var f = (a, a) => a",
+		"'use strict'; var x; delete x;",
+		"function f() { 'use strict'; var x; return delete x; }",
+		"'use strict'; with (Math) {}",
+		"'use strict'; var n = 010;",
+		"function f() { 'use strict'; return 08; }",
+		"'use strict'; var eval;",
+		"'use strict'; let arguments = 1;",
+		"function f(eval) { 'use strict'; }",
+		"function arguments() { 'use strict'; }",
+		"'use strict'; try {} catch (eval) {}",
+		"'use strict'; '\\01'",
+		"'use strict'; var s = 'a\\7';",
+		"'use strict'; var o = {'\\101': 1};",
+		"function f() { '\\01'; 'use strict'; }",
+		"'use strict'; eval = 1;",
+		"'use strict'; eval += 1;",
+		"function f() { 'use strict'; arguments++; }",
+		"function f() { 'use strict'; --arguments; }",
+		"function f() { 'use strict'; for (arguments in {}); }",
+		"'use strict'; [eval] = [];",
+	} {
+		if _, err := Parse(src); err == nil {
+			tst.Errorf("Expected strict mode error for '%s'", src)
+		}
+	}
+	checkScript(tst, ctx, "var eval = 010; eval + 08", int64(16))
+	checkScript(tst, ctx, `'use strict'; '\0' + '\x41'`, "\x00A")
+	checkScript(tst, ctx, `function f() { arguments = 1; return arguments; }
+                           f() + ('\101' + '\0' + '\08').length`,
+		int64(5))
+
+	// Strict by default through the parse options, and for modules
+	opts := types.ParseOptions{Strict: true}
+	if _, err := ParseWithOptions("function f(a, a) {}", opts); err == nil {
+		tst.Errorf("Expected error for duplicate parameter in strict mode")
+	}
+	prg, err := ParseWithOptions(catchName("undeclared = 1"), opts)
+	if err != nil {
+		tst.Fatalf("Unexpected error parsing strict script: %v", err)
+	}
+	if res, err := prg.Run(); err != nil || res.Native() != "ReferenceError" {
+		tst.Errorf("Expected strict reference error, got %v (%v)", res, err)
+	}
+	checkModule(tst, ctx, catchName("undeclared = 1"), "ReferenceError")
+}
@@ -0,0 +1,128 @@
+/*
+ * Test methods for the Unicode (UTF-16) semantics of strings.
+ *
+ * Copyright (C) 2005-2026 J.M. Heisz.  All Rights Reserved.
+ * See the LICENSE file accompanying the distribution your rights to use
+ * this software.
+ */
+
+package gescript
+
+import (
+	"testing"
+
+	"github.com/heisz/gescript/types"
+)
+
+func TestStringUnicode(tst *testing.T) {
+	ctx := NewScriptContext()
+
+	// Indexing and lengths are by UTF-16 code unit
+	checkScript(tst, ctx, `var s = 'Zoë Müller';
+                           [s.length, s[2], s.charAt(8), s.charCodeAt(2),
+                            s.slice(2, 5), s.substring(6, 4), s.substr(-6, 3),
+                            s.indexOf('M'), s.lastIndexOf('l'), s.at(-1),
+                            s.padEnd(12, 'ä'), s.startsWith('Mü', 4),
+                            s.endsWith('ë', 3), s.includes('ë M')].join('|')`,
+		"10|ë|e|235|ë M|Mü|Mül|4|7|r|Zoë Müllerää|true|true|true")
+	checkScript(tst, ctx, `var e = 'a😀b';
+                           [e.length, e.charCodeAt(1), e.charCodeAt(2),
+                            e.codePointAt(1), e.codePointAt(2), e.indexOf('b'),
+                            e.split('').length, e.at(3), e.codePointAt(9)]
+                               .join(',')`,
+		"4,55357,56832,128512,56832,3,4,b,")
+
+	// Lone surrogates are retained and recombine
+	checkScript(tst, ctx, `var e = '😀', hi = e[0], lo = e.slice(1);
+                           [hi + lo === e, (hi + lo).length, e.isWellFormed(),
+                            hi.isWellFormed(), lo.length,
+                            '😀' === e, '\u{1F600}' === e,
+                            'x\uDE00'.toWellFormed() === 'x�'].join(',')`,
+		"true,2,true,false,1,true,true,true")
+	checkScript(tst, ctx, `String.fromCharCode(0xD83D, 0xDE00, 0x10041) +
+                               String.fromCodePoint(0x1F600, 0x41)`,
+		"😀A😀A")
+
+	// Iteration and spread are by code point
+	checkScript(tst, ctx, `var cps = [];
+                           for (var c of 'é😀x') cps.push(c);
+                           cps.join('|') + ':' + [...'a😀'].length`,
+		"é|😀|x:2")
+
+	// Normalization forms
+	checkScript(tst, ctx, `var a = '\u00C5', d = 'A\u030A';
+                           [a === d, a.normalize('NFD') === d,
+                            d.normalize() === a, d.normalize('NFC').length,
+                            '\uFB01'.normalize('NFKC'),
+                            '\uFB01'.normalize() === '\uFB01',
+                            '\uAC01'.normalize('NFD').length,
+                            '\u1100\u1161\u11A8'.normalize() === '\uAC01',
+                            'a\u0323\u0307'.normalize() ===
+                                'a\u0307\u0323'.normalize(),
+                            '\u2460'.normalize('NFKD')].join(',')`,
+		"false,true,true,1,fi,true,3,true,true,1")
+
+	// String.raw, with the raw strings from the template object
+	checkScript(tst, ctx, `String.raw({raw: ['x', 'y', 'z']}, 1, 2, 3) + ':' +
+                               String.raw({raw: 'abc'}, '-')`,
+		"x1y2z:a-bc")
+
+	// Long strings (cached code units) and interleaved access to several
+	checkScript(tst, ctx, `var a = 'ab'.repeat(5000), u = 'é😀'.repeat(100),
+                               sum = 0;
+                           for (var i = 0; i < a.length; i++) {
+                               sum += a.charCodeAt(i) + u.charCodeAt(i % 300);
+                           }
+                           [sum, u.length, u[299].charCodeAt(0), u.charAt(301),
+                            a.length, a[9999], a.charCodeAt(10000)].join()`,
+		"375677759,300,56832,,10000,b,NaN")
+
+	for src, expected := range map[string]string{
+		"'x'.normalize('NFX')":       "RangeError",
+		"String.fromCodePoint(-1)":   "RangeError",
+		"String.fromCodePoint(1.5)":  "RangeError",
+		"String.fromCodePoint(1e10)": "RangeError",
+		"String.raw({})":             "TypeError",
+	} {
+		checkScript(tst, ctx, `var r = '';
+                               try { `+src+`; } catch (e) { r = e.name; }
+                               r`, expected)
+	}
+}
+
+func TestStringHost(tst *testing.T) {
+	ctx := NewScriptContext()
+
+	// Host strings are UTF-8, but measured/indexed in UTF-16 code units
+	ctx.SetGlobal("name", types.NewFromInterface("José 🎉"))
+	checkScript(tst, ctx, "name.length + ':' + name.slice(0, 4)", "7:José")
+
+	// And lone surrogates are made well-formed for the host
+	script, err := Parse("'ok\\uD800'.toWellFormed()")
+	if err != nil {
+		tst.Fatalf("Unexpected parse error: %v", err)
+	}
+	res, err := script.RunWithContext(ctx)
+	if err != nil {
+		tst.Fatalf("Unexpected run error: %v", err)
+	}
+	if res.Native() != "ok�" {
+		tst.Fatalf("Incorrect well-formed string: %q", res.Native())
+	}
+}
+
+func BenchmarkStringIndex(bnch *testing.B) {
+	ctx := NewScriptContext()
+	script, err := Parse(`var s = 'x'.repeat(40000), sum = 0;
+                          for (var i = 0; i < s.length; i++) {
+                              sum += s.charCodeAt(i) + s[i].length;
+                          } sum`)
+	if err != nil {
+		bnch.Fatalf("Unexpected parse error: %v", err)
+	}
+	for idx := 0; idx < bnch.N; idx++ {
+		if _, err := script.RunWithContext(ctx); err != nil {
+			bnch.Fatalf("Unexpected run error: %v", err)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+/*
+ * Test methods for symbols and symbol-keyed properties.
+ *
+ * Copyright (C) 2005-2026 J.M. Heisz.  All Rights Reserved.
+ * See the LICENSE file accompanying the distribution your rights to use
+ * this software.
+ */
+
+package gescript
+
+import (
+	"testing"
+)
+
+// Helper to run a script in the context and verify the (native) result
+func checkScript(tst *testing.T, ctx *ScriptContext, src string,
+	expected interface{}) {
+	prg, err := Parse(src)
+	if err != nil {
+		tst.Fatalf("Unexpected error parsing '%s': %v", src, err)
+	}
+	res, err := prg.RunWithContext(ctx)
+	if err != nil {
+		tst.Fatalf("Unexpected error running '%s': %v", src, err)
+	}
+	if actual := res.Native(); actual != expected {
+		tst.Fatalf("Script '%s': expected %v (%T), got %v (%T)",
+			src, expected, expected, actual, actual)
+	}
+}
+
+func TestSymbolBasics(tst *testing.T) {
+	ctx := NewScriptContext()
+	checkScript(tst, ctx, "typeof Symbol('a')", "symbol")
+	checkScript(tst, ctx, "Symbol('a').toString()", "Symbol(a)")
+	checkScript(tst, ctx, "String(Symbol('b'))", "Symbol(b)")
+	checkScript(tst, ctx, "Symbol('a').description", "a")
+	checkScript(tst, ctx, "Symbol().description", nil)
+	checkScript(tst, ctx, "Symbol('x') === Symbol('x')", false)
+	checkScript(tst, ctx, "var s = Symbol('x'); s === s && s == s", true)
+	checkScript(tst, ctx, "Symbol.for('k') === Symbol.for('k')", true)
+	checkScript(tst, ctx, "Symbol.keyFor(Symbol.for('k'))", "k")
+	checkScript(tst, ctx, "Symbol.keyFor(Symbol('k'))", nil)
+	checkScript(tst, ctx, "Symbol.iterator.description", "Symbol.iterator")
+
+	// Implicit conversions are not allowed
+	checkScript(tst, ctx, `var r = '';
+                           try { Symbol() + 1; } catch (e) { r = e.name; }
+                           r`, "TypeError")
+	checkScript(tst, ctx, `var r = '';
+                           try { 'a' + Symbol(); } catch (e) { r = e.message; }
+                           r`, "Cannot convert a Symbol value to a string")
+	checkScript(tst, ctx, `var r = '';
+                           try { -Symbol(); } catch (e) { r = e.name; }
+                           r`, "TypeError")
+	checkScript(tst, ctx, `var r = '';
+                           try { Symbol.keyFor('k'); } catch (e) { r = e.name; }
+                           r`, "TypeError")
+	checkScript(tst, ctx, catchName("new Symbol()"), "TypeError")
+	checkScript(tst, ctx, catchName("Reflect.construct(Symbol, [])"),
+		"TypeError")
+}
+
+func TestSymbolProperties(tst *testing.T) {
+	ctx := NewScriptContext()
+	checkScript(tst, ctx, `var s = Symbol('p'), o = {a: 1};
+                           o[s] = 2;
+                           Object.keys(o).length + o[s]`, int64(3))
+	checkScript(tst, ctx, `var s = Symbol('p'), o = {}, keys = '';
+                           o[s] = 1; o.b = 2;
+                           for (var k in o) keys = keys + k;
+                           keys + (s in o) + ('p' in o)`, "btruefalse")
+	checkScript(tst, ctx, `var s = Symbol(), o = {};
+                           o[s] = 1; delete o[s];
+                           s in o`, false)
+	checkScript(tst, ctx, `var s = Symbol(), o = {};
+                           o[s] = 1;
+                           Object.getOwnPropertySymbols(o)[0] === s`, true)
+	checkScript(tst, ctx, `var s = Symbol(), o = {};
+                           o[s] = 'v';
+                           Object.assign({}, o)[s]`, "v")
+	checkScript(tst, ctx, `var o = {a: 1};
+                           o[Symbol('h')] = 2;
+                           JSON.stringify(o)`, `{"a":1}`)
+	checkScript(tst, ctx, `var s = Symbol('c'), o = {[s]: 1, a: 2}, c = {...o};
+                           c[s] + c.a + Object.keys(c).length`, int64(4))
+}
+
+func TestWellKnownSymbols(tst *testing.T) {
+	ctx := NewScriptContext()
+	checkScript(tst, ctx, `var o = {};
+                           o[Symbol.toPrimitive] = h => h == 'number' ? 4 : h;
+                           (o * 2) + ':' + (o + '')`, "8:default")
+	checkScript(tst, ctx, `var o = {[Symbol.toPrimitive](h) { return h[0]; },
+                                    toString() { return 'no'; }};
+                           [String(o), Number({[Symbol.toPrimitive]: h => 7}),
+                            o == 'd', o != 'd', [o, null, o].join(),
+                            String([o, 1]), {valueOf: () => 1} == true]
+                               .join(' ')`, "s 7 true false s,,s s,1 true")
+
+	// Errors in the conversion propagate from each of the above
+	for _, src := range []string{"String(o)", "Number(o)", "o == 'x'",
+		"o != 1", "[o].join()", "[1].join(o)", "String([o])", "'' + [o]"} {
+		checkScript(tst, ctx, `var o = {[Symbol.toPrimitive]() {
+                                   throw {name: 'Hook'}; }};
+                               `+catchName(src), "Hook")
+	}
+	checkScript(tst, ctx, catchName("[Symbol()].join()"), "TypeError")
+	checkScript(tst, ctx, "String(Symbol('e')) + Number(2n)", "Symbol(e)2")
+	checkScript(tst, ctx, `var o = {};
+                           o[Symbol.toStringTag] = 'Thing';
+                           o.toString()`, "[object Thing]")
+	checkScript(tst, ctx, `var it = [1, 2][Symbol.iterator]();
+                           it.next().value + it.next().value`, int64(3))
+	checkScript(tst, ctx, `var o = {}, t = 0;
+                           o[Symbol.iterator] = function() {
+                               var i = 0;
+                               return {
+                                   next: function() {
+                                       i = i + 1;
+                                       return { value: i, done: i > 3 };
+                                   }
+                               };
+                           };
+                           for (const v of o) t = t + v;
+                           t`, int64(6))
+	checkScript(tst, ctx, `var t = '';
+                           for (const c of 'ab'[Symbol.iterator]()) t = c + t;
+                           t`, "ba")
+}
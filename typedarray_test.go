@@ -0,0 +1,170 @@
+/*
+ * Test methods for the binary data types (ArrayBuffer, DataView and the
+ * typed arrays).
+ *
+ * Copyright (C) 2005-2026 J.M. Heisz.  All Rights Reserved.
+ * See the LICENSE file accompanying the distribution your rights to use
+ * this software.
+ */
+
+package gescript
+
+import (
+	"testing"
+
+	"github.com/heisz/gescript/types"
+)
+
+func TestTypedArrayScript(tst *testing.T) {
+	ctx := NewScriptContext()
+
+	// Construction, element conversion (wrapping, clamping) and properties
+	checkScript(tst, ctx, `var a = new Uint8Array(4);
+                           a[0] = 257; a[1] = -1; a[2] = 3.7; a['3'] = '9';
+                           a[4] = 1; a[1.5] = 2;
+                           a.join(',') + ':' + a.length + ':' + a.byteLength`,
+		"1,255,3,9:4:4")
+	checkScript(tst, ctx, `new Uint8ClampedArray([300, -5, 1.5, 2.5]).join()`,
+		"255,0,2,2")
+	checkScript(tst, ctx, `[new Int8Array([200])[0], new Int16Array([-1])[0],
+                            new Uint32Array([-1])[0], new Float32Array([0.1])[0],
+                            Int32Array.BYTES_PER_ELEMENT, a[9] === undefined,
+                            new Float64Array(2).BYTES_PER_ELEMENT].join(',')`,
+		"-56,-1,4294967295,0.10000000149011612,4,true,8")
+	checkScript(tst, ctx, `'' + Int16Array.from([1, 2], function(v) {
+                               return v * 10; }) + ':' + Uint8Array.of(4, 5)`,
+		"10,20:4,5")
+	checkScript(tst, ctx, "'' + new BigInt64Array([-1n, 2n])", "-1,2")
+
+	// Views over a shared buffer (DataView is big-endian by default)
+	views := `var buf = new ArrayBuffer(8);
+              var dv = new DataView(buf);
+              dv.setUint16(0, 0x1234);
+              dv.setUint16(2, 0x1234, true);
+              dv.setFloat32(4, 1.5);
+              var b = new Uint8Array(buf);
+`
+	checkScript(tst, ctx, views+`b.join(',') + ':' + dv.getUint16(2) + ':' +
+                                     dv.getInt8(0) + ':' + dv.getFloat32(4) +
+                                     ':' + new Uint16Array(buf, 2, 1)[0]
+                                         .toString(16)`,
+		"18,52,52,18,63,192,0,0:13330:18:1.5:1234")
+	checkScript(tst, ctx, views+`var sub = b.subarray(1, 3); sub[0] = 99;
+                                 var cp = b.slice(1, 3); cp[1] = 77;
+                                 b[1] + ':' + b[2] + ':' + sub.byteOffset +
+                                     ':' + (sub.buffer === buf) + ':' +
+                                     buf.slice(6).byteLength`,
+		"99:52:1:true:2")
+	checkScript(tst, ctx, views+`dv.setBigUint64(0, 18446744073709551615n);
+                                 dv.getBigInt64(0) + ':' +
+                                     ArrayBuffer.isView(dv) + ':' +
+                                     ArrayBuffer.isView(buf)`,
+		"-1:true:false")
+
+	// Array-like methods, iteration and spreading
+	ints := "var t = new Int32Array([5, -2, 10, 1]); t.set([7, 8], 2);\n"
+	checkScript(tst, ctx, ints+`[t.indexOf(8), t.includes(-2), t.at(-1),
+                            t.findLast(function(v) { return v > 0; }),
+                            t.map(function(v) { return v * 2; }).join(' '),
+                            t.filter(function(v) { return v > 0; }).length,
+                            t.reduce(function(a, v) { return a + v; }),
+                            t.slice().sort().join(' '),
+                            t.slice().sort(function(a, b) {
+                                return b - a; }).join(' '),
+                            t.slice().reverse().join(' '),
+                            new Int8Array([1, 2, 3, 4, 5]).copyWithin(0, 3)
+                                .join(' '),
+                            new Uint8Array(3).fill(4, 1).join(' ')].join(',')`,
+		"3,true,8,8,10 -4 14 16,3,18,-2 5 7 8,8 7 5 -2,8 7 -2 5,4 5 3 4 5,0 4 4")
+	checkScript(tst, ctx, ints+`function mul(a, b) { return a * b; }
+                           var s = ''; for (var v of new Uint8Array([1, 2]))
+                               s = s + v;
+                           for (var k in new Uint8Array(2)) s = s + k;
+                           s + ':' + [...new Uint8Array([3, 4])] + ':' +
+                               mul(...new Uint8Array([3, 4])) + ':' +
+                               (1 in t) + ':' + (4 in t)`,
+		"1201:3,4:12:true:false")
+	checkScript(tst, ctx, ints+`var e = '';
+                           for (var x of new Uint8Array([6, 7]).entries())
+                               e = e + x[0] + '=' + x[1] + ' ';
+                           e + Array.isArray(t) + ':' +
+                               (t instanceof Int32Array) + ':' +
+                               (t instanceof Uint8Array)`,
+		"0=6 1=7 false:true:false")
+
+	// Errors
+	for src, expected := range map[string]string{
+		"new Uint8Array(-1)":                                           "RangeError",
+		"new ArrayBuffer(-1)":                                          "RangeError",
+		"new Uint16Array(new ArrayBuffer(3))":                          "RangeError",
+		"new Uint16Array(new ArrayBuffer(4), 1)":                       "RangeError",
+		"new DataView([])":                                             "TypeError",
+		"new DataView(new ArrayBuffer(2)).getInt32(0)":                 "RangeError",
+		"new Uint8Array(2).set([1, 2, 3])":                             "RangeError",
+		"new BigInt64Array(1)[0] = 1":                                  "TypeError",
+		"new Int8Array(1)[0] = 1n":                                     "TypeError",
+		"new Int8Array(1).map(5)":                                      "TypeError",
+		"new Int8Array(2).sort(function() { throw {name: 'Abort'}; })": "Abort",
+	} {
+		checkScript(tst, ctx, `var r = '';
+                               try { `+src+`; } catch (e) { r = e.name; }
+                               r`, expected)
+	}
+}
+
+func TestTypedArrayHost(tst *testing.T) {
+	ctx := NewScriptContext()
+
+	// Byte slices are shared (zero-copy) with the script in both directions
+	payload := []byte{1, 2, 3, 4}
+	ctx.SetGlobal("payload", types.NewFromInterface(payload))
+	checkScript(tst, ctx, `payload[0] = 10;
+                           new DataView(payload.buffer).getUint32(0)`,
+		int64(0x0a020304))
+	if payload[0] != 10 {
+		tst.Fatalf("Script modification not shared: %v", payload)
+	}
+
+	ctx.RegisterGoFunc("checksum", func(data []byte) int {
+		sum := 0
+		for _, b := range data {
+			sum += int(b)
+		}
+		data[0] = 0
+		return sum
+	})
+	checkScript(tst, ctx, "checksum(payload.subarray(1)) + ':' + payload[1]",
+		"9:0")
+
+	script, err := Parse(`({raw: new Uint8Array([5, 6]),
+                            samples: new Float32Array([0.5, 1.5]),
+                            ints: new Int16Array([-1, 2])})`)
+	if err != nil {
+		tst.Fatalf("Unexpected parse error: %v", err)
+	}
+	res, err := script.RunWithContext(ctx)
+	if err != nil {
+		tst.Fatalf("Unexpected run error: %v", err)
+	}
+	native := res.Native().(map[string]interface{})
+	if raw, ok := native["raw"].([]byte); !ok || len(raw) != 2 ||
+		raw[1] != 6 {
+		tst.Fatalf("Incorrect native bytes: %#v", native["raw"])
+	}
+	if ints, ok := native["ints"].([]int16); !ok || ints[0] != -1 {
+		tst.Fatalf("Incorrect native int16s: %#v", native["ints"])
+	}
+
+	var out struct {
+		Raw     []byte    `json:"raw"`
+		Samples []float32 `json:"samples"`
+		Ints    []int     `json:"ints"`
+	}
+	if err := types.ExportTo(res, &out); err != nil {
+		tst.Fatalf("Unexpected export error: %v", err)
+	}
+	if len(out.Raw) != 2 || out.Raw[0] != 5 || len(out.Samples) != 2 ||
+		out.Samples[1] != 1.5 || out.Ints[0] != -1 || out.Ints[1] != 2 {
+		tst.Fatalf("Incorrect exported binary data: %+v", out)
+	}
+}
@@ -0,0 +1,317 @@
+/*
+ * BigInt datatype, arbitrary precision integers backed by math/big.
+ *
+ * Copyright (C) 2005-2026 J.M. Heisz.  All Rights Reserved.
+ * See the LICENSE file accompanying the distribution your rights to use
+ * this software.
+ */
+
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+)
+
+// Limit on the size (in bits) of BigInt values, to avoid runaway allocations
+// from shifts and exponentiation
+const maxBigIntBits = 1 << 24
+
+var errBigIntSize = errors.New("RangeError: Maximum BigInt size exceeded")
+
+// BigIntType is the (immutable) BigInt primitive, the zero value is 0n.  The
+// value is never modified once created, so it can be freely shared.
+type BigIntType struct {
+	val *big.Int
+}
+
+// Create a BigInt from a Go integer value
+func NewBigInt(val int64) BigIntType {
+	return BigIntType{val: big.NewInt(val)}
+}
+
+// Ditto for an unsigned value (e.g. 64-bit identifiers)
+func NewBigIntFromUint64(val uint64) BigIntType {
+	return BigIntType{val: new(big.Int).SetUint64(val)}
+}
+
+// Ditto for an arbitrary precision integer (the value is copied)
+func NewBigIntFromBig(val *big.Int) BigIntType {
+	return BigIntType{val: new(big.Int).Set(val)}
+}
+
+// Create a BigInt from an integral floating point value, error if the value
+// has a fractional part or is not finite
+func NewBigIntFromFloat(val float64) (BigIntType, error) {
+	if math.IsNaN(val) || math.IsInf(val, 0) || val != math.Trunc(val) {
+		return BigIntType{}, fmt.Errorf("The number %s cannot be converted "+
+			"to a BigInt because it is not an integer",
+			ToString(NumberType(val)))
+	}
+	res, _ := new(big.Float).SetFloat64(val).Int(nil)
+	return BigIntType{val: res}, nil
+}
+
+// Parse the BigInt from a string (Section 7.1.14, StringToBigInt), decimal
+// with optional sign or 0x/0o/0b prefixed, where empty is zero
+func ParseBigInt(str string) (BigIntType, error) {
+	src := strings.TrimSpace(str)
+	if src == "" {
+		return BigIntType{}, nil
+	}
+
+	base := 10
+	if len(src) > 2 && src[0] == '0' {
+		switch src[1] {
+		case 'x', 'X':
+			base = 16
+		case 'o', 'O':
+			base = 8
+		case 'b', 'B':
+			base = 2
+		}
+		if base != 10 {
+			src = src[2:]
+		}
+	}
+	digits := src
+	if base == 10 && (src[0] == '+' || src[0] == '-') {
+		digits = src[1:]
+	}
+
+	// SetString is more lenient (e.g. signs), so validate the digits
+	valid := digits != ""
+	for _, ch := range strings.ToLower(digits) {
+		dval := strings.IndexRune("0123456789abcdef", ch)
+		if dval < 0 || dval >= base {
+			valid = false
+			break
+		}
+	}
+	val, ok := new(big.Int).SetString(src, base)
+	if !valid || !ok {
+		return BigIntType{}, fmt.Errorf("Cannot convert %s to a BigInt", str)
+	}
+	return BigIntType{val: val}, nil
+}
+
+// The underlying value, shared so must not be modified
+func (bval BigIntType) bigVal() *big.Int {
+	if bval.val == nil {
+		return new(big.Int)
+	}
+	return bval.val
+}
+
+// Wrap a newly calculated value, subject to the size limit
+func newBigIntResult(val *big.Int) (BigIntType, error) {
+	if val.BitLen() > maxBigIntBits {
+		return BigIntType{}, errBigIntSize
+	}
+	return BigIntType{val: val}, nil
+}
+
+// Native() for a BigInt is a (copy of the) *big.Int value
+func (bval BigIntType) Native() interface{} {
+	return bval.Big()
+}
+
+// BigInts are primitive values
+func (bval BigIntType) ToPrimitive(pref any) DataType {
+	return bval
+}
+
+// Copy of the value as a Go arbitrary precision integer
+func (bval BigIntType) Big() *big.Int {
+	return new(big.Int).Set(bval.bigVal())
+}
+
+// The value as a Go integer, false if outside of the int64 range
+func (bval BigIntType) Int64() (int64, bool) {
+	return bval.bigVal().Int64(), bval.bigVal().IsInt64()
+}
+
+// Ditto for an unsigned value, false if negative or outside of the range
+func (bval BigIntType) Uint64() (uint64, bool) {
+	return bval.bigVal().Uint64(), bval.bigVal().IsUint64()
+}
+
+// Nearest floating point value (Number(bigint))
+func (bval BigIntType) Float64() float64 {
+	res, _ := new(big.Float).SetInt(bval.bigVal()).Float64()
+	return res
+}
+
+// Sign of the value, -1, 0 or 1
+func (bval BigIntType) Sign() int {
+	return bval.bigVal().Sign()
+}
+
+// Compare the value to the other BigInt, -1, 0 or 1 for less, equal, greater
+func (bval BigIntType) Cmp(other BigIntType) int {
+	return bval.bigVal().Cmp(other.bigVal())
+}
+
+// Compare the value to the (exact) floating point value, false if NaN
+func (bval BigIntType) CmpFloat(val float64) (int, bool) {
+	switch {
+	case math.IsNaN(val):
+		return 0, false
+	case math.IsInf(val, 1):
+		return -1, true
+	case math.IsInf(val, -1):
+		return 1, true
+	}
+	return new(big.Float).SetInt(bval.bigVal()).Cmp(big.NewFloat(val)), true
+}
+
+// Representation of the value in the given radix (2 to 36)
+func (bval BigIntType) Text(radix int) string {
+	return bval.bigVal().Text(radix)
+}
+
+// Decimal representation of the value (without the 'n' suffix)
+func (bval BigIntType) String() string {
+	return bval.bigVal().String()
+}
+
+// Arithmetic operations, all of which return new values
+func (bval BigIntType) Add(other BigIntType) BigIntType {
+	return BigIntType{val: new(big.Int).Add(bval.bigVal(), other.bigVal())}
+}
+
+func (bval BigIntType) Sub(other BigIntType) BigIntType {
+	return BigIntType{val: new(big.Int).Sub(bval.bigVal(), other.bigVal())}
+}
+
+func (bval BigIntType) Mul(other BigIntType) (BigIntType, error) {
+	if bval.bigVal().BitLen()+other.bigVal().BitLen() > maxBigIntBits+1 {
+		return BigIntType{}, errBigIntSize
+	}
+	return newBigIntResult(new(big.Int).Mul(bval.bigVal(), other.bigVal()))
+}
+
+// Division truncates towards zero (error if the divisor is zero)
+func (bval BigIntType) Quo(other BigIntType) (BigIntType, error) {
+	if other.Sign() == 0 {
+		return BigIntType{}, errors.New("RangeError: Division by zero")
+	}
+	res := new(big.Int).Quo(bval.bigVal(), other.bigVal())
+	return BigIntType{val: res}, nil
+}
+
+// Remainder has the sign of the dividend (error if the divisor is zero)
+func (bval BigIntType) Rem(other BigIntType) (BigIntType, error) {
+	if other.Sign() == 0 {
+		return BigIntType{}, errors.New("RangeError: Division by zero")
+	}
+	res := new(big.Int).Rem(bval.bigVal(), other.bigVal())
+	return BigIntType{val: res}, nil
+}
+
+// Exponentiation (error if the exponent is negative)
+func (bval BigIntType) Exp(other BigIntType) (BigIntType, error) {
+	if other.Sign() < 0 {
+		return BigIntType{}, errors.New("RangeError: Exponent must be " +
+			"non-negative")
+	}
+	base, exp := bval.bigVal(), other.bigVal()
+	if base.CmpAbs(big.NewInt(1)) > 0 &&
+		(!exp.IsInt64() || exp.Int64() > maxBigIntBits ||
+			int64(base.BitLen()-1)*exp.Int64() > maxBigIntBits) {
+		return BigIntType{}, errBigIntSize
+	}
+	return newBigIntResult(new(big.Int).Exp(base, exp, nil))
+}
+
+func (bval BigIntType) Neg() BigIntType {
+	return BigIntType{val: new(big.Int).Neg(bval.bigVal())}
+}
+
+// Bitwise operations, as for an infinite two's complement representation
+func (bval BigIntType) And(other BigIntType) BigIntType {
+	return BigIntType{val: new(big.Int).And(bval.bigVal(), other.bigVal())}
+}
+
+func (bval BigIntType) Or(other BigIntType) BigIntType {
+	return BigIntType{val: new(big.Int).Or(bval.bigVal(), other.bigVal())}
+}
+
+func (bval BigIntType) Xor(other BigIntType) BigIntType {
+	return BigIntType{val: new(big.Int).Xor(bval.bigVal(), other.bigVal())}
+}
+
+func (bval BigIntType) Not() BigIntType {
+	return BigIntType{val: new(big.Int).Not(bval.bigVal())}
+}
+
+// Left shift, where a negative shift is a (sign extending) right shift
+func (bval BigIntType) Lsh(other BigIntType) (BigIntType, error) {
+	return bval.shift(other.bigVal(), false)
+}
+
+// Right shift (sign extending), a negative shift is a left shift
+func (bval BigIntType) Rsh(other BigIntType) (BigIntType, error) {
+	return bval.shift(other.bigVal(), true)
+}
+
+func (bval BigIntType) shift(count *big.Int, right bool) (BigIntType, error) {
+	if count.Sign() < 0 {
+		count, right = new(big.Int).Neg(count), !right
+	}
+	val := bval.bigVal()
+	if right {
+		if !count.IsInt64() || count.Int64() > int64(val.BitLen()) {
+			if val.Sign() < 0 {
+				return NewBigInt(-1), nil
+			}
+			return BigIntType{}, nil
+		}
+		return BigIntType{val: new(big.Int).Rsh(val, uint(count.Int64()))},
+			nil
+	}
+	if val.Sign() == 0 {
+		return bval, nil
+	}
+	if !count.IsInt64() || count.Int64() > maxBigIntBits {
+		return BigIntType{}, errBigIntSize
+	}
+	return newBigIntResult(new(big.Int).Lsh(val, uint(count.Int64())))
+}
+
+// Wrap the value to a signed integer of the given number of bits (as for
+// BigInt.asIntN)
+func (bval BigIntType) AsIntN(bits uint64) (BigIntType, error) {
+	val := bval.bigVal()
+	if bits == 0 {
+		return BigIntType{}, nil
+	}
+	if bits > uint64(val.BitLen()) {
+		return bval, nil
+	}
+	mod := new(big.Int).Lsh(big.NewInt(1), uint(bits))
+	res := new(big.Int).Mod(val, mod)
+	if res.Bit(int(bits-1)) != 0 {
+		res.Sub(res, mod)
+	}
+	return BigIntType{val: res}, nil
+}
+
+// Ditto for an unsigned integer (as for BigInt.asUintN)
+func (bval BigIntType) AsUintN(bits uint64) (BigIntType, error) {
+	val := bval.bigVal()
+	if bits == 0 {
+		return BigIntType{}, nil
+	}
+	if val.Sign() >= 0 && bits >= uint64(val.BitLen()) {
+		return bval, nil
+	}
+	if bits > maxBigIntBits {
+		return BigIntType{}, errBigIntSize
+	}
+	mod := new(big.Int).Lsh(big.NewInt(1), uint(bits))
+	return BigIntType{val: new(big.Int).Mod(val, mod)}, nil
+}
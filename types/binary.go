@@ -0,0 +1,456 @@
+/*
+ * Binary data types, ArrayBuffer with the typed array and DataView views.
+ *
+ * Copyright (C) 2005-2026 J.M. Heisz.  All Rights Reserved.
+ * See the LICENSE file accompanying the distribution your rights to use
+ * this software.
+ */
+
+package types
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"strings"
+)
+
+// Limit on the size of array buffers (in bytes)
+const MaxArrayBufferLength = 1 << 31
+
+// ArrayBufferType is a fixed length buffer of raw bytes, which can be shared
+// with a Go byte slice (no copying) and accessed through the views
+type ArrayBufferType struct {
+	data []byte
+}
+
+// Create a new (zero-filled) array buffer of the given length
+func NewArrayBuffer(length int) *ArrayBufferType {
+	return &ArrayBufferType{data: make([]byte, length)}
+}
+
+// Create an array buffer sharing the Go byte slice (changes are visible to
+// both the script and the Go application)
+func NewArrayBufferFromBytes(data []byte) *ArrayBufferType {
+	if data == nil {
+		data = []byte{}
+	}
+	return &ArrayBufferType{data: data}
+}
+
+// Native() for a buffer is the (shared) byte slice
+func (buf *ArrayBufferType) Native() interface{} {
+	return buf.data
+}
+
+func (buf *ArrayBufferType) ToPrimitive(pref any) DataType {
+	return StringType("[object ArrayBuffer]")
+}
+
+// The underlying bytes of the buffer (shared, not a copy)
+func (buf *ArrayBufferType) Bytes() []byte {
+	return buf.data
+}
+
+func (buf *ArrayBufferType) ByteLength() int {
+	return len(buf.data)
+}
+
+// The element types of the typed arrays
+type TypedArrayKind int
+
+const (
+	Int8Array TypedArrayKind = iota
+	Uint8Array
+	Uint8ClampedArray
+	Int16Array
+	Uint16Array
+	Int32Array
+	Uint32Array
+	Float32Array
+	Float64Array
+	BigInt64Array
+	BigUint64Array
+)
+
+// All of the typed array kinds, for registration of the constructors
+var TypedArrayKinds = []TypedArrayKind{Int8Array, Uint8Array,
+	Uint8ClampedArray, Int16Array, Uint16Array, Int32Array, Uint32Array,
+	Float32Array, Float64Array, BigInt64Array, BigUint64Array}
+
+var typedArrayNames = [...]string{"Int8Array", "Uint8Array",
+	"Uint8ClampedArray", "Int16Array", "Uint16Array", "Int32Array",
+	"Uint32Array", "Float32Array", "Float64Array", "BigInt64Array",
+	"BigUint64Array"}
+
+var typedArraySizes = [...]int{1, 1, 1, 2, 2, 4, 4, 4, 8, 8, 8}
+
+// The constructor name for the kind (e.g. "Uint8Array")
+func (kind TypedArrayKind) String() string {
+	return typedArrayNames[kind]
+}
+
+// Size of the elements in bytes
+func (kind TypedArrayKind) ElementSize() int {
+	return typedArraySizes[kind]
+}
+
+// Elements of the BigInt arrays are BigInts rather than numbers
+func (kind TypedArrayKind) IsBigInt() bool {
+	return kind == BigInt64Array || kind == BigUint64Array
+}
+
+// Decode the element value from the bytes, with the given byte order
+func (kind TypedArrayKind) decode(data []byte,
+	order binary.ByteOrder) DataType {
+	switch kind {
+	case Int8Array:
+		return IntegerType(int8(data[0]))
+	case Uint8Array, Uint8ClampedArray:
+		return IntegerType(data[0])
+	case Int16Array:
+		return IntegerType(int16(order.Uint16(data)))
+	case Uint16Array:
+		return IntegerType(order.Uint16(data))
+	case Int32Array:
+		return IntegerType(int32(order.Uint32(data)))
+	case Uint32Array:
+		return IntegerType(order.Uint32(data))
+	case Float32Array:
+		return NumberType(math.Float32frombits(order.Uint32(data)))
+	case Float64Array:
+		return NumberType(math.Float64frombits(order.Uint64(data)))
+	case BigInt64Array:
+		return NewBigInt(int64(order.Uint64(data)))
+	}
+	return NewBigIntFromUint64(order.Uint64(data))
+}
+
+// Encode the value into the bytes with the given byte order, where integers
+// wrap (modulo) as per the specification conversions (Section 7.1.6 etc.)
+// and BigInt values are required for the BigInt arrays (TypeError)
+func (kind TypedArrayKind) encode(data []byte, val DataType,
+	order binary.ByteOrder) error {
+	if kind.IsBigInt() {
+		bval, ok := val.(BigIntType)
+		if !ok {
+			return errors.New("TypeError: Cannot convert " + ToString(val) +
+				" to a BigInt")
+		}
+		wrapped, _ := bval.AsUintN(64)
+		num, _ := wrapped.Uint64()
+		order.PutUint64(data, num)
+		return nil
+	}
+	if _, ok := val.(BigIntType); ok {
+		return errors.New("TypeError: Cannot convert a BigInt value to a " +
+			"number")
+	}
+
+	num := ToNumber(val)
+	switch kind {
+	case Uint8ClampedArray:
+		if num != num || num <= 0 {
+			data[0] = 0
+		} else if num >= 255 {
+			data[0] = 255
+		} else {
+			data[0] = byte(math.RoundToEven(num))
+		}
+	case Float32Array:
+		order.PutUint32(data, math.Float32bits(float32(num)))
+	case Float64Array:
+		order.PutUint64(data, math.Float64bits(num))
+	default:
+		ival := wrapInteger(num)
+		switch kind.ElementSize() {
+		case 1:
+			data[0] = byte(ival)
+		case 2:
+			order.PutUint16(data, uint16(ival))
+		default:
+			order.PutUint32(data, uint32(ival))
+		}
+	}
+	return nil
+}
+
+// Integer conversion (modulo 2^32) for the integer element types
+func wrapInteger(num float64) int64 {
+	if math.IsNaN(num) || math.IsInf(num, 0) {
+		return 0
+	}
+	return int64(math.Mod(math.Trunc(num), 1<<32))
+}
+
+// TypedArrayType is a view of elements of the kind within an array buffer,
+// multiple views can share the same buffer (e.g. from subarray())
+type TypedArrayType struct {
+	kind   TypedArrayKind
+	buffer *ArrayBufferType
+	offset int
+	length int
+}
+
+// Create a new typed array (with a new buffer) of the given element length
+func NewTypedArray(kind TypedArrayKind, length int) *TypedArrayType {
+	return &TypedArrayType{kind: kind,
+		buffer: NewArrayBuffer(length * kind.ElementSize()),
+		length: length}
+}
+
+// Create a typed array view on the buffer, from the byte offset for the
+// number of elements (negative for the remainder of the buffer)
+func NewTypedArrayView(kind TypedArrayKind, buffer *ArrayBufferType,
+	offset, length int) (*TypedArrayType, error) {
+	size := kind.ElementSize()
+	if offset < 0 || offset%size != 0 {
+		return nil, errors.New("RangeError: Start offset of " + kind.String() +
+			" should be a multiple of " + ToString(IntegerType(size)))
+	}
+	if length < 0 {
+		if (buffer.ByteLength()-offset)%size != 0 {
+			return nil, errors.New("RangeError: Byte length of " +
+				kind.String() + " should be a multiple of " +
+				ToString(IntegerType(size)))
+		}
+		length = (buffer.ByteLength() - offset) / size
+	}
+	if length < 0 || offset+length*size > buffer.ByteLength() {
+		return nil, errors.New("RangeError: Invalid typed array length: " +
+			ToString(IntegerType(length)))
+	}
+	return &TypedArrayType{kind: kind, buffer: buffer, offset: offset,
+		length: length}, nil
+}
+
+// Create a Uint8Array sharing the Go byte slice (no copying)
+func NewUint8ArrayFromBytes(data []byte) *TypedArrayType {
+	return &TypedArrayType{kind: Uint8Array,
+		buffer: NewArrayBufferFromBytes(data), length: len(data)}
+}
+
+// Native() for byte arrays is the (shared) byte slice of the view, other
+// element types are copied into a slice of the equivalent Go type
+func (arr *TypedArrayType) Native() interface{} {
+	switch arr.kind {
+	case Uint8Array, Uint8ClampedArray:
+		return arr.Bytes()
+	case Int8Array:
+		return typedSlice(arr, func(val DataType) int8 {
+			return int8(val.(IntegerType))
+		})
+	case Int16Array:
+		return typedSlice(arr, func(val DataType) int16 {
+			return int16(val.(IntegerType))
+		})
+	case Uint16Array:
+		return typedSlice(arr, func(val DataType) uint16 {
+			return uint16(val.(IntegerType))
+		})
+	case Int32Array:
+		return typedSlice(arr, func(val DataType) int32 {
+			return int32(val.(IntegerType))
+		})
+	case Uint32Array:
+		return typedSlice(arr, func(val DataType) uint32 {
+			return uint32(val.(IntegerType))
+		})
+	case Float32Array:
+		return typedSlice(arr, func(val DataType) float32 {
+			return float32(val.(NumberType))
+		})
+	case Float64Array:
+		return typedSlice(arr, func(val DataType) float64 {
+			return float64(val.(NumberType))
+		})
+	case BigInt64Array:
+		return typedSlice(arr, func(val DataType) int64 {
+			num, _ := val.(BigIntType).Int64()
+			return num
+		})
+	}
+	return typedSlice(arr, func(val DataType) uint64 {
+		num, _ := val.(BigIntType).Uint64()
+		return num
+	})
+}
+
+func typedSlice[T any](arr *TypedArrayType, conv func(val DataType) T) []T {
+	res := make([]T, arr.length)
+	for idx := range res {
+		res[idx] = conv(arr.Get(idx))
+	}
+	return res
+}
+
+// Copy of the elements as a standard array
+func (arr *TypedArrayType) toArray() *ArrayType {
+	res := NewArray(arr.length)
+	for idx := range res.Elements {
+		res.Elements[idx] = arr.Get(idx)
+	}
+	return res
+}
+
+// Typed arrays convert to strings like arrays (comma-separated)
+func (arr *TypedArrayType) ToPrimitive(pref any) DataType {
+	parts := make([]string, arr.length)
+	for idx := range parts {
+		parts[idx] = ToString(arr.Get(idx))
+	}
+	return StringType(strings.Join(parts, ","))
+}
+
+// Accessors for the view details
+func (arr *TypedArrayType) Kind() TypedArrayKind {
+	return arr.kind
+}
+
+func (arr *TypedArrayType) Buffer() *ArrayBufferType {
+	return arr.buffer
+}
+
+func (arr *TypedArrayType) ByteOffset() int {
+	return arr.offset
+}
+
+func (arr *TypedArrayType) ByteLength() int {
+	return arr.length * arr.kind.ElementSize()
+}
+
+func (arr *TypedArrayType) Length() int {
+	return arr.length
+}
+
+// The bytes of the view (shared with the buffer, not a copy)
+func (arr *TypedArrayType) Bytes() []byte {
+	return arr.buffer.data[arr.offset : arr.offset+arr.ByteLength()]
+}
+
+// Retrieve the element at the index, undefined if out of range
+func (arr *TypedArrayType) Get(index int) DataType {
+	if index < 0 || index >= arr.length {
+		return Undefined
+	}
+	size := arr.kind.ElementSize()
+	start := arr.offset + index*size
+	return arr.kind.decode(arr.buffer.data[start:start+size],
+		binary.LittleEndian)
+}
+
+// Store the (converted) value at the index, ignored if out of range (error
+// for BigInt/number mismatches)
+func (arr *TypedArrayType) Set(index int, val DataType) error {
+	size := arr.kind.ElementSize()
+	if index < 0 || index >= arr.length {
+		// Conversion still applies (and can fail)
+		return arr.kind.encode(make([]byte, size), val, binary.LittleEndian)
+	}
+	start := arr.offset + index*size
+	return arr.kind.encode(arr.buffer.data[start:start+size], val,
+		binary.LittleEndian)
+}
+
+// Create a new view on the same buffer for the element range
+func (arr *TypedArrayType) Subarray(begin, end int) *TypedArrayType {
+	if end < begin {
+		end = begin
+	}
+	return &TypedArrayType{kind: arr.kind, buffer: arr.buffer,
+		offset: arr.offset + begin*arr.kind.ElementSize(),
+		length: end - begin}
+}
+
+// DataViewType provides access to (mixed) values at arbitrary byte offsets
+// within an array buffer, with either byte order
+type DataViewType struct {
+	buffer *ArrayBufferType
+	offset int
+	length int
+}
+
+// Create a view on the buffer from the byte offset for the number of bytes
+// (negative for the remainder of the buffer)
+func NewDataView(buffer *ArrayBufferType, offset,
+	length int) (*DataViewType, error) {
+	if offset < 0 || offset > buffer.ByteLength() {
+		return nil, errors.New("RangeError: Start offset " +
+			ToString(IntegerType(offset)) + " is outside the bounds of " +
+			"the buffer")
+	}
+	if length < 0 {
+		length = buffer.ByteLength() - offset
+	}
+	if offset+length > buffer.ByteLength() {
+		return nil, errors.New("RangeError: Invalid DataView length " +
+			ToString(IntegerType(length)))
+	}
+	return &DataViewType{buffer: buffer, offset: offset, length: length}, nil
+}
+
+// Native() for a data view is the (shared) byte slice of the view
+func (view *DataViewType) Native() interface{} {
+	return view.Bytes()
+}
+
+func (view *DataViewType) ToPrimitive(pref any) DataType {
+	return StringType("[object DataView]")
+}
+
+// Accessors for the view details
+func (view *DataViewType) Buffer() *ArrayBufferType {
+	return view.buffer
+}
+
+func (view *DataViewType) ByteOffset() int {
+	return view.offset
+}
+
+func (view *DataViewType) ByteLength() int {
+	return view.length
+}
+
+// The bytes of the view (shared with the buffer, not a copy)
+func (view *DataViewType) Bytes() []byte {
+	return view.buffer.data[view.offset : view.offset+view.length]
+}
+
+// Locate the bytes for a value of the kind at the offset within the view
+func (view *DataViewType) element(kind TypedArrayKind,
+	offset int) ([]byte, error) {
+	size := kind.ElementSize()
+	if offset < 0 || offset+size > view.length {
+		return nil, errors.New("RangeError: Offset is outside the bounds " +
+			"of the DataView")
+	}
+	start := view.offset + offset
+	return view.buffer.data[start : start+size], nil
+}
+
+func byteOrder(littleEndian bool) binary.ByteOrder {
+	if littleEndian {
+		return binary.LittleEndian
+	}
+	return binary.BigEndian
+}
+
+// Read a value of the element kind at the byte offset in the view
+func (view *DataViewType) Get(kind TypedArrayKind, offset int,
+	littleEndian bool) (DataType, error) {
+	data, err := view.element(kind, offset)
+	if err != nil {
+		return nil, err
+	}
+	return kind.decode(data, byteOrder(littleEndian)), nil
+}
+
+// Write a (converted) value of the element kind at the byte offset
+func (view *DataViewType) Set(kind TypedArrayKind, offset int, val DataType,
+	littleEndian bool) error {
+	data, err := view.element(kind, offset)
+	if err != nil {
+		return err
+	}
+	return kind.encode(data, val, byteOrder(littleEndian))
+}
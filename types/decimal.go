@@ -0,0 +1,495 @@
+/*
+ * Arbitrary precision decimal datatype, for exact (e.g. financial) values.
+ *
+ * Copyright (C) 2005-2026 J.M. Heisz.  All Rights Reserved.
+ * See the LICENSE file accompanying the distribution your rights to use
+ * this software.
+ */
+
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Rounding modes for decimal operations that reduce the number of digits
+type RoundingMode int
+
+const (
+	RoundHalfEven RoundingMode = iota // nearest, ties to even (banker's)
+	RoundHalfUp                       // nearest, ties away from zero
+	RoundHalfDown                     // nearest, ties towards zero
+	RoundUp                           // away from zero
+	RoundDown                         // towards zero (truncate)
+	RoundCeiling                      // towards positive infinity
+	RoundFloor                        // towards negative infinity
+)
+
+var roundingModeNames = []string{"half-even", "half-up", "half-down", "up",
+	"down", "ceiling", "floor"}
+
+// Script name of the rounding mode (e.g. "half-even")
+func (mode RoundingMode) String() string {
+	if mode >= 0 && int(mode) < len(roundingModeNames) {
+		return roundingModeNames[mode]
+	}
+	return "RoundingMode(" + strconv.Itoa(int(mode)) + ")"
+}
+
+// Determine the rounding mode from the script name
+func ParseRoundingMode(name string) (RoundingMode, error) {
+	for idx, mname := range roundingModeNames {
+		if mname == name {
+			return RoundingMode(idx), nil
+		}
+	}
+	return RoundHalfEven, fmt.Errorf("Invalid rounding mode '%s'", name)
+}
+
+// Settings for decimal operations, where Precision is the number of
+// significant digits for division (inexact) results and Rounding is the
+// default mode for those results and for rounding without an explicit mode
+type DecimalContext struct {
+	Precision int
+	Rounding  RoundingMode
+}
+
+// Defaults equivalent to IEEE 754 decimal128
+var DefaultDecimalContext = DecimalContext{Precision: 34,
+	Rounding: RoundHalfEven}
+
+// Provider for the decimal context of the process, if supported
+type decimalProcess interface {
+	DecimalContext() DecimalContext
+}
+
+// Retrieve the decimal context for the process (or the default)
+func DecimalContextOf(prc Process) DecimalContext {
+	if dp, ok := prc.(decimalProcess); ok {
+		if dctx := dp.DecimalContext(); dctx.Precision > 0 {
+			return dctx
+		}
+	}
+	return DefaultDecimalContext
+}
+
+// Limit on the (absolute) scale to avoid unbounded values from exponents
+const maxDecimalScale = 1 << 16
+
+var bigTen = big.NewInt(10)
+
+// Decimal is an immutable arbitrary precision decimal value (unscaled value
+// times ten to the power of minus the scale), the zero value is zero.  It is
+// both the Go value and the script datatype, so crosses the boundary intact.
+type Decimal struct {
+	unscaled *big.Int
+	scale    int32
+}
+
+// Create a decimal from the unscaled value and scale (e.g. 1234, 2 is 12.34)
+func NewDecimal(unscaled int64, scale int32) Decimal {
+	return newDecimal(big.NewInt(unscaled), int64(scale))
+}
+
+// Ditto, for an arbitrary precision unscaled value
+func NewDecimalFromBigInt(unscaled *big.Int, scale int32) Decimal {
+	return newDecimal(new(big.Int).Set(unscaled), int64(scale))
+}
+
+// Create a decimal from the shortest representation of the floating point
+// value (e.g. 0.1 is exactly 0.1), error for NaN or infinite values
+func NewDecimalFromFloat(val float64) (Decimal, error) {
+	if math.IsNaN(val) || math.IsInf(val, 0) {
+		return Decimal{}, fmt.Errorf("Cannot convert %v to a decimal", val)
+	}
+	return ParseDecimal(strconv.FormatFloat(val, 'g', -1, 64))
+}
+
+// Parse the decimal from a string, in plain or exponential notation
+func ParseDecimal(str string) (Decimal, error) {
+	invalid := fmt.Errorf("Invalid decimal value '%s'", str)
+	src := strings.TrimSpace(str)
+
+	mant, exp := src, int64(0)
+	if idx := strings.IndexAny(src, "eE"); idx >= 0 {
+		var err error
+		exp, err = strconv.ParseInt(src[idx+1:], 10, 32)
+		if err != nil {
+			return Decimal{}, invalid
+		}
+		mant = src[:idx]
+	}
+	sign := ""
+	if mant != "" && (mant[0] == '+' || mant[0] == '-') {
+		if mant[0] == '-' {
+			sign = "-"
+		}
+		mant = mant[1:]
+	}
+	intPart, fracPart := mant, ""
+	if idx := strings.IndexByte(mant, '.'); idx >= 0 {
+		intPart, fracPart = mant[:idx], mant[idx+1:]
+	}
+	digits := intPart + fracPart
+	if digits == "" || strings.Trim(digits, "0123456789") != "" {
+		return Decimal{}, invalid
+	}
+
+	scale := int64(len(fracPart)) - exp
+	if scale > maxDecimalScale || scale < -maxDecimalScale {
+		return Decimal{}, fmt.Errorf("Decimal value '%s' out of range", str)
+	}
+	coeff, _ := new(big.Int).SetString(sign+digits, 10)
+	return newDecimal(coeff, scale), nil
+}
+
+// Internal constructor, taking ownership of the coefficient (non-negative
+// scale to keep the arithmetic simple)
+func newDecimal(coeff *big.Int, scale int64) Decimal {
+	if scale < 0 {
+		coeff.Mul(coeff, pow10(-scale))
+		scale = 0
+	}
+	return Decimal{unscaled: coeff, scale: int32(scale)}
+}
+
+func pow10(exp int64) *big.Int {
+	return new(big.Int).Exp(bigTen, big.NewInt(exp), nil)
+}
+
+// Number of decimal digits in the (absolute) value
+func numDigits(val *big.Int) int {
+	return len(new(big.Int).Abs(val).String())
+}
+
+// Unscaled value, shared so must not be modified
+func (dec Decimal) coeff() *big.Int {
+	if dec.unscaled == nil {
+		return new(big.Int)
+	}
+	return dec.unscaled
+}
+
+// Unscaled values of the two decimals at a common scale
+func align(a, b Decimal) (*big.Int, *big.Int, int32) {
+	x, y := a.coeff(), b.coeff()
+	switch {
+	case a.scale < b.scale:
+		x = new(big.Int).Mul(x, pow10(int64(b.scale-a.scale)))
+		return x, y, b.scale
+	case a.scale > b.scale:
+		y = new(big.Int).Mul(y, pow10(int64(a.scale-b.scale)))
+	}
+	return x, y, a.scale
+}
+
+// Adjust the truncated quotient according to the remainder and rounding mode
+func roundQuotient(quo, rem, den *big.Int, mode RoundingMode) {
+	if rem.Sign() == 0 {
+		return
+	}
+	neg := rem.Sign() != den.Sign()
+	half := new(big.Int).Abs(rem)
+	half.Lsh(half, 1)
+	cmpHalf := half.Cmp(new(big.Int).Abs(den))
+
+	var incr bool
+	switch mode {
+	case RoundHalfEven:
+		incr = cmpHalf > 0 || (cmpHalf == 0 && quo.Bit(0) == 1)
+	case RoundHalfUp:
+		incr = cmpHalf >= 0
+	case RoundHalfDown:
+		incr = cmpHalf > 0
+	case RoundUp:
+		incr = true
+	case RoundCeiling:
+		incr = !neg
+	case RoundFloor:
+		incr = neg
+	}
+	if incr {
+		if neg {
+			quo.Sub(quo, big.NewInt(1))
+		} else {
+			quo.Add(quo, big.NewInt(1))
+		}
+	}
+}
+
+// Sign of the value, -1, 0 or 1
+func (dec Decimal) Sign() int {
+	return dec.coeff().Sign()
+}
+
+// Number of digits after the decimal point
+func (dec Decimal) Scale() int32 {
+	return dec.scale
+}
+
+func (dec Decimal) Add(val Decimal) Decimal {
+	x, y, scale := align(dec, val)
+	return Decimal{unscaled: new(big.Int).Add(x, y), scale: scale}
+}
+
+func (dec Decimal) Sub(val Decimal) Decimal {
+	x, y, scale := align(dec, val)
+	return Decimal{unscaled: new(big.Int).Sub(x, y), scale: scale}
+}
+
+func (dec Decimal) Mul(val Decimal) Decimal {
+	return Decimal{unscaled: new(big.Int).Mul(dec.coeff(), val.coeff()),
+		scale: dec.scale + val.scale}
+}
+
+// Divide by the value, exact results are returned as-is and inexact results
+// are rounded to the context precision (significant digits)
+func (dec Decimal) Quo(val Decimal, dctx DecimalContext) (Decimal, error) {
+	if val.Sign() == 0 {
+		return Decimal{}, errors.New("RangeError: Division by zero")
+	}
+	if dec.Sign() == 0 {
+		return Decimal{}, nil
+	}
+	prec := dctx.Precision
+	if prec <= 0 {
+		prec = DefaultDecimalContext.Precision
+	}
+
+	// Scale the result for the precision based on the estimated magnitude,
+	// one less if the quotient has an extra digit (avoid double rounding)
+	mag := (numDigits(dec.coeff()) - int(dec.scale)) -
+		(numDigits(val.coeff()) - int(val.scale))
+	scale := int64(prec - mag)
+	var quo, rem, den *big.Int
+	for pass := 0; pass < 2; pass++ {
+		num := new(big.Int).Set(dec.coeff())
+		den = new(big.Int).Set(val.coeff())
+		shift := int64(val.scale) - int64(dec.scale) + scale
+		if shift >= 0 {
+			num.Mul(num, pow10(shift))
+		} else {
+			den.Mul(den, pow10(-shift))
+		}
+		quo, rem = new(big.Int).QuoRem(num, den, new(big.Int))
+		if numDigits(quo) <= prec {
+			break
+		}
+		scale--
+	}
+	roundQuotient(quo, rem, den, dctx.Rounding)
+
+	// Exact (or rounded) results don't need the trailing zeros
+	for scale > 0 {
+		q, r := new(big.Int).QuoRem(quo, bigTen, new(big.Int))
+		if r.Sign() != 0 {
+			break
+		}
+		quo, scale = q, scale-1
+	}
+	return newDecimal(quo, scale), nil
+}
+
+// Remainder of truncating division by the value (as for the % operator)
+func (dec Decimal) Rem(val Decimal) (Decimal, error) {
+	if val.Sign() == 0 {
+		return Decimal{}, errors.New("RangeError: Division by zero")
+	}
+	x, y, scale := align(dec, val)
+	return Decimal{unscaled: new(big.Int).Rem(x, y), scale: scale}, nil
+}
+
+// Raise to the (integral) power, negative exponents are the reciprocal which
+// is rounded to the context precision as for division
+func (dec Decimal) Pow(exp int64, dctx DecimalContext) (Decimal, error) {
+	if exp < 0 {
+		if exp == math.MinInt64 {
+			return Decimal{}, errors.New("RangeError: Decimal exponent " +
+				"out of range")
+		}
+		res, err := dec.Pow(-exp, dctx)
+		if err != nil {
+			return Decimal{}, err
+		}
+		return NewDecimal(1, 0).Quo(res, dctx)
+	}
+
+	// Limit the digits and scale of the result, as for parsed values
+	for _, factor := range []int64{int64(numDigits(dec.coeff()) - 1),
+		int64(dec.scale)} {
+		if factor > 0 && exp > maxDecimalScale/factor {
+			return Decimal{}, errors.New("RangeError: Decimal exponent " +
+				"out of range")
+		}
+	}
+	return newDecimal(new(big.Int).Exp(dec.coeff(), big.NewInt(exp), nil),
+		int64(dec.scale)*exp), nil
+}
+
+func (dec Decimal) Neg() Decimal {
+	return Decimal{unscaled: new(big.Int).Neg(dec.coeff()), scale: dec.scale}
+}
+
+func (dec Decimal) Abs() Decimal {
+	return Decimal{unscaled: new(big.Int).Abs(dec.coeff()), scale: dec.scale}
+}
+
+// Compare to the value, -1 if less than, 0 if equal and 1 if greater than
+func (dec Decimal) Cmp(val Decimal) int {
+	x, y, _ := align(dec, val)
+	return x.Cmp(y)
+}
+
+// Round to the given number of digits after the decimal point (padded with
+// zeros if more than the current scale, negative for tens, hundreds, etc.)
+func (dec Decimal) Round(scale int32, mode RoundingMode) Decimal {
+	if scale >= dec.scale {
+		return Decimal{unscaled: new(big.Int).Mul(dec.coeff(),
+			pow10(int64(scale-dec.scale))), scale: scale}
+	}
+	den := pow10(int64(dec.scale) - int64(scale))
+	quo, rem := new(big.Int).QuoRem(dec.coeff(), den, new(big.Int))
+	roundQuotient(quo, rem, den, mode)
+	return newDecimal(quo, int64(scale))
+}
+
+// Plain (non-exponential) string representation of the value
+func (dec Decimal) String() string {
+	coeff := dec.coeff()
+	digits := new(big.Int).Abs(coeff).String()
+	if scale := int(dec.scale); scale > 0 {
+		if len(digits) <= scale {
+			digits = strings.Repeat("0", scale-len(digits)+1) + digits
+		}
+		digits = digits[:len(digits)-scale] + "." + digits[len(digits)-scale:]
+	}
+	if coeff.Sign() < 0 {
+		return "-" + digits
+	}
+	return digits
+}
+
+// String representation with the fixed number of decimal places
+func (dec Decimal) StringFixed(places int32, mode RoundingMode) string {
+	return dec.Round(places, mode).String()
+}
+
+// Nearest floating point value
+func (dec Decimal) Float64() float64 {
+	val, _ := strconv.ParseFloat(dec.String(), 64)
+	return val
+}
+
+// DataType implementation, native value is the decimal itself
+func (dec Decimal) Native() interface{} {
+	return dec
+}
+
+func (dec Decimal) ToPrimitive(pref any) DataType {
+	return StringType(dec.String())
+}
+
+// JSON representation is the exact string value
+func (dec Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(dec.String())), nil
+}
+
+// And accept either strings or numbers from JSON
+func (dec *Decimal) UnmarshalJSON(data []byte) error {
+	str := string(data)
+	if unq, err := strconv.Unquote(str); err == nil {
+		str = unq
+	}
+	val, err := ParseDecimal(str)
+	if err != nil {
+		return err
+	}
+	*dec = val
+	return nil
+}
+
+func (dec Decimal) MarshalText() ([]byte, error) {
+	return []byte(dec.String()), nil
+}
+
+func (dec *Decimal) UnmarshalText(text []byte) error {
+	val, err := ParseDecimal(string(text))
+	if err != nil {
+		return err
+	}
+	*dec = val
+	return nil
+}
+
+// Convert an operand for the decimal operators, numbers are converted exactly
+// (by shortest representation), false for other types
+func decimalOperand(val DataType) (Decimal, bool) {
+	switch tval := val.(type) {
+	case Decimal:
+		return tval, true
+	case IntegerType:
+		return NewDecimal(int64(tval), 0), true
+	case NumberType:
+		dec, err := NewDecimalFromFloat(float64(tval))
+		return dec, err == nil
+	}
+	return Decimal{}, false
+}
+
+// Operators for decimal values (OperandType), against decimals and numbers
+func (dec Decimal) BinaryOp(prc Process, op Operator, other DataType,
+	reversed bool) (DataType, error) {
+	val, ok := decimalOperand(other)
+	if !ok {
+		return nil, ErrNoOperator
+	}
+	left, right := dec, val
+	if reversed {
+		left, right = val, dec
+	}
+	switch op {
+	case OpAdd:
+		return left.Add(right), nil
+	case OpSubtract:
+		return left.Sub(right), nil
+	case OpMultiply:
+		return left.Mul(right), nil
+	case OpDivide:
+		return left.Quo(right, DecimalContextOf(prc))
+	case OpModulus:
+		return left.Rem(right)
+	case OpExponent:
+		exp := right.Round(0, RoundDown)
+		if exp.Cmp(right) != 0 || !exp.coeff().IsInt64() {
+			return nil, errors.New("RangeError: Decimal exponent must be " +
+				"an integer")
+		}
+		return left.Pow(exp.coeff().Int64(), DecimalContextOf(prc))
+	}
+	return nil, ErrNoOperator
+}
+
+func (dec Decimal) Compare(prc Process, other DataType) (int, error) {
+	val, ok := decimalOperand(other)
+	if !ok {
+		return 0, ErrNoOperator
+	}
+	return dec.Cmp(val), nil
+}
+
+func (dec Decimal) Equals(prc Process, other DataType) (bool, error) {
+	val, ok := decimalOperand(other)
+	if !ok {
+		return false, ErrNoOperator
+	}
+	return dec.Cmp(val) == 0, nil
+}
+
+func (dec Decimal) Negate(prc Process) (DataType, error) {
+	return dec.Neg(), nil
+}
@@ -0,0 +1,436 @@
+/*
+ * Export (decoding) of script values into typed Go values.
+ *
+ * Copyright (C) 2005-2026 J.M. Heisz.  All Rights Reserved.
+ * See the LICENSE file accompanying the distribution your rights to use
+ * this software.
+ */
+
+package types
+
+import (
+	"encoding"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+var (
+	timeType            = reflect.TypeOf(time.Time{})
+	bigIntType          = reflect.TypeOf(big.Int{})
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// Options for the export of script values into Go values
+type ExportOptions struct {
+	// By default, a number can only be exported to an integer type if it has
+	// no fractional part, lenient conversion truncates towards zero instead
+	LenientNumbers bool
+}
+
+// Error for an export failure, with the path to the failing value in the
+// source value (e.g. "items[2].price"), empty for the top-level value
+type ExportError struct {
+	Path string
+	Msg  string
+}
+
+func (err *ExportError) Error() string {
+	if err.Path == "" {
+		return err.Msg
+	}
+	return err.Path + ": " + err.Msg
+}
+
+// Export the script value into the Go value referenced by the target pointer,
+// the inverse of NewFromInterface.  Objects (and host objects) are exported
+// to structs (by the ges/json field names, ignoring unknown properties) or
+// maps, as are Map instances to maps, arrays to slices or arrays.  Times are
+// exported from RFC 3339 strings or epoch milliseconds, and other
+// encoding.TextUnmarshaler types from strings.
+func ExportTo(val DataType, target any) error {
+	return ExportToWithOptions(val, target, ExportOptions{})
+}
+
+// Export the script value into the target with the specified options
+func ExportToWithOptions(val DataType, target any, opts ExportOptions) error {
+	rv := reflect.ValueOf(target)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("ExportTo target must be a non-nil pointer, got %T",
+			target)
+	}
+	dec := exporter{lenient: opts.LenientNumbers}
+	return dec.export(val, rv.Elem(), "")
+}
+
+// Convert the script value to a new Go value of the given type (strict)
+func toGoValue(val DataType, typ reflect.Type) (reflect.Value, error) {
+	res := reflect.New(typ).Elem()
+	if err := (&exporter{}).export(val, res, ""); err != nil {
+		return reflect.Value{}, err
+	}
+	return res, nil
+}
+
+// Short type description of a script value for conversion errors
+func typeName(val DataType) string {
+	switch tval := val.(type) {
+	case UndefinedType:
+		return "undefined"
+	case NullType:
+		return "null"
+	case BooleanType:
+		return "boolean"
+	case IntegerType, NumberType:
+		return "number"
+	case BigIntType:
+		return "bigint"
+	case StringType:
+		return "string"
+	case *SymbolType:
+		return "symbol"
+	case *ArrayType:
+		return "array"
+	case *TypedArrayType:
+		return tval.Kind().String()
+	case *ArrayBufferType:
+		return "ArrayBuffer"
+	case *DataViewType:
+		return "DataView"
+	case *MapType:
+		return "Map"
+	case FunctionType:
+		return "function"
+	}
+	return "object"
+}
+
+// Internal state for an export operation
+type exporter struct {
+	lenient bool
+}
+
+// Extend the path for a property or element
+func fieldPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func indexPath(path string, idx int) string {
+	return path + "[" + strconv.Itoa(idx) + "]"
+}
+
+// Export the value into the (settable) Go value
+func (dec *exporter) export(val DataType, rv reflect.Value, path string) error {
+	if val == nil {
+		val = Undefined
+	}
+	typ := rv.Type()
+	fail := func() error {
+		return &ExportError{Path: path,
+			Msg: fmt.Sprintf("cannot convert %s to %s", typeName(val), typ)}
+	}
+
+	// Values that are also Go values (e.g. Decimal) are assigned directly
+	if typ.Kind() != reflect.Interface &&
+		reflect.TypeOf(val).AssignableTo(typ) {
+		rv.Set(reflect.ValueOf(val))
+		return nil
+	}
+
+	// Wrapped Go values are used directly (or their address)
+	if gobj, ok := val.(*GoObject); ok {
+		src := gobj.value
+		if src.Type().AssignableTo(typ) {
+			rv.Set(src)
+			return nil
+		}
+		if src.CanAddr() && src.Addr().Type().AssignableTo(typ) {
+			rv.Set(src.Addr())
+			return nil
+		}
+	}
+
+	// Interfaces take script values as-is or the native equivalent
+	if typ.Kind() == reflect.Interface {
+		if typ.NumMethod() > 0 {
+			if !reflect.TypeOf(val).Implements(typ) {
+				return fail()
+			}
+			rv.Set(reflect.ValueOf(val))
+		} else if native := val.Native(); native != nil {
+			rv.Set(reflect.ValueOf(native))
+		} else {
+			rv.Set(reflect.Zero(typ))
+		}
+		return nil
+	}
+
+	// Undefined/null are the zero value for nillable types
+	switch val.(type) {
+	case UndefinedType, NullType:
+		switch typ.Kind() {
+		case reflect.Ptr, reflect.Slice, reflect.Map, reflect.Func:
+			rv.Set(reflect.Zero(typ))
+			return nil
+		}
+		return fail()
+	}
+
+	// Times are accepted as RFC 3339 strings or epoch milliseconds
+	if typ == timeType {
+		switch tval := val.(type) {
+		case StringType:
+			tm, err := time.Parse(time.RFC3339Nano, string(tval))
+			if err != nil {
+				return &ExportError{Path: path,
+					Msg: fmt.Sprintf("invalid time '%s'", tval)}
+			}
+			rv.Set(reflect.ValueOf(tm))
+			return nil
+		case IntegerType, NumberType:
+			rv.Set(reflect.ValueOf(time.UnixMilli(int64(ToNumber(tval)))))
+			return nil
+		}
+		return fail()
+	}
+
+	// Arbitrary precision integers are accepted from any integral value
+	if typ == bigIntType {
+		var res *big.Int
+		switch tval := val.(type) {
+		case BigIntType:
+			res = tval.bigVal()
+		case IntegerType, NumberType:
+			num, ok := dec.integralValue(tval)
+			if !ok {
+				return fail()
+			}
+			res = big.NewInt(num)
+		}
+		if res != nil {
+			rv.Addr().Interface().(*big.Int).Set(res)
+			return nil
+		}
+	}
+
+	// Other text-based types decode themselves from strings
+	if str, ok := val.(StringType); ok && typ.Kind() != reflect.String &&
+		reflect.PtrTo(typ).Implements(textUnmarshalerType) {
+		unm := rv.Addr().Interface().(encoding.TextUnmarshaler)
+		if err := unm.UnmarshalText([]byte(str)); err != nil {
+			return &ExportError{Path: path, Msg: err.Error()}
+		}
+		return nil
+	}
+
+	switch typ.Kind() {
+	case reflect.Bool:
+		bval, ok := val.(BooleanType)
+		if !ok {
+			return fail()
+		}
+		rv.SetBool(bool(bval))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Int64:
+		num, ok := dec.integralValue(val)
+		if !ok || rv.OverflowInt(num) {
+			return fail()
+		}
+		rv.SetInt(num)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64, reflect.Uintptr:
+		if bval, ok := val.(BigIntType); ok {
+			num, ok := bval.Uint64()
+			if !ok || rv.OverflowUint(num) {
+				return fail()
+			}
+			rv.SetUint(num)
+			break
+		}
+		num, ok := dec.integralValue(val)
+		if !ok || num < 0 || rv.OverflowUint(uint64(num)) {
+			return fail()
+		}
+		rv.SetUint(uint64(num))
+	case reflect.Float32, reflect.Float64:
+		switch nval := val.(type) {
+		case IntegerType:
+			rv.SetFloat(float64(nval))
+		case NumberType:
+			rv.SetFloat(float64(nval))
+		case BigIntType:
+			rv.SetFloat(nval.Float64())
+		default:
+			return fail()
+		}
+	case reflect.String:
+		sval, ok := val.(StringType)
+		if !ok {
+			return fail()
+		}
+		rv.SetString(string(sval))
+	case reflect.Slice, reflect.Array:
+		// Binary data is shared with byte slices, otherwise by element
+		if bytes := binaryBytes(val); bytes != nil &&
+			typ.Kind() == reflect.Slice && typ.Elem().Kind() == reflect.Uint8 {
+			rv.SetBytes(bytes)
+			return nil
+		}
+		if tarr, ok := val.(*TypedArrayType); ok {
+			val = tarr.toArray()
+		}
+		arr, ok := val.(*ArrayType)
+		if !ok {
+			return fail()
+		}
+		if typ.Kind() == reflect.Slice {
+			rv.Set(reflect.MakeSlice(typ, len(arr.Elements),
+				len(arr.Elements)))
+		} else if len(arr.Elements) > typ.Len() {
+			return &ExportError{Path: path,
+				Msg: fmt.Sprintf("array length %d exceeds %s",
+					len(arr.Elements), typ)}
+		}
+		for idx, elem := range arr.Elements {
+			err := dec.export(elem, rv.Index(idx), indexPath(path, idx))
+			if err != nil {
+				return err
+			}
+		}
+		if typ.Kind() == reflect.Array {
+			for idx := len(arr.Elements); idx < typ.Len(); idx++ {
+				rv.Index(idx).Set(reflect.Zero(typ.Elem()))
+			}
+		}
+	case reflect.Map:
+		if rv.IsNil() {
+			rv.Set(reflect.MakeMap(typ))
+		}
+		ok, err := exportProperties(val, true, path,
+			func(key string, elem DataType) error {
+				mkey, err := mapKey(rv, key)
+				if err != nil {
+					return &ExportError{Path: path, Msg: err.Error()}
+				}
+				mval := reflect.New(typ.Elem()).Elem()
+				err = dec.export(elem, mval, fieldPath(path, key))
+				if err != nil {
+					return err
+				}
+				rv.SetMapIndex(mkey, mval)
+				return nil
+			})
+		if !ok {
+			return fail()
+		}
+		return err
+	case reflect.Struct:
+		ok, err := exportProperties(val, false, path,
+			func(key string, elem DataType) error {
+				field, ok := structField(rv, key)
+				if !ok {
+					return nil
+				}
+				return dec.export(elem, field, fieldPath(path, key))
+			})
+		if !ok {
+			return fail()
+		}
+		return err
+	case reflect.Ptr:
+		if rv.IsNil() {
+			rv.Set(reflect.New(typ.Elem()))
+		}
+		return dec.export(val, rv.Elem(), path)
+	default:
+		return fail()
+	}
+	return nil
+}
+
+// Visit the named properties of the source for the export into a Go map or
+// struct: object properties, host object keys or (for a map target) the
+// primitive keys of a Map.  False if the value does not have properties.
+func exportProperties(val DataType, mapTarget bool, path string,
+	fn func(key string, elem DataType) error) (bool, error) {
+	switch tval := val.(type) {
+	case *ObjectType:
+		for key, elem := range tval.Properties {
+			if err := fn(key, elem); err != nil {
+				return true, err
+			}
+		}
+	case *MapType:
+		if !mapTarget {
+			return false, nil
+		}
+		for key, elem, pos := tval.Next(0); pos >= 0; key, elem,
+			pos = tval.Next(pos) {
+			if _, ok := key.(*SymbolType); ok || !IsPrimitive(key) {
+				return true, &ExportError{Path: path,
+					Msg: "cannot export Map key of type " + typeName(key)}
+			}
+			if err := fn(ToString(key), elem); err != nil {
+				return true, err
+			}
+		}
+	case HostObject:
+		for _, key := range tval.Keys() {
+			elem, err := tval.Get(key)
+			if err != nil {
+				return true, &ExportError{Path: fieldPath(path, key),
+					Msg: err.Error()}
+			}
+			if err := fn(key, elem); err != nil {
+				return true, err
+			}
+		}
+	default:
+		return false, nil
+	}
+	return true, nil
+}
+
+// Extract an integer value (int64 range), where non-integral numbers are
+// only truncated for lenient exports
+func (dec *exporter) integralValue(val DataType) (int64, bool) {
+	switch nval := val.(type) {
+	case IntegerType:
+		return int64(nval), true
+	case BigIntType:
+		return nval.Int64()
+	case NumberType:
+		num := float64(nval)
+		if dec.lenient {
+			num = math.Trunc(num)
+		}
+		if num != math.Trunc(num) || num < math.MinInt64 ||
+			num >= math.MaxInt64 {
+			return 0, false
+		}
+		return int64(num), true
+	}
+	return 0, false
+}
+
+// The (shared) bytes of the binary data value, nil if not byte data
+func binaryBytes(val DataType) []byte {
+	switch tval := val.(type) {
+	case *ArrayBufferType:
+		return tval.Bytes()
+	case *DataViewType:
+		return tval.Bytes()
+	case *TypedArrayType:
+		switch tval.Kind() {
+		case Uint8Array, Uint8ClampedArray:
+			return tval.Bytes()
+		}
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+/*
+ * Calling of arbitrary Go functions from scripts through reflection.
+ *
+ * Copyright (C) 2005-2026 J.M. Heisz.  All Rights Reserved.
+ * See the LICENSE file accompanying the distribution your rights to use
+ * this software.
+ */
+
+package types
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+var (
+	errorType   = reflect.TypeOf((*error)(nil)).Elem()
+	contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+	processType = reflect.TypeOf((*Process)(nil)).Elem()
+)
+
+// Create a native function that calls the Go function, converting the script
+// arguments to the Go parameter types (TypeError if not possible) and the
+// results back to script values.  Parameters of type context.Context and
+// types.Process are provided by the engine and do not consume arguments.
+// Variadic functions take the remaining arguments.  A non-nil error as the
+// last result is thrown as an Error and multiple (other) results are returned
+// as an array.  Panics if fn is not a function.
+func NewGoFunction(name string, fn any) *NativeFunction {
+	rv := reflect.ValueOf(fn)
+	if rv.Kind() != reflect.Func || rv.IsNil() {
+		panic(fmt.Sprintf("NewGoFunction: %T for %s is not a function", fn,
+			name))
+	}
+	return goFunction(name, rv)
+}
+
+// Wrap a reflected Go function (or method) as a callable native function
+func goFunction(name string, fn reflect.Value) *NativeFunction {
+	return &NativeFunction{
+		Name: name,
+		Fn: func(prc Process, args []DataType) (DataType, error) {
+			return callGoFunction(prc, name, fn, args)
+		},
+	}
+}
+
+// Provider for the Go context of the process, if supported
+type contextProcess interface {
+	Context() context.Context
+}
+
+// Call the Go function with the converted script arguments
+func callGoFunction(prc Process, name string, fn reflect.Value,
+	args []DataType) (DataType, error) {
+	fnType := fn.Type()
+	fixed := fnType.NumIn()
+	if fnType.IsVariadic() {
+		fixed--
+	}
+
+	in := make([]reflect.Value, 0, fnType.NumIn()+len(args))
+	argIdx := 0
+	for idx := 0; idx < fixed; idx++ {
+		paramType := fnType.In(idx)
+		switch paramType {
+		case contextType:
+			goCtx := context.Background()
+			if cp, ok := prc.(contextProcess); ok && cp.Context() != nil {
+				goCtx = cp.Context()
+			}
+			in = append(in, reflect.ValueOf(&goCtx).Elem())
+			continue
+		case processType:
+			in = append(in, reflect.ValueOf(&prc).Elem())
+			continue
+		}
+
+		conv, err := toGoValue(Arg(args, argIdx), paramType)
+		if err != nil {
+			return nil, ThrowError(prc, "TypeError",
+				fmt.Sprintf("Invalid argument %d to %s: %v", argIdx+1,
+					name, err))
+		}
+		in = append(in, conv)
+		argIdx++
+	}
+	if fnType.IsVariadic() {
+		elemType := fnType.In(fixed).Elem()
+		for ; argIdx < len(args); argIdx++ {
+			conv, err := toGoValue(args[argIdx], elemType)
+			if err != nil {
+				return nil, ThrowError(prc, "TypeError",
+					fmt.Sprintf("Invalid argument %d to %s: %v", argIdx+1,
+						name, err))
+			}
+			in = append(in, conv)
+		}
+	}
+
+	out := fn.Call(in)
+	if cnt := len(out); cnt > 0 && fnType.Out(cnt-1) == errorType {
+		if err, _ := out[cnt-1].Interface().(error); err != nil {
+			return nil, ThrowError(prc, "Error", err.Error())
+		}
+		out = out[:cnt-1]
+	}
+	switch len(out) {
+	case 0:
+		return Undefined, nil
+	case 1:
+		return wrapValue(out[0]), nil
+	}
+	arr := NewArray(len(out))
+	for idx, val := range out {
+		arr.Elements[idx] = wrapValue(val)
+	}
+	return arr, nil
+}
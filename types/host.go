@@ -0,0 +1,198 @@
+/*
+ * Interfaces for host (Go) types that behave like script objects.
+ *
+ * Copyright (C) 2005-2026 J.M. Heisz.  All Rights Reserved.
+ * See the LICENSE file accompanying the distribution your rights to use
+ * this software.
+ */
+
+package types
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+/*
+ * HostObject is implemented by Go types that provide their own properties to
+ * scripts (e.g. lazily loaded records), the engine dispatches all property
+ * access, assignment, deletion, 'in' checks and enumeration (for-in,
+ * Object.keys, JSON) to the object.  Errors are thrown into the script, where
+ * errors with an 'XxxError: ' prefix become the corresponding error type (or
+ * use Process.Throw() for a specific exception value).
+ */
+type HostObject interface {
+	DataType
+
+	// Retrieve the named property, Undefined if not present
+	Get(name string) (DataType, error)
+
+	// Assign the named property
+	Set(name string, val DataType) error
+
+	// Remove the named property, false if it cannot be deleted
+	Delete(name string) (bool, error)
+
+	// Determine if the property exists
+	Has(name string) bool
+
+	// Enumerable property names, in order
+	Keys() []string
+}
+
+// Optional interface for host objects that can be called as functions
+type HostCallable interface {
+	HostObject
+	Call(prc Process, args []DataType) (DataType, error)
+}
+
+// Optional interface for host objects to define the result of typeof (the
+// default is "object", or "function" for callable host objects)
+type HostTypeOf interface {
+	HostObject
+	TypeOf() string
+}
+
+// Optional interface for host objects with symbol-keyed members, such as a
+// Symbol.iterator method for iteration (for-of and spread), Undefined if the
+// symbol is not defined
+type HostSymbolObject interface {
+	HostObject
+	GetSymbol(sym *SymbolType) DataType
+}
+
+// Optional interface for host objects where the property access runs script
+// code (e.g. proxy traps), which must execute in the calling process and can
+// raise exceptions there
+type HostProcessObject interface {
+	HostObject
+	GetChecked(prc Process, name string) (DataType, error)
+	SetChecked(prc Process, name string, val DataType) error
+	HasChecked(prc Process, name string) (bool, error)
+	KeysChecked(prc Process) ([]string, error)
+}
+
+// Retrieve the named property of the host object in the calling process
+// (which may be nil for access outside of a script)
+func HostGet(prc Process, host HostObject, name string) (DataType, error) {
+	if checked, ok := host.(HostProcessObject); ok && prc != nil {
+		return checked.GetChecked(prc, name)
+	}
+	return host.Get(name)
+}
+
+// Assign the named property of the host object in the calling process
+func HostSet(prc Process, host HostObject, name string, val DataType) error {
+	if checked, ok := host.(HostProcessObject); ok && prc != nil {
+		return checked.SetChecked(prc, name, val)
+	}
+	return host.Set(name, val)
+}
+
+// Determine if the host object has the property, in the calling process
+func HostHas(prc Process, host HostObject, name string) (bool, error) {
+	if checked, ok := host.(HostProcessObject); ok && prc != nil {
+		return checked.HasChecked(prc, name)
+	}
+	return host.Has(name), nil
+}
+
+// Enumerable property names of the host object, in the calling process
+func HostKeys(prc Process, host HostObject) ([]string, error) {
+	if checked, ok := host.(HostProcessObject); ok && prc != nil {
+		return checked.KeysChecked(prc)
+	}
+	return host.Keys(), nil
+}
+
+// Determine the typeof result for the host object
+func HostTypeName(obj HostObject) string {
+	if typed, ok := obj.(HostTypeOf); ok {
+		return typed.TypeOf()
+	}
+	if _, ok := obj.(HostCallable); ok {
+		return "function"
+	}
+	return "object"
+}
+
+// Throw the error from a host object into the script (catchable), where an
+// 'XxxError: ...' prefix defines the error type (exceptions pass through)
+func HostError(prc Process, err error) error {
+	return prc.Throw(prc.Catch(err))
+}
+
+// Native equivalent of the value for JSON encoding, where host objects are
+// enumerated through their keys (unless they provide their own marshaling)
+// in the calling process (nil outside of a script)
+func jsonNative(prc Process, val DataType) (interface{}, error) {
+	switch tgt := val.(type) {
+	case *ArrayType:
+		result := make([]interface{}, len(tgt.Elements))
+		for idx, elem := range tgt.Elements {
+			if elem == nil {
+				continue
+			}
+			conv, err := jsonNative(prc, elem)
+			if err != nil {
+				return nil, err
+			}
+			result[idx] = conv
+		}
+		return result, nil
+	case *ObjectType:
+		result := make(map[string]interface{})
+		for key, elem := range tgt.Properties {
+			if elem == nil {
+				continue
+			}
+			conv, err := jsonNative(prc, elem)
+			if err != nil {
+				return nil, err
+			}
+			result[key] = conv
+		}
+		return result, nil
+	case HostObject:
+		if _, ok := tgt.(json.Marshaler); ok {
+			return tgt, nil
+		}
+		keys, err := HostKeys(prc, tgt)
+		if err != nil {
+			return nil, err
+		}
+		result := make(map[string]interface{})
+		for _, key := range keys {
+			elem, err := HostGet(prc, tgt, key)
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := elem.(UndefinedType); ok || elem == nil {
+				continue
+			}
+			conv, err := jsonNative(prc, elem)
+			if err != nil {
+				return nil, err
+			}
+			result[key] = conv
+		}
+		return result, nil
+	case *TypedArrayType:
+		// As per the specification, typed arrays are indexed objects
+		result := make(map[string]interface{})
+		for idx := 0; idx < tgt.Length(); idx++ {
+			conv, err := jsonNative(prc, tgt.Get(idx))
+			if err != nil {
+				return nil, err
+			}
+			result[ToString(IntegerType(idx))] = conv
+		}
+		return result, nil
+	case *ArrayBufferType, *DataViewType, *MapType:
+		return map[string]interface{}{}, nil
+	case BigIntType:
+		return nil, errors.New("TypeError: Do not know how to serialize a " +
+			"BigInt")
+	}
+	return val.Native(), nil
+}
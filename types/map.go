@@ -0,0 +1,145 @@
+/*
+ * Map datatype, the keyed collection with insertion ordering.
+ *
+ * Copyright (C) 2005-2026 J.M. Heisz.  All Rights Reserved.
+ * See the LICENSE file accompanying the distribution your rights to use
+ * this software.
+ */
+
+package types
+
+import "math"
+
+// MapType is the keyed collection of Section 24.1, where the keys are
+// compared by SameValueZero and the entries retain their insertion order.
+// Deleted entries are left as (nil key) tombstones so that iteration remains
+// live against the map as it changes.
+type MapType struct {
+	keys   []DataType
+	values []DataType
+	index  map[interface{}]int
+	size   int
+}
+
+// Distinct lookup key for primitives that are not directly comparable
+type sameValueLookup struct {
+	kind byte
+	str  string
+}
+
+// Determine the lookup key for the value (SameValueZero), where numbers are
+// unified (-0 is 0, all NaNs are the same) and objects are by identity
+func sameValueKey(key DataType) interface{} {
+	switch tkey := key.(type) {
+	case IntegerType:
+		return float64(tkey)
+	case NumberType:
+		num := float64(tkey)
+		if math.IsNaN(num) {
+			return sameValueLookup{kind: 'N'}
+		}
+		if num == 0 {
+			return float64(0)
+		}
+		return num
+	case BigIntType:
+		return sameValueLookup{kind: 'b', str: tkey.String()}
+	case Decimal:
+		return sameValueLookup{kind: 'd', str: tkey.String()}
+	}
+	return key
+}
+
+// Create a new (empty) map instance
+func NewMap() *MapType {
+	return &MapType{index: make(map[interface{}]int)}
+}
+
+// Native() for a map is the Go map of the native values, keyed by the native
+// primitive values (object keys are retained as is, by identity)
+func (m *MapType) Native() interface{} {
+	res := make(map[interface{}]interface{}, m.size)
+	for idx, key := range m.keys {
+		switch key.(type) {
+		case nil:
+			continue
+		case UndefinedType, NullType, BooleanType, IntegerType, NumberType,
+			StringType:
+			res[key.Native()] = m.values[idx].Native()
+		default:
+			res[key] = m.values[idx].Native()
+		}
+	}
+	return res
+}
+
+func (m *MapType) ToPrimitive(pref any) DataType {
+	return StringType("[object Map]")
+}
+
+// Retrieve the value for the key, false if not present
+func (m *MapType) Get(key DataType) (DataType, bool) {
+	if idx, ok := m.index[sameValueKey(key)]; ok {
+		return m.values[idx], true
+	}
+	return Undefined, false
+}
+
+// Add or replace the value for the key (replacement retains the order)
+func (m *MapType) Set(key DataType, val DataType) {
+	lookup := sameValueKey(key)
+	if idx, ok := m.index[lookup]; ok {
+		m.values[idx] = val
+		return
+	}
+	if num, ok := key.(NumberType); ok && num == 0 {
+		key = IntegerType(0)
+	}
+	m.index[lookup] = len(m.keys)
+	m.keys = append(m.keys, key)
+	m.values = append(m.values, val)
+	m.size++
+}
+
+func (m *MapType) Has(key DataType) bool {
+	_, ok := m.index[sameValueKey(key)]
+	return ok
+}
+
+// Remove the entry for the key, false if it was not present
+func (m *MapType) Delete(key DataType) bool {
+	lookup := sameValueKey(key)
+	idx, ok := m.index[lookup]
+	if !ok {
+		return false
+	}
+	delete(m.index, lookup)
+	m.keys[idx], m.values[idx] = nil, nil
+	m.size--
+	return true
+}
+
+// Remove all entries (active iterations continue with any later additions)
+func (m *MapType) Clear() {
+	for idx := range m.keys {
+		m.keys[idx], m.values[idx] = nil, nil
+	}
+	m.index = make(map[interface{}]int)
+	m.size = 0
+}
+
+// Number of entries in the map
+func (m *MapType) Size() int {
+	return m.size
+}
+
+// Find the next entry at or after the position (in insertion order) for live
+// iteration, returning the position following the entry or -1 at the end
+func (m *MapType) Next(pos int) (DataType, DataType, int) {
+	for ; pos < len(m.keys); pos++ {
+		if m.keys[pos] != nil {
+			return m.keys[pos], m.values[pos], pos + 1
+		}
+	}
+	return nil, nil, -1
+}
@@ -0,0 +1,76 @@
+/*
+ * Module namespace datatype, the exported bindings of an ES module.
+ *
+ * Copyright (C) 2005-2026 J.M. Heisz.  All Rights Reserved.
+ * See the LICENSE file accompanying the distribution your rights to use
+ * this software.
+ */
+
+package types
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Namespace objects (Section 10.4.6) reflect the live export bindings of the
+// module, so hold the references to the binding values and not copies (host
+// object for the engine)
+type ModuleNamespace struct {
+	names    []string
+	bindings map[string]*DataType
+}
+
+// Create the namespace for the set of export names/binding references
+func NewModuleNamespace(bindings map[string]*DataType) *ModuleNamespace {
+	names := make([]string, 0, len(bindings))
+	for name := range bindings {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return &ModuleNamespace{names: names, bindings: bindings}
+}
+
+// Native() for a namespace is a snapshot of the current export values
+func (ns *ModuleNamespace) Native() interface{} {
+	result := make(map[string]interface{})
+	for name, ref := range ns.bindings {
+		if *ref != nil {
+			result[name] = (*ref).Native()
+		}
+	}
+	return result
+}
+
+func (ns *ModuleNamespace) ToPrimitive(pref any) DataType {
+	return StringType("[object Module]")
+}
+
+// Retrieve the current value of the named export (undefined if not exported)
+func (ns *ModuleNamespace) Get(name string) (DataType, error) {
+	if ref, ok := ns.bindings[name]; ok && *ref != nil {
+		return *ref, nil
+	}
+	return Undefined, nil
+}
+
+// Namespaces are immutable (exports are only changed by the module)
+func (ns *ModuleNamespace) Set(name string, val DataType) error {
+	return fmt.Errorf("TypeError: Cannot assign to read only property "+
+		"'%s' of object '[object Module]'", name)
+}
+
+func (ns *ModuleNamespace) Delete(name string) (bool, error) {
+	return !ns.Has(name), nil
+}
+
+// Determine if the namespace has the named export
+func (ns *ModuleNamespace) Has(name string) bool {
+	_, ok := ns.bindings[name]
+	return ok
+}
+
+// The (sorted) list of export names
+func (ns *ModuleNamespace) Keys() []string {
+	return ns.names
+}
@@ -0,0 +1,54 @@
+/*
+ * Operator support for host value types (e.g. decimals, money, durations).
+ *
+ * Copyright (C) 2005-2026 J.M. Heisz.  All Rights Reserved.
+ * See the LICENSE file accompanying the distribution your rights to use
+ * this software.
+ */
+
+package types
+
+import "errors"
+
+// The binary arithmetic operators available to host operand types
+type Operator string
+
+const (
+	OpAdd      Operator = "+"
+	OpSubtract Operator = "-"
+	OpMultiply Operator = "*"
+	OpDivide   Operator = "/"
+	OpModulus  Operator = "%"
+	OpExponent Operator = "**"
+)
+
+// Returned by the operand methods to apply the default operator handling
+// (conversion through ToPrimitive/ToNumber) for the operands
+var ErrNoOperator = errors.New("operator not supported")
+
+/*
+ * OperandType is implemented by host DataTypes that define their own operator
+ * behaviour, consulted by the engine before the default primitive conversions
+ * of the operands.  For binary operators, the left operand is tried first and
+ * then the right operand (reversed).  Errors are thrown into the script as for
+ * host objects (e.g. 'TypeError: ...' prefix), other than ErrNoOperator.
+ */
+type OperandType interface {
+	DataType
+
+	// Apply the binary arithmetic operator with the other operand, where the
+	// value is the left operand (right operand if reversed)
+	BinaryOp(prc Process, op Operator, other DataType,
+		reversed bool) (DataType, error)
+
+	// Relative ordering (<, <=, >, >=) of the value against the other value,
+	// negative if less than, zero if equal and positive if greater than
+	Compare(prc Process, other DataType) (int, error)
+
+	// Loose equality (==, !=) against the other value, strict equality is
+	// always identity
+	Equals(prc Process, other DataType) (bool, error)
+
+	// Unary minus
+	Negate(prc Process) (DataType, error)
+}
@@ -0,0 +1,385 @@
+/*
+ * Promise datatype and the (host-drained) job queue for promise reactions.
+ *
+ * Copyright (C) 2005-2026 J.M. Heisz.  All Rights Reserved.
+ * See the LICENSE file accompanying the distribution your rights to use
+ * this software.
+ */
+
+package types
+
+import (
+	"context"
+	"sync"
+)
+
+// Note: per Section 9.5, promise reactions are queued as jobs (microtasks).
+// The engine never runs them on its own, it is up to the host application to
+// drain the queue (see ScriptContext).  This keeps execution on the goroutine
+// of the caller, the only thread-safe element is the queue itself.
+
+// Job is a unit of deferred script work, executed against a process
+type Job func(prc Process) error
+
+// JobQueue is the FIFO set of pending jobs, safe for cross-goroutine enqueue
+type JobQueue struct {
+	mutex sync.Mutex
+	jobs  []Job
+
+	// Number of outstanding host handles that will enqueue in the future
+	holds int
+
+	// Wakeup signal for waiters on the queue (buffered, non-blocking)
+	wakeup chan struct{}
+}
+
+// Create a new (empty) job queue instance
+func NewJobQueue() *JobQueue {
+	return &JobQueue{
+		wakeup: make(chan struct{}, 1),
+	}
+}
+
+// Append a job to the queue (any goroutine)
+func (jq *JobQueue) Enqueue(job Job) {
+	jq.mutex.Lock()
+	jq.jobs = append(jq.jobs, job)
+	jq.mutex.Unlock()
+	jq.signal()
+}
+
+// Remove and return the next job in the queue, nil if empty
+func (jq *JobQueue) Dequeue() Job {
+	jq.mutex.Lock()
+	defer jq.mutex.Unlock()
+	if len(jq.jobs) == 0 {
+		return nil
+	}
+	job := jq.jobs[0]
+	jq.jobs[0] = nil
+	jq.jobs = jq.jobs[1:]
+	return job
+}
+
+// Number of jobs currently waiting for execution
+func (jq *JobQueue) Len() int {
+	jq.mutex.Lock()
+	defer jq.mutex.Unlock()
+	return len(jq.jobs)
+}
+
+// Number of outstanding host handles (pending external settlement)
+func (jq *JobQueue) Holds() int {
+	jq.mutex.Lock()
+	defer jq.mutex.Unlock()
+	return jq.holds
+}
+
+// Mark/release an outstanding external element that will enqueue later
+func (jq *JobQueue) hold() {
+	jq.mutex.Lock()
+	jq.holds++
+	jq.mutex.Unlock()
+}
+func (jq *JobQueue) release() {
+	jq.mutex.Lock()
+	jq.holds--
+	jq.mutex.Unlock()
+	jq.signal()
+}
+
+// Non-blocking notification for anyone waiting on the queue
+func (jq *JobQueue) signal() {
+	select {
+	case jq.wakeup <- struct{}{}:
+	default:
+	}
+}
+
+// Wait blocks until there is a job to run, returning false if there are no
+// jobs and no outstanding holds (nothing will ever arrive) or context is done
+func (jq *JobQueue) Wait(ctx context.Context) (bool, error) {
+	for {
+		jq.mutex.Lock()
+		jobCount, holdCount := len(jq.jobs), jq.holds
+		jq.mutex.Unlock()
+		if jobCount > 0 {
+			return true, nil
+		}
+		if holdCount <= 0 {
+			return false, nil
+		}
+
+		select {
+		case <-jq.wakeup:
+		case <-ctx.Done():
+			return false, ctx.Err()
+		}
+	}
+}
+
+// Enumeration of the possible states of a promise (Section 27.2.6)
+type PromiseState int
+
+const (
+	PromisePending PromiseState = iota
+	PromiseFulfilled
+	PromiseRejected
+)
+
+// Reaction records queued against a pending promise, either a script handler
+// pair with a derived promise (then) or a native callback (await, combiners)
+type promiseReaction struct {
+	onFulfilled DataType
+	onRejected  DataType
+	derived     *PromiseType
+	native      func(prc Process, val DataType, rejected bool) error
+}
+
+type PromiseType struct {
+	state     PromiseState
+	result    DataType
+	reactions []promiseReaction
+}
+
+// Create a new pending promise instance
+func NewPromise() *PromiseType {
+	return &PromiseType{
+		state:  PromisePending,
+		result: Undefined,
+	}
+}
+
+// Native() for a promise is the promise itself (to inspect state/result)
+func (prm *PromiseType) Native() interface{} {
+	return prm
+}
+
+func (prm *PromiseType) ToPrimitive(pref any) DataType {
+	return StringType("[object Promise]")
+}
+
+// Accessors for the current state and settled value/reason of the promise
+func (prm *PromiseType) State() PromiseState {
+	return prm.state
+}
+func (prm *PromiseType) Result() DataType {
+	return prm.result
+}
+
+// Settle the promise with the given value, following promise/thenable values
+// as per the resolve function (Section 27.2.1.3.2)
+func (prm *PromiseType) Resolve(prc Process, val DataType) {
+	if prm.state != PromisePending {
+		return
+	}
+	if val == DataType(prm) {
+		prm.Reject(prc, NewError("TypeError",
+			"Chaining cycle detected for promise"))
+		return
+	}
+
+	// Promises (and thenables) are followed in a distinct job
+	if other, ok := val.(*PromiseType); ok {
+		prc.Jobs().Enqueue(func(prc Process) error {
+			other.addReaction(prc, promiseReaction{
+				native: func(prc Process, res DataType, rejected bool) error {
+					if rejected {
+						prm.Reject(prc, res)
+					} else {
+						prm.Resolve(prc, res)
+					}
+					return nil
+				},
+			})
+			return nil
+		})
+		return
+	}
+	if obj, ok := val.(*ObjectType); ok {
+		if then, ok := obj.Get("then").(FunctionType); ok {
+			prc.Jobs().Enqueue(func(prc Process) error {
+				resolve, reject := prm.ResolvingFunctions()
+				_, err := CallMethod(prc, then, obj,
+					[]DataType{resolve, reject})
+				if err != nil {
+					reject.Call(prc, []DataType{prc.Catch(err)})
+				}
+				return nil
+			})
+			return
+		}
+	}
+
+	prm.settle(prc, PromiseFulfilled, val)
+}
+
+// Reject the promise with the given reason (if still pending)
+func (prm *PromiseType) Reject(prc Process, reason DataType) {
+	if prm.state != PromisePending {
+		return
+	}
+	prm.settle(prc, PromiseRejected, reason)
+}
+
+// Common method to transition state and trigger the pending reactions
+func (prm *PromiseType) settle(prc Process, state PromiseState, val DataType) {
+	prm.state = state
+	prm.result = val
+	reactions := prm.reactions
+	prm.reactions = nil
+	for _, reaction := range reactions {
+		prm.queueReaction(prc, reaction)
+	}
+}
+
+// Register a reaction, queueing it immediately if already settled
+func (prm *PromiseType) addReaction(prc Process, reaction promiseReaction) {
+	if prm.state == PromisePending {
+		prm.reactions = append(prm.reactions, reaction)
+	} else {
+		prm.queueReaction(prc, reaction)
+	}
+}
+
+// Queue the job to process a reaction against the settled outcome
+func (prm *PromiseType) queueReaction(prc Process, reaction promiseReaction) {
+	val, rejected := prm.result, prm.state == PromiseRejected
+	prc.Jobs().Enqueue(func(prc Process) error {
+		if reaction.native != nil {
+			return reaction.native(prc, val, rejected)
+		}
+
+		// Select the handler, missing handlers pass the outcome through
+		handler := reaction.onFulfilled
+		if rejected {
+			handler = reaction.onRejected
+		}
+		fn, ok := handler.(FunctionType)
+		if !ok {
+			if reaction.derived != nil {
+				if rejected {
+					reaction.derived.Reject(prc, val)
+				} else {
+					reaction.derived.Resolve(prc, val)
+				}
+			}
+			return nil
+		}
+
+		res, err := fn.Call(prc, []DataType{val})
+		if reaction.derived != nil {
+			if err != nil {
+				reaction.derived.Reject(prc, prc.Catch(err))
+			} else {
+				reaction.derived.Resolve(prc, res)
+			}
+		}
+		return nil
+	})
+}
+
+// Attach the fulfillment/rejection handlers, returning the derived promise
+func (prm *PromiseType) Then(prc Process, onFulfilled DataType,
+	onRejected DataType) *PromiseType {
+	derived := NewPromise()
+	prm.addReaction(prc, promiseReaction{
+		onFulfilled: onFulfilled,
+		onRejected:  onRejected,
+		derived:     derived,
+	})
+	return derived
+}
+
+// Attach a native callback for the settled outcome (no derived promise)
+func (prm *PromiseType) OnSettled(prc Process,
+	fn func(prc Process, val DataType, rejected bool) error) {
+	prm.addReaction(prc, promiseReaction{native: fn})
+}
+
+// Generate the resolve/reject function pair for the promise, sharing the
+// 'already resolved' state per Section 27.2.1.3
+func (prm *PromiseType) ResolvingFunctions() (*NativeFunction,
+	*NativeFunction) {
+	resolved := false
+	resolve := &NativeFunction{
+		Name: "resolve",
+		Fn: func(prc Process, args []DataType) (DataType, error) {
+			if !resolved {
+				resolved = true
+				prm.Resolve(prc, Arg(args, 0))
+			}
+			return Undefined, nil
+		},
+	}
+	reject := &NativeFunction{
+		Name: "reject",
+		Fn: func(prc Process, args []DataType) (DataType, error) {
+			if !resolved {
+				resolved = true
+				prm.Reject(prc, Arg(args, 0))
+			}
+			return Undefined, nil
+		},
+	}
+	return resolve, reject
+}
+
+// Convert the value to a promise, returning promises unchanged
+func PromiseResolve(prc Process, val DataType) *PromiseType {
+	if prm, ok := val.(*PromiseType); ok {
+		return prm
+	}
+	prm := NewPromise()
+	prm.Resolve(prc, val)
+	return prm
+}
+
+// PromiseHandle allows the host to settle a pending promise from any goroutine,
+// the settlement is queued as a job for the next drain of the process queue
+type PromiseHandle struct {
+	promise *PromiseType
+	jobs    *JobQueue
+	once    sync.Once
+}
+
+// Create a pending promise (to return to the script) and the associated
+// handle for later (asynchronous) resolution by the host
+func NewPendingPromise(prc Process) (*PromiseType, *PromiseHandle) {
+	prm := NewPromise()
+	hndl := &PromiseHandle{
+		promise: prm,
+		jobs:    prc.Jobs(),
+	}
+	hndl.jobs.hold()
+	return prm, hndl
+}
+
+// Fulfill the associated promise with the value (first settle call wins)
+func (hndl *PromiseHandle) Resolve(val DataType) {
+	hndl.settle(val, false)
+}
+
+// Reject the associated promise with the reason (first settle call wins)
+func (hndl *PromiseHandle) Reject(reason DataType) {
+	hndl.settle(reason, true)
+}
+
+func (hndl *PromiseHandle) settle(val DataType, rejected bool) {
+	hndl.once.Do(func() {
+		hndl.jobs.Enqueue(func(prc Process) error {
+			if rejected {
+				hndl.promise.Reject(prc, val)
+			} else {
+				hndl.promise.Resolve(prc, val)
+			}
+			return nil
+		})
+		hndl.jobs.release()
+	})
+}
+
+// Retrieve the promise instance associated to the handle
+func (hndl *PromiseHandle) Promise() *PromiseType {
+	return hndl.promise
+}
@@ -0,0 +1,137 @@
+/*
+ * Symbol datatype, including the global registry and well-known symbols.
+ *
+ * Copyright (C) 2005-2026 J.M. Heisz.  All Rights Reserved.
+ * See the LICENSE file accompanying the distribution your rights to use
+ * this software.
+ */
+
+package types
+
+import "sync"
+
+// Symbols are unique by identity, so always referenced by pointer
+type SymbolType struct {
+	description string
+	hasDesc     bool
+}
+
+// Create a new (unique) symbol, with optional description
+func NewSymbol(description string) *SymbolType {
+	return &SymbolType{description: description, hasDesc: true}
+}
+
+// Variant of the above for Symbol() without a description (undefined)
+func NewAnonymousSymbol() *SymbolType {
+	return &SymbolType{}
+}
+
+// Native() for a symbol is the instance (there is no Go equivalent)
+func (sym *SymbolType) Native() interface{} {
+	return sym
+}
+
+// Symbols are primitive values
+func (sym *SymbolType) ToPrimitive(pref any) DataType {
+	return sym
+}
+
+// Symbols are omitted from JSON (null within arrays)
+func (sym *SymbolType) MarshalJSON() ([]byte, error) {
+	return []byte("null"), nil
+}
+
+// Accessor for the description, undefined if not provided
+func (sym *SymbolType) Description() DataType {
+	if !sym.hasDesc {
+		return Undefined
+	}
+	return StringType(sym.description)
+}
+
+// Descriptive string form, as per Symbol.prototype.toString()
+func (sym *SymbolType) String() string {
+	return "Symbol(" + sym.description + ")"
+}
+
+// The well-known symbols (Section 6.1.5.1), the associated members of the
+// native types are resolved using the spec notation (e.g. "@@iterator")
+var (
+	SymbolAsyncIterator = newWellKnownSymbol("asyncIterator")
+	SymbolHasInstance   = newWellKnownSymbol("hasInstance")
+	SymbolIterator      = newWellKnownSymbol("iterator")
+	SymbolToPrimitive   = newWellKnownSymbol("toPrimitive")
+	SymbolToStringTag   = newWellKnownSymbol("toStringTag")
+)
+
+var wellKnownSymbols = map[*SymbolType]string{}
+
+func newWellKnownSymbol(name string) *SymbolType {
+	sym := NewSymbol("Symbol." + name)
+	wellKnownSymbols[sym] = name
+	return sym
+}
+
+// Retrieve the (spec) member name for a well-known symbol, empty if not one
+func WellKnownName(sym *SymbolType) string {
+	if name, ok := wellKnownSymbols[sym]; ok {
+		return "@@" + name
+	}
+	return ""
+}
+
+// Global symbol registry for Symbol.for/keyFor (shared by all contexts)
+var symbolRegistry = struct {
+	sync.Mutex
+	byKey map[string]*SymbolType
+}{byKey: make(map[string]*SymbolType)}
+
+// Retrieve or create the registered symbol for the given key
+func SymbolFor(key string) *SymbolType {
+	symbolRegistry.Lock()
+	defer symbolRegistry.Unlock()
+	if sym, ok := symbolRegistry.byKey[key]; ok {
+		return sym
+	}
+	sym := NewSymbol(key)
+	symbolRegistry.byKey[key] = sym
+	return sym
+}
+
+// Determine the registry key for the symbol, false if not registered
+func SymbolKeyFor(sym *SymbolType) (string, bool) {
+	symbolRegistry.Lock()
+	defer symbolRegistry.Unlock()
+	if reg, ok := symbolRegistry.byKey[sym.description]; ok && reg == sym {
+		return sym.description, true
+	}
+	return "", false
+}
+
+// Create an iterator object (Section 27.1.1.2) from the native sequence
+// function, which returns the next value or false when exhausted.  The object
+// is itself iterable (returns itself for Symbol.iterator).
+func NewIterator(next func() (DataType, bool)) *ObjectType {
+	iter := NewObject()
+	iter.Set("next", &NativeFunction{
+		Name: "next",
+		Fn: func(prc Process, args []DataType) (DataType, error) {
+			res := NewObject()
+			if val, ok := next(); ok {
+				res.Set("value", val)
+				res.Set("done", BooleanType(false))
+			} else {
+				res.Set("value", Undefined)
+				res.Set("done", BooleanType(true))
+			}
+			return res, nil
+		},
+	})
+	iter.SetSymbol(SymbolIterator, &NativeFunction{
+		Name: "[Symbol.iterator]",
+		Fn: func(prc Process, args []DataType) (DataType, error) {
+			return iter, nil
+		},
+	})
+	return iter
+}
@@ -80,6 +80,9 @@ func (sval StringType) ToPrimitive(pref any) DataType {
 
 type ArrayType struct {
 	Elements []DataType
+
+	// Frozen arrays ignore modifications (Object.freeze)
+	Frozen bool
 }
 
 // Native() is found in the conversion elements in util.go
@@ -96,6 +99,10 @@ func (arr *ArrayType) Get(index int) DataType {
 	return arr.Elements[index]
 }
 func (arr *ArrayType) Set(index int, val DataType) {
+	if arr.Frozen {
+		return
+	}
+
 	// Automatically extend array if index falls outside of range
 	for len(arr.Elements) <= index {
 		arr.Elements = append(arr.Elements, Undefined)
@@ -117,34 +124,98 @@ func NewArray(size int) *ArrayType {
 
 type ObjectType struct {
 	Properties map[string]DataType
+
+	// Symbol-keyed properties (lazily allocated), excluded from enumeration
+	Symbols map[*SymbolType]DataType
+
+	// Prototype for inherited properties (from the constructor), nil if none
+	Proto *ObjectType
+
+	// Frozen objects ignore property additions, changes and deletions
+	Frozen bool
 }
 
 // Native() is found in the conversion elements in util.go
 
 func (obj *ObjectType) ToPrimitive(pref any) DataType {
+	// Per Section 20.1.3.6, the tag can be overridden by Symbol.toStringTag
+	if tag, ok := obj.GetSymbol(SymbolToStringTag).(StringType); ok {
+		return StringType("[object " + string(tag) + "]")
+	}
 	return StringType("[object Object]")
 }
 
-// Utility methods to actually work with the object contents
+// Utility methods to actually work with the object contents (where Get
+// includes inherited properties but Has is only for the object itself)
 func (obj *ObjectType) Get(propName string) DataType {
-	if val, ok := obj.Properties[propName]; ok {
-		return val
+	for ; obj != nil; obj = obj.Proto {
+		if val, ok := obj.Properties[propName]; ok {
+			return val
+		}
 	}
 	return Undefined
 }
 func (obj *ObjectType) Set(propName string, val DataType) {
-	obj.Properties[propName] = val
+	if !obj.Frozen {
+		obj.Properties[propName] = val
+	}
 }
 func (obj *ObjectType) Has(propName string) bool {
 	_, ok := obj.Properties[propName]
 	return ok
 }
+
+// And the equivalents for the symbol-keyed properties
+func (obj *ObjectType) GetSymbol(sym *SymbolType) DataType {
+	for ; obj != nil; obj = obj.Proto {
+		if val, ok := obj.Symbols[sym]; ok {
+			return val
+		}
+	}
+	return Undefined
+}
+func (obj *ObjectType) SetSymbol(sym *SymbolType, val DataType) {
+	if obj.Frozen {
+		return
+	}
+	if obj.Symbols == nil {
+		obj.Symbols = make(map[*SymbolType]DataType)
+	}
+	obj.Symbols[sym] = val
+}
+func (obj *ObjectType) HasSymbol(sym *SymbolType) bool {
+	_, ok := obj.Symbols[sym]
+	return ok
+}
+func (obj *ObjectType) DeleteSymbol(sym *SymbolType) {
+	if !obj.Frozen {
+		delete(obj.Symbols, sym)
+	}
+}
+
+// Determine if the prototype is in the prototype chain of the object
+func (obj *ObjectType) InheritsFrom(proto *ObjectType) bool {
+	for cur := obj.Proto; cur != nil; cur = cur.Proto {
+		if cur == proto {
+			return true
+		}
+	}
+	return false
+}
 func NewObject() *ObjectType {
 	return &ObjectType{
 		Properties: make(map[string]DataType),
 	}
 }
 
+// Error instances are (for now) just objects with the name and message
+func NewError(name string, message string) *ObjectType {
+	obj := NewObject()
+	obj.Set("name", StringType(name))
+	obj.Set("message", StringType(message))
+	return obj
+}
+
 // Expose a set of process elements for use in native callers, passed everywhere
 type Process interface {
 	// Accessors to define and retrieve global values (from context)
@@ -153,6 +224,44 @@ type Process interface {
 
 	// Sets up an exception 'traceback', returns error to return to process
 	Throw(exception DataType) error
+
+	// Converse of the above, obtain (and clear) the exception for the error
+	Catch(err error) DataType
+
+	// Queue of pending (promise) jobs, drained by the host application
+	Jobs() *JobQueue
+}
+
+// Optional process interface reporting the native constructor currently
+// being invoked through new (nil for a plain function call)
+type ConstructingProcess interface {
+	Constructing() *NativeConstructor
+}
+
+// Determine if the named native constructor is being invoked through new,
+// for natives that are only (or never) constructible
+func IsConstructing(prc Process, name string) bool {
+	cprc, ok := prc.(ConstructingProcess)
+	if !ok {
+		return false
+	}
+	ctor := cprc.Constructing()
+	return ctor != nil && ctor.Name == name
+}
+
+// Options that alter the parse rules for scripts (house style restrictions),
+// where the zero value is the standard language
+type ParseOptions struct {
+	// Require explicit semicolons (no automatic semicolon insertion)
+	DisableASI bool
+
+	// Treat all code as strict mode code (as if "use strict" was declared)
+	Strict bool
+}
+
+// Convenience method to throw a (catchable) error instance of the given type
+func ThrowError(prc Process, name string, message string) error {
+	return prc.Throw(NewError(name, message))
 }
 
 // In ECMAScript functions are first-class, so we have types for them too
@@ -163,9 +272,29 @@ type FunctionType interface {
 	Call(prc Process, args []DataType) (DataType, error)
 }
 
+// Call the function with an explicit this value, where the function supports it
+func CallMethod(prc Process, fn FunctionType, thisVal DataType,
+	args []DataType) (DataType, error) {
+	if mfn, ok := fn.(interface {
+		CallWithThis(prc Process, thisVal DataType,
+			args []DataType) (DataType, error)
+	}); ok {
+		return mfn.CallWithThis(prc, thisVal, args)
+	}
+	return fn.Call(prc, args)
+}
+
 // NativeFn is the signature for Go functions callable from scripts
 type NativeFn func(prc Process, args []DataType) (DataType, error)
 
+// Utility to safely extract a native argument, undefined if not provided
+func Arg(args []DataType, idx int) DataType {
+	if idx >= 0 && idx < len(args) && args[idx] != nil {
+		return args[idx]
+	}
+	return Undefined
+}
+
 // And this is the native function datatype wrapper (implements FunctionType)
 type NativeFunction struct {
 	Name string
@@ -226,8 +355,18 @@ type NativeConstructor struct {
 	// Native method to dynamically resolve properties and methods for the type
 	InstanceMembers MemberResolver
 
-	// Global/static methods defined against the type name
+	// Global/static methods defined against the type name, where a
+	// "@@hasInstance" method overrides the instanceof check (as for
+	// Symbol.hasInstance)
 	StaticMethods map[string]DataType
+
+	// Brand check for instanceof, true if the value is an instance of the
+	// type (no instances if nil)
+	IsInstance func(val DataType) bool
+
+	// The typeof result for instances of (non-builtin) types, "object" if
+	// not specified (requires IsInstance)
+	TypeOf string
 }
 
 func (nc *NativeConstructor) Native() interface{} {
@@ -0,0 +1,278 @@
+/*
+ * Support for the UTF-16 code unit semantics of strings.
+ *
+ * Copyright (C) 2005-2026 J.M. Heisz.  All Rights Reserved.
+ * See the LICENSE file accompanying the distribution your rights to use
+ * this software.
+ */
+
+package types
+
+import (
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// Script strings are stored as Go (UTF-8) strings, but are indexed by UTF-16
+// code unit as per the specification.  Lone surrogates (which cannot be
+// represented in UTF-8) are encoded as their (generalized WTF-8) three byte
+// sequences, so no script string content is lost.  Invalid UTF-8 bytes from
+// the host are treated as U+FFFD replacement characters.
+
+// Decode the character at the byte offset, including surrogate sequences
+func decodeChar(str string, offset int) (rune, int) {
+	if str[offset] < utf8.RuneSelf {
+		return rune(str[offset]), 1
+	}
+	if str[offset] == 0xED && offset+2 < len(str) &&
+		str[offset+1] >= 0xA0 && str[offset+1] <= 0xBF &&
+		str[offset+2] >= 0x80 && str[offset+2] <= 0xBF {
+		return 0xD000 | rune(str[offset+1]&0x3F)<<6 |
+			rune(str[offset+2]&0x3F), 3
+	}
+	return utf8.DecodeRuneInString(str[offset:])
+}
+
+// Append the (WTF-8) encoding of the lone surrogate code unit
+func appendSurrogate(buff []byte, unit uint16) []byte {
+	return append(buff, byte(0xE0|unit>>12), byte(0x80|(unit>>6)&0x3F),
+		byte(0x80|unit&0x3F))
+}
+
+// Determine if the string has only single byte characters, where byte and
+// code unit indices are identical
+func isASCII(str string) bool {
+	for idx := 0; idx < len(str); idx++ {
+		if str[idx] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+// Convert the string to the equivalent sequence of UTF-16 code units
+func UTF16(str string) []uint16 {
+	res := make([]uint16, 0, len(str))
+	for offset := 0; offset < len(str); {
+		ch, size := decodeChar(str, offset)
+		if ch >= 0x10000 {
+			high, low := utf16.EncodeRune(ch)
+			res = append(res, uint16(high), uint16(low))
+		} else {
+			res = append(res, uint16(ch))
+		}
+		offset += size
+	}
+	return res
+}
+
+// Convert the UTF-16 code units back to a string, where surrogate pairs are
+// combined and lone surrogates are retained
+func FromUTF16(units []uint16) string {
+	buff := make([]byte, 0, len(units))
+	for idx := 0; idx < len(units); idx++ {
+		unit := units[idx]
+		switch {
+		case unit < utf8.RuneSelf:
+			buff = append(buff, byte(unit))
+		case utf16.IsSurrogate(rune(unit)):
+			if unit < 0xDC00 && idx+1 < len(units) &&
+				units[idx+1] >= 0xDC00 && units[idx+1] <= 0xDFFF {
+				buff = utf8.AppendRune(buff,
+					utf16.DecodeRune(rune(unit), rune(units[idx+1])))
+				idx++
+			} else {
+				buff = appendSurrogate(buff, unit)
+			}
+		default:
+			buff = utf8.AppendRune(buff, rune(unit))
+		}
+	}
+	return string(buff)
+}
+
+// Length of the string in UTF-16 code units (the script length property)
+func UTF16Length(str string) int {
+	length := 0
+	for offset := 0; offset < len(str); {
+		ch, size := decodeChar(str, offset)
+		if ch >= 0x10000 {
+			length += 2
+		} else {
+			length++
+		}
+		offset += size
+	}
+	return length
+}
+
+// Extract the UTF-16 code unit at the index, false if out of range
+func CodeUnitAt(str string, idx int) (uint16, bool) {
+	if idx < 0 {
+		return 0, false
+	}
+	if isASCII(str) {
+		if idx >= len(str) {
+			return 0, false
+		}
+		return uint16(str[idx]), true
+	}
+	return unitAt(UTF16(str), idx)
+}
+
+// Common range check for the code unit index
+func unitAt(units []uint16, idx int) (uint16, bool) {
+	if idx < 0 || idx >= len(units) {
+		return 0, false
+	}
+	return units[idx], true
+}
+
+// Code unit details of the (longer) strings most recently accessed by index
+// or length, as a script loop over charCodeAt would otherwise rescan the
+// string on every iteration.  A cache belongs to a single process (it is not
+// synchronized) and its strings are released with the process.  A nil cache
+// simply scans the strings on each access.
+type UnitCache struct {
+	entries [4]*unitInfo
+	next    int
+}
+
+// Units are only decoded for non-ASCII content
+type unitInfo struct {
+	str    string
+	ascii  bool
+	length int
+	units  []uint16
+}
+
+// Strings below this size are simply scanned on each access
+const unitCacheMinLength = 64
+
+// Optional process interface providing the code unit cache of the process
+type UnitCacheProcess interface {
+	UnitCache() *UnitCache
+}
+
+// Obtain the code unit cache of the process (nil if not supported)
+func ProcessUnitCache(prc Process) *UnitCache {
+	if cprc, ok := prc.(UnitCacheProcess); ok {
+		return cprc.UnitCache()
+	}
+	return nil
+}
+
+// Obtain the (cached) code unit details for the string, nil if not cached
+func (cache *UnitCache) lookup(str string) *unitInfo {
+	if cache == nil || len(str) < unitCacheMinLength {
+		return nil
+	}
+	for _, info := range cache.entries {
+		// Comparison of the same string instance does not scan the content
+		if info != nil && info.str == str {
+			return info
+		}
+	}
+
+	info := &unitInfo{str: str, ascii: isASCII(str)}
+	if info.ascii {
+		info.length = len(str)
+	} else {
+		info.units = UTF16(str)
+		info.length = len(info.units)
+	}
+	cache.entries[cache.next] = info
+	cache.next = (cache.next + 1) % len(cache.entries)
+	return info
+}
+
+// Length of the string in UTF-16 code units, through the cache
+func (cache *UnitCache) UTF16Length(str string) int {
+	if info := cache.lookup(str); info != nil {
+		return info.length
+	}
+	return UTF16Length(str)
+}
+
+// Extract the UTF-16 code unit at the index through the cache, false if out
+// of range
+func (cache *UnitCache) CodeUnitAt(str string, idx int) (uint16, bool) {
+	info := cache.lookup(str)
+	switch {
+	case info == nil:
+		return CodeUnitAt(str, idx)
+	case !info.ascii:
+		return unitAt(info.units, idx)
+	case idx < 0 || idx >= len(str):
+		return 0, false
+	}
+	return uint16(str[idx]), true
+}
+
+// Extract the code point (as a string) starting at the byte offset, along
+// with the number of bytes consumed, for iteration by code point
+func NextCodePoint(str string, offset int) (string, int) {
+	ch, size := decodeChar(str, offset)
+	if ch >= 0xD800 && ch < 0xDC00 && offset+size < len(str) {
+		if low, lsize := decodeChar(str, offset+size); low >= 0xDC00 &&
+			low <= 0xDFFF {
+			return string(utf16.DecodeRune(ch, low)), size + lsize
+		}
+	}
+	if utf16.IsSurrogate(ch) {
+		return str[offset : offset+size], size
+	}
+	return string(ch), size
+}
+
+// Concatenate the strings, joining a surrogate pair split across the two
+// (lone surrogates from separate escape sequences or slices)
+func ConcatStrings(left, right string) string {
+	if len(left) >= 3 && len(right) >= 3 {
+		high, hsize := decodeChar(left, len(left)-3)
+		low, _ := decodeChar(right, 0)
+		if hsize == 3 && high >= 0xD800 && high < 0xDC00 &&
+			low >= 0xDC00 && low <= 0xDFFF {
+			return left[:len(left)-3] +
+				string(utf16.DecodeRune(high, low)) + right[3:]
+		}
+	}
+	return left + right
+}
+
+// Determine if the string has no lone surrogates (String isWellFormed)
+func IsWellFormed(str string) bool {
+	if isASCII(str) {
+		return true
+	}
+	units := UTF16(str)
+	for idx := 0; idx < len(units); idx++ {
+		if utf16.IsSurrogate(rune(units[idx])) {
+			if units[idx] >= 0xDC00 || idx+1 >= len(units) ||
+				units[idx+1] < 0xDC00 || units[idx+1] > 0xDFFF {
+				return false
+			}
+			idx++
+		}
+	}
+	return true
+}
+
+// Replace any lone surrogates with U+FFFD (String toWellFormed), the result
+// is always valid UTF-8
+func ToWellFormed(str string) string {
+	if IsWellFormed(str) && utf8.ValidString(str) {
+		return str
+	}
+	var sb strings.Builder
+	for offset := 0; offset < len(str); {
+		cp, size := NextCodePoint(str, offset)
+		if ch, _ := decodeChar(cp, 0); utf16.IsSurrogate(ch) {
+			cp = string(utf8.RuneError)
+		}
+		sb.WriteString(cp)
+		offset += size
+	}
+	return sb.String()
+}
@@ -11,6 +11,7 @@ package types
 import (
 	"encoding/json"
 	"math"
+	"math/big"
 	"reflect"
 	"strconv"
 	"strings"
@@ -18,7 +19,7 @@ import (
 
 // Determine the 'truthiness' of the data value according to specification
 func IsTruthy(val DataType) bool {
-	switch val.(type) {
+	switch v := val.(type) {
 	case UndefinedType, NullType:
 		return false
 	case BooleanType:
@@ -28,6 +29,8 @@ func IsTruthy(val DataType) bool {
 	case NumberType:
 		n := val.Native().(float64)
 		return n != 0 && n == n // NaN check
+	case BigIntType:
+		return v.Sign() != 0
 	case StringType:
 		return len(val.Native().(string)) > 0
 	}
@@ -54,23 +57,96 @@ func ToString(val DataType) string {
 	case IntegerType:
 		return strconv.FormatInt(int64(v), 10)
 	case NumberType:
-		return strconv.FormatFloat(float64(v), 'f', -1, 64)
+		return formatNumber(float64(v))
+	case BigIntType:
+		return v.String()
 	case StringType:
 		return string(v)
 	case *ArrayType:
 		// Arrays stringify as comma-separated values
-		parts := make([]string, len(v.Elements))
-		for idx, elem := range v.Elements {
-			parts[idx] = ToString(elem)
-		}
-		return strings.Join(parts, ",")
+		return JoinElements(v.Elements, ",")
 	case *ObjectType:
-		return "[object Object]"
+		return string(v.ToPrimitive(nil).(StringType))
+	case *SymbolType:
+		// Only valid for explicit conversion, see ToStringChecked
+		return v.String()
 	default:
 		return val.ToPrimitive(nil).Native().(string)
 	}
 }
 
+// Join the string forms of the array elements (Section 23.1.3.18), where
+// undefined and null elements (and holes) are empty strings
+func JoinElements(elems []DataType, sep string) string {
+	parts := make([]string, len(elems))
+	for idx, elem := range elems {
+		switch elem.(type) {
+		case nil, UndefinedType, NullType:
+			continue
+		}
+		parts[idx] = ToString(elem)
+	}
+	return strings.Join(parts, sep)
+}
+
+// Checked form of JoinElements, where the elements are converted through
+// ToStringChecked (honouring Symbol.toPrimitive and reporting its errors)
+func JoinElementsChecked(prc Process, elems []DataType,
+	sep string) (string, error) {
+	parts := make([]string, len(elems))
+	for idx, elem := range elems {
+		switch elem.(type) {
+		case nil, UndefinedType, NullType:
+			continue
+		}
+		str, err := ToStringChecked(prc, elem)
+		if err != nil {
+			return "", err
+		}
+		parts[idx] = str
+	}
+	return strings.Join(parts, sep), nil
+}
+
+// Format the number per the specification (Section 6.1.6.1.20), using the
+// shortest round-trip digits and exponential notation outside of 1e-7 to 1e21
+func formatNumber(num float64) string {
+	switch {
+	case math.IsNaN(num):
+		return "NaN"
+	case num == 0:
+		return "0"
+	case num < 0:
+		return "-" + formatNumber(-num)
+	case math.IsInf(num, 1):
+		return "Infinity"
+	}
+
+	// Shortest digits in d.ddde+x form, n is the decimal point position
+	digits := strconv.FormatFloat(num, 'e', -1, 64)
+	epos := strings.IndexByte(digits, 'e')
+	exp, _ := strconv.Atoi(digits[epos+1:])
+	digits = strings.Replace(digits[:epos], ".", "", 1)
+	k, n := len(digits), exp+1
+
+	switch {
+	case k <= n && n <= 21:
+		return digits + strings.Repeat("0", n-k)
+	case 0 < n && n <= 21:
+		return digits[:n] + "." + digits[n:]
+	case -6 < n && n <= 0:
+		return "0." + strings.Repeat("0", -n) + digits
+	}
+	res := digits[:1]
+	if k > 1 {
+		res += "." + digits[1:]
+	}
+	if n-1 < 0 {
+		return res + "e-" + strconv.Itoa(1-n)
+	}
+	return res + "e+" + strconv.Itoa(n-1)
+}
+
 // Convert a data value to an integer (zero if invalid)
 func ToInt(val DataType) int {
 	num := ToNumber(val)
@@ -99,6 +175,9 @@ func ToNumber(val DataType) float64 {
 		return float64(v)
 	case NumberType:
 		return float64(v)
+	case BigIntType:
+		// Only valid for explicit conversion, see ToNumberChecked
+		return v.Float64()
 	case StringType:
 		s := strings.TrimSpace(string(v))
 		if s == "" {
@@ -114,6 +193,110 @@ func ToNumber(val DataType) float64 {
 	}
 }
 
+// Determine if the value is a primitive (non-object) type
+func IsPrimitive(val DataType) bool {
+	switch val.(type) {
+	case UndefinedType, NullType, BooleanType, IntegerType, NumberType,
+		BigIntType, StringType, *SymbolType:
+		return true
+	}
+	return false
+}
+
+// Full ToPrimitive conversion (Section 7.1.1) for script objects, honouring
+// Symbol.toPrimitive and (own) valueOf/toString methods.  The hint is one of
+// "default", "number" or "string".  Arrays join their (checked) elements,
+// other types use the type conversion.
+func ToPrimitiveChecked(prc Process, val DataType,
+	hint string) (DataType, error) {
+	if arr, ok := val.(*ArrayType); ok {
+		str, err := JoinElementsChecked(prc, arr.Elements, ",")
+		return StringType(str), err
+	}
+	obj, ok := val.(*ObjectType)
+	if !ok {
+		return val.ToPrimitive(hint), nil
+	}
+
+	if exotic := obj.GetSymbol(SymbolToPrimitive); exotic != Undefined {
+		fn, ok := exotic.(FunctionType)
+		if !ok {
+			return nil, ThrowError(prc, "TypeError",
+				"Symbol.toPrimitive is not a function")
+		}
+		res, err := CallMethod(prc, fn, obj, []DataType{StringType(hint)})
+		if err != nil {
+			return nil, err
+		}
+		if !IsPrimitive(res) {
+			return nil, ThrowError(prc, "TypeError",
+				"Cannot convert object to primitive value")
+		}
+		return res, nil
+	}
+
+	// OrdinaryToPrimitive, method order is determined by the hint
+	methods := []string{"valueOf", "toString"}
+	if hint == "string" {
+		methods = []string{"toString", "valueOf"}
+	}
+	for _, name := range methods {
+		if fn, ok := obj.Get(name).(FunctionType); ok {
+			res, err := CallMethod(prc, fn, obj, nil)
+			if err != nil {
+				return nil, err
+			}
+			if IsPrimitive(res) {
+				return res, nil
+			}
+		}
+	}
+	return obj.ToPrimitive(hint), nil
+}
+
+// Implicit string conversion, symbols cannot be converted (TypeError)
+func ToStringChecked(prc Process, val DataType) (string, error) {
+	prim, err := ToPrimitiveChecked(prc, val, "string")
+	if err != nil {
+		return "", err
+	}
+	if _, ok := prim.(*SymbolType); ok {
+		return "", ThrowError(prc, "TypeError",
+			"Cannot convert a Symbol value to a string")
+	}
+	return ToString(prim), nil
+}
+
+// Implicit number conversion, symbols and BigInts cannot be converted
+// (TypeError)
+func ToNumberChecked(prc Process, val DataType) (float64, error) {
+	prim, err := ToPrimitiveChecked(prc, val, "number")
+	if err != nil {
+		return 0, err
+	}
+	switch prim.(type) {
+	case *SymbolType:
+		return 0, ThrowError(prc, "TypeError",
+			"Cannot convert a Symbol value to a number")
+	case BigIntType:
+		return 0, ThrowError(prc, "TypeError",
+			"Cannot convert a BigInt value to a number")
+	}
+	return ToNumber(prim), nil
+}
+
+// Convert to a property key (Section 7.1.19), either a symbol or a string
+func ToPropertyKey(prc Process, val DataType) (DataType, error) {
+	prim, err := ToPrimitiveChecked(prc, val, "string")
+	if err != nil {
+		return nil, err
+	}
+	if sym, ok := prim.(*SymbolType); ok {
+		return sym, nil
+	}
+	return StringType(ToString(prim)), nil
+}
+
 // Shared function for strict equality (===) comparison
 func StrictEquals(val DataType, cmp DataType) bool {
 	switch vval := val.(type) {
@@ -149,6 +332,11 @@ func StrictEquals(val DataType, cmp DataType) bool {
 			return vval == cval
 		}
 		return false
+	case BigIntType:
+		if cval, ok := cmp.(BigIntType); ok {
+			return vval.Cmp(cval) == 0
+		}
+		return false
 	case *ArrayType:
 		return val == cmp
 	case *ObjectType:
@@ -207,6 +395,20 @@ func NewFromStruct(v interface{}) *ObjectType {
 
 // Translate a reflection value into the associated gescript datatype
 func fromReflectValue(rv reflect.Value) DataType {
+	// Values that are already datatypes (e.g. Decimal) are used as-is
+	if rv.IsValid() && rv.CanInterface() &&
+		!(rv.Kind() == reflect.Ptr && rv.IsNil()) {
+		if dt, ok := rv.Interface().(DataType); ok && dt != nil {
+			return dt
+		}
+		switch bval := rv.Interface().(type) {
+		case *big.Int:
+			return NewBigIntFromBig(bval)
+		case big.Int:
+			return NewBigIntFromBig(&bval)
+		}
+	}
+
 	// Dereference pointers and interfaces
 	for rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Interface {
 		if rv.IsNil() {
@@ -225,6 +427,10 @@ func fromReflectValue(rv reflect.Value) DataType {
 
 	case reflect.Uint, reflect.Uint8, reflect.Uint16,
 		reflect.Uint32, reflect.Uint64:
+		// Values beyond the integer range are BigInts (e.g. 64-bit ids)
+		if rv.Uint() > math.MaxInt64 {
+			return NewBigIntFromUint64(rv.Uint())
+		}
 		return IntegerType(int64(rv.Uint()))
 
 	case reflect.Float32, reflect.Float64:
@@ -234,6 +440,11 @@ func fromReflectValue(rv reflect.Value) DataType {
 		return StringType(rv.String())
 
 	case reflect.Slice, reflect.Array:
+		// Byte slices are shared with a (byte) typed array
+		if rv.Kind() == reflect.Slice &&
+			rv.Type().Elem().Kind() == reflect.Uint8 {
+			return NewUint8ArrayFromBytes(rv.Bytes())
+		}
 		return arrayFromReflectValue(rv)
 
 	case reflect.Map:
@@ -374,7 +585,16 @@ func ParseJSON(jsonStr string) (DataType, error) {
 
 // Convert a gescript dataset into JSON
 func StringifyJSON(dt DataType) (string, error) {
-	raw := dt.Native()
+	return StringifyJSONChecked(nil, dt)
+}
+
+// Convert a gescript dataset into JSON, where host objects are accessed in
+// the calling process
+func StringifyJSONChecked(prc Process, dt DataType) (string, error) {
+	raw, err := jsonNative(prc, dt)
+	if err != nil {
+		return "", err
+	}
 	bytes, err := json.Marshal(raw)
 	if err != nil {
 		return "", err
@@ -0,0 +1,369 @@
+/*
+ * Live binding of Go values (structs, maps, slices) through reflection.
+ *
+ * Copyright (C) 2005-2026 J.M. Heisz.  All Rights Reserved.
+ * See the LICENSE file accompanying the distribution your rights to use
+ * this software.
+ */
+
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Unlike the NewFrom*() translations, which copy the Go data into script
+// objects, a wrapped Go value is accessed directly so script changes are
+// reflected in the Go value (and vice versa).  Property names for struct
+// fields follow the same ges/json tag rules, and methods (including pointer
+// receivers for addressable values) are callable by their Go names.  This is
+// a host object for the engine.  Structs and arrays held by value in a map or
+// interface cannot be updated in place, so those are wrapped as read only
+// copies (assignments raise a TypeError rather than silently being lost).
+// Wrapped slices and arrays are indexed and iterated like script arrays but
+// are not arrays, the Array.prototype methods (map, forEach, etc.) are not
+// available (use Array.from() for a script array copy of the elements).
+type GoObject struct {
+	value    reflect.Value
+	readOnly bool
+}
+
+// Wrap the Go value for live access from scripts.  Pass a pointer to a struct
+// to modify the original, a struct value is copied (as with any Go value).
+// Scalar values are simply translated to the equivalent script type.
+func Wrap(val any) DataType {
+	if val == nil {
+		return NullType{}
+	}
+	if dt, ok := val.(DataType); ok {
+		return dt
+	}
+	return wrapValue(reflect.ValueOf(val))
+}
+
+// Wrap the reflected value, only containers are bound live
+func wrapValue(rv reflect.Value) DataType {
+	return wrapMember(rv, false, false)
+}
+
+// Wrap the reflected value, where a member (field, entry or element) struct
+// or array that is not addressable is a read only copy, as is any member of
+// a read only value
+func wrapMember(rv reflect.Value, member, readOnly bool) DataType {
+	if !rv.IsValid() {
+		return Undefined
+	}
+	if rv.CanInterface() {
+		if dt, ok := rv.Interface().(DataType); ok && dt != nil {
+			return dt
+		}
+	}
+	for rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return NullType{}
+		}
+		rv = rv.Elem()
+	}
+
+	switch rv.Kind() {
+	case reflect.Struct, reflect.Array:
+		// Need an addressable instance for field updates and pointer methods
+		if !rv.CanAddr() {
+			cp := reflect.New(rv.Type()).Elem()
+			cp.Set(rv)
+			rv = cp
+			readOnly = readOnly || member
+		}
+		return &GoObject{value: rv, readOnly: readOnly}
+	case reflect.Map, reflect.Slice:
+		if rv.IsNil() {
+			return NullType{}
+		}
+		return &GoObject{value: rv}
+	case reflect.Func:
+		if rv.IsNil() {
+			return NullType{}
+		}
+		return goFunction(rv.Type().String(), rv)
+	}
+	return fromReflectValue(rv)
+}
+
+// Native() for a wrapped value is the underlying Go value (pointer to the
+// struct/array when addressable)
+func (gobj *GoObject) Native() interface{} {
+	kind := gobj.value.Kind()
+	if (kind == reflect.Struct || kind == reflect.Array) &&
+		gobj.value.CanAddr() {
+		return gobj.value.Addr().Interface()
+	}
+	return gobj.value.Interface()
+}
+
+func (gobj *GoObject) ToPrimitive(pref any) DataType {
+	if str, ok := gobj.Native().(fmt.Stringer); ok {
+		return StringType(str.String())
+	}
+	switch gobj.value.Kind() {
+	case reflect.Slice, reflect.Array:
+		// Like arrays, a comma-separated list of the elements
+		parts := make([]string, gobj.value.Len())
+		for idx := range parts {
+			parts[idx] = ToString(wrapValue(gobj.value.Index(idx)))
+		}
+		return StringType(strings.Join(parts, ","))
+	}
+	return StringType("[object Object]")
+}
+
+// Access the underlying reflection value of the wrapped instance
+func (gobj *GoObject) Value() reflect.Value {
+	return gobj.value
+}
+
+// Retrieve the property value (field, map entry, element or method)
+func (gobj *GoObject) Get(name string) (DataType, error) {
+	return gobj.get(name), nil
+}
+
+func (gobj *GoObject) get(name string) DataType {
+	rv := gobj.value
+	switch rv.Kind() {
+	case reflect.Struct:
+		if field, ok := structField(rv, name); ok {
+			return wrapMember(field, true, gobj.readOnly)
+		}
+	case reflect.Map:
+		if key, err := mapKey(rv, name); err == nil {
+			if val := rv.MapIndex(key); val.IsValid() {
+				return wrapMember(val, true, false)
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		if name == "length" {
+			return IntegerType(rv.Len())
+		}
+		if idx, ok := sliceIndex(name); ok {
+			if idx < rv.Len() {
+				return wrapMember(rv.Index(idx), true, gobj.readOnly)
+			}
+			return Undefined
+		}
+	}
+
+	if method := gobj.method(name); method.IsValid() {
+		return goFunction(name, method)
+	}
+	return Undefined
+}
+
+// Symbol-keyed members, where wrapped slices and arrays are iterable
+func (gobj *GoObject) GetSymbol(sym *SymbolType) DataType {
+	switch gobj.value.Kind() {
+	case reflect.Slice, reflect.Array:
+		if sym == SymbolIterator {
+			return &NativeFunction{Name: "[Symbol.iterator]", Fn: gobj.values}
+		}
+	}
+	return Undefined
+}
+
+// Iterator over the (live) elements, as for the array values iterator
+func (gobj *GoObject) values(prc Process,
+	args []DataType) (DataType, error) {
+	idx := 0
+	return NewIterator(func() (DataType, bool) {
+		if idx >= gobj.value.Len() {
+			return nil, false
+		}
+		idx++
+		return wrapMember(gobj.value.Index(idx-1), true, gobj.readOnly), true
+	}), nil
+}
+
+// Find the named method, pointer receivers are available when addressable
+// (and not a read only copy)
+func (gobj *GoObject) method(name string) reflect.Value {
+	if gobj.value.CanAddr() && !gobj.readOnly {
+		if method := gobj.value.Addr().MethodByName(name); method.IsValid() {
+			return method
+		}
+	}
+	return gobj.value.MethodByName(name)
+}
+
+// Update the property value in the underlying Go value, with conversion to
+// the Go type (error if not convertible or not a valid property)
+func (gobj *GoObject) Set(name string, val DataType) error {
+	rv := gobj.value
+	if gobj.readOnly {
+		return fmt.Errorf("TypeError: Cannot assign to property '%s' of a "+
+			"copied %s value", name, rv.Type())
+	}
+	switch rv.Kind() {
+	case reflect.Struct:
+		field, ok := structField(rv, name)
+		if !ok {
+			return fmt.Errorf("TypeError: Cannot add property %s, object is not "+
+				"extensible", name)
+		}
+		if !field.CanSet() {
+			return fmt.Errorf("TypeError: Cannot assign to read only property '%s'",
+				name)
+		}
+		conv, err := toGoValue(val, field.Type())
+		if err != nil {
+			return fmt.Errorf("TypeError: Cannot assign property '%s': %v", name, err)
+		}
+		field.Set(conv)
+		return nil
+	case reflect.Map:
+		key, err := mapKey(rv, name)
+		if err != nil {
+			return fmt.Errorf("TypeError: %v", err)
+		}
+		conv, err := toGoValue(val, rv.Type().Elem())
+		if err != nil {
+			return fmt.Errorf("TypeError: Cannot assign property '%s': %v", name, err)
+		}
+		rv.SetMapIndex(key, conv)
+		return nil
+	case reflect.Slice, reflect.Array:
+		idx, ok := sliceIndex(name)
+		if !ok {
+			return fmt.Errorf("TypeError: Cannot add property %s, object is not "+
+				"extensible", name)
+		}
+		if idx >= rv.Len() {
+			return fmt.Errorf("TypeError: Index %d out of range for length %d", idx,
+				rv.Len())
+		}
+		conv, err := toGoValue(val, rv.Type().Elem())
+		if err != nil {
+			return fmt.Errorf("TypeError: Cannot assign element %d: %v", idx, err)
+		}
+		rv.Index(idx).Set(conv)
+		return nil
+	}
+	return fmt.Errorf("TypeError: Cannot assign to read only property '%s'", name)
+}
+
+// Remove the property (only map entries can be deleted)
+func (gobj *GoObject) Delete(name string) (bool, error) {
+	if gobj.value.Kind() != reflect.Map {
+		return !gobj.Has(name), nil
+	}
+	if key, err := mapKey(gobj.value, name); err == nil {
+		gobj.value.SetMapIndex(key, reflect.Value{})
+	}
+	return true, nil
+}
+
+// JSON encoding of the wrapped value uses the Go marshaling (tags/options)
+func (gobj *GoObject) MarshalJSON() ([]byte, error) {
+	return json.Marshal(gobj.Native())
+}
+
+// Determine if the property exists, including the (callable) methods
+func (gobj *GoObject) Has(name string) bool {
+	rv := gobj.value
+	switch rv.Kind() {
+	case reflect.Struct:
+		if _, ok := structField(rv, name); ok {
+			return true
+		}
+	case reflect.Map:
+		if key, err := mapKey(rv, name); err == nil &&
+			rv.MapIndex(key).IsValid() {
+			return true
+		}
+	case reflect.Slice, reflect.Array:
+		if idx, ok := sliceIndex(name); ok && idx < rv.Len() {
+			return true
+		}
+		if name == "length" {
+			return true
+		}
+	}
+	return gobj.method(name).IsValid()
+}
+
+// Enumerable property names: struct fields in declaration order, sorted map
+// keys or element indices
+func (gobj *GoObject) Keys() []string {
+	rv := gobj.value
+	var keys []string
+	switch rv.Kind() {
+	case reflect.Struct:
+		rt := rv.Type()
+		for idx := 0; idx < rt.NumField(); idx++ {
+			field := rt.Field(idx)
+			if !field.IsExported() {
+				continue
+			}
+			if name := getFieldName(field); name != "-" {
+				keys = append(keys, name)
+			}
+		}
+	case reflect.Map:
+		for _, key := range rv.MapKeys() {
+			keys = append(keys, fmt.Sprint(key.Interface()))
+		}
+		sort.Strings(keys)
+	case reflect.Slice, reflect.Array:
+		keys = make([]string, rv.Len())
+		for idx := range keys {
+			keys[idx] = strconv.Itoa(idx)
+		}
+	}
+	return keys
+}
+
+// Locate the struct field for the property name (ges/json tag or name)
+func structField(rv reflect.Value, name string) (reflect.Value, bool) {
+	rt := rv.Type()
+	for idx := 0; idx < rt.NumField(); idx++ {
+		field := rt.Field(idx)
+		if field.IsExported() && name != "-" && getFieldName(field) == name {
+			return rv.Field(idx), true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+// Convert the property name to the key type of the map
+func mapKey(rv reflect.Value, name string) (reflect.Value, error) {
+	keyType := rv.Type().Key()
+	switch keyType.Kind() {
+	case reflect.String:
+		return reflect.ValueOf(name).Convert(keyType), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Int64:
+		num, err := strconv.ParseInt(name, 10, keyType.Bits())
+		if err == nil {
+			return reflect.ValueOf(num).Convert(keyType), nil
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64:
+		num, err := strconv.ParseUint(name, 10, keyType.Bits())
+		if err == nil {
+			return reflect.ValueOf(num).Convert(keyType), nil
+		}
+	}
+	return reflect.Value{}, fmt.Errorf("Invalid key '%s' for %s", name,
+		rv.Type())
+}
+
+// Parse an array index property name
+func sliceIndex(name string) (int, bool) {
+	idx, err := strconv.Atoi(name)
+	if err != nil || idx < 0 || strconv.Itoa(idx) != name {
+		return 0, false
+	}
+	return idx, true
+}
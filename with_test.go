@@ -0,0 +1,165 @@
+/*
+ * Test methods for the with statement and implicit scope objects.
+ *
+ * Copyright (C) 2005-2026 J.M. Heisz.  All Rights Reserved.
+ * See the LICENSE file accompanying the distribution your rights to use
+ * this software.
+ */
+
+package gescript
+
+import (
+	"testing"
+
+	"github.com/heisz/gescript/types"
+)
+
+func TestWithStatement(tst *testing.T) {
+	ctx := NewScriptContext()
+
+	// Object properties ahead of locals and globals, which remain visible
+	checkScript(tst, ctx, `var o = {a: 1, b: 2}, a = 10, c = 20;
+                           var r; with (o) { r = a + b + c; } r`, int64(23))
+	checkScript(tst, ctx, `var o = {a: 1}, a = 10;
+                           with (o) a = 5, b = 6;
+                           [o.a, a, typeof o.b, b].join()`,
+		"5,10,undefined,6")
+	checkScript(tst, ctx, `var o = {n: 1};
+                           with (o) { n += 2; n *= 3; } o.n`, int64(9))
+	checkScript(tst, ctx, `var n = 1, o = {n: 5};
+                           with (o) { n++; } n + ':' + o.n`, "1:6")
+	checkScript(tst, ctx, `var o = {m: 5}, r;
+                           with (o) { r = [m++, ++m, m--, --m]; }
+                           r.join() + ':' + o.m`, "5,7,7,5:5")
+	checkScript(tst, ctx, `var o = {u: 1};
+                           with (o) { u++; w = 0; w--; --w; }
+                           [o.u, typeof o.w, w].join()`, "2,undefined,-2")
+	checkScript(tst, ctx, `function f() {
+                               var c = 0, o = {c: 10};
+                               return function() {
+                                   with (o) { c++; }
+                                   return [c++, o.c].join();
+                               };
+                           } f()()`, "0,11")
+	checkScript(tst, ctx, `function C() {} C.prototype.m = () => 'm';
+                           var r; with (new C()) r = m(); r`, "m")
+
+	// Locals declared in the body are not subject to the object
+	checkScript(tst, ctx, `var o = {x: 1};
+                           with (o) { let x = 2; x = 3; } o.x`, int64(1))
+	checkScript(tst, ctx, `var o = {x: 1};
+                           with (o) { var x = 2, y = 3; }
+                           [o.x, typeof x, y].join()`,
+		"2,undefined,3")
+
+	// Nested statements and closures, lookups are dynamic
+	checkScript(tst, ctx, `var o = {a: 1}, p = {a: 2, b: 3}, r = [], a = 'v';
+                           with (o) with (p) { r.push(a, b); }
+                           with (o) var f = function() { return a; };
+                           r.push(f()); o.a = 4; r.push(f());
+                           delete o.a; r.push(f());
+                           r.join()`, "2,3,1,4,v")
+	checkScript(tst, ctx, `function g(rec) {
+                               var total = 0;
+                               with (rec) {
+                                   return () => total > 100 && region == 'EU';
+                               }
+                           }
+                           [g({total: 150, region: 'EU'})(),
+                            g({total: 50, region: 'EU'})()].join()`,
+		"true,false")
+
+	// Functions found on the object are called with the object as 'this'
+	checkScript(tst, ctx, `var o = {x: 1, f() { return this === o && this.x; }};
+                           function g() { return this === o; }
+                           var r; with (o) { r = [f(), g(), f.call({})]; }
+                           r.join()`, "1,false,false")
+	checkScript(tst, ctx, `var o = {eval: function(s) {
+                                   return this === o ? s + '!' : s; }};
+                           var r; with (o) { r = eval('a'); } r`, "a!")
+
+	// Direct eval code resolves through the object, delete removes from it
+	checkScript(tst, ctx, `var o = {x: 5}, x = 1, r;
+                           with (o) { r = eval('x'); eval('x = 7'); }
+                           [r, o.x, x].join()`, "5,7,1")
+	checkScript(tst, ctx, `function f() {
+                               var o = {x: 5}, x = 1;
+                               with (o) {
+                                   let y = 2;
+                                   return (() => eval('x + y'))() +
+                                       eval('var r; with ({x: 3}) r = x; r');
+                               }
+                           } f()`, int64(10))
+	checkScript(tst, ctx, `var o = {x: 5}, x = 1, r;
+                           with (o) { r = [delete x, delete y]; }
+                           [r.join(), 'x' in o, x].join()`,
+		"true,true,false,1")
+
+	// Native instances and constructors
+	checkScript(tst, ctx, "var r; with ([1, 2, 3]) r = length + indexOf(2); r",
+		int64(4))
+	checkScript(tst, ctx, "var r; with (Number) r = isInteger(EPSILON); r",
+		false)
+	checkScript(tst, ctx, "var r; with ('abc') r = toUpperCase(); r", "ABC")
+
+	// Host objects, including writes through the host
+	rec := &hostRecord{table: "orders"}
+	ctx.SetGlobal("rec", rec)
+	checkScript(tst, ctx, `var r;
+                           with (rec) {
+                               name = name + '!'; r = id + ':' + name;
+                           }
+                           r`,
+		"7:widget!")
+	if !rec.dirty["name"] {
+		tst.Errorf("Expected write of name through the host object")
+	}
+	checkScript(tst, ctx, catchName("with (rec) id = 3"), "TypeError")
+
+	// Scope object must be an object
+	checkScript(tst, ctx, catchName("with (null) {}"), "TypeError")
+	for _, src := range []string{
+		"with {}",
+		"with (o",
+		"function f() { 'use strict'; with (o) {} }",
+	} {
+		if _, err := Parse(src); err == nil {
+			tst.Errorf("Expected with statement error for '%s'", src)
+		}
+	}
+}
+
+func TestRunWithScope(tst *testing.T) {
+	ctx := NewScriptContext()
+	prg, err := Parse(`var big = function() { return total > 100; };
+                       total = total + 1;
+                       big() && region == 'EU' && typeof missing`)
+	if err != nil {
+		tst.Fatalf("Unexpected error parsing script: %v", err)
+	}
+
+	// Host record, writes go back to the record
+	rec := &hostRecord{table: "orders"}
+	rec.load()
+	rec.columns["total"] = types.IntegerType(100)
+	rec.columns["region"] = types.StringType("EU")
+	res, err := prg.RunWithScope(ctx, rec)
+	if err != nil || res.Native() != "undefined" {
+		tst.Errorf("Incorrect host scope result %v (%v)", res, err)
+	}
+	if rec.columns["total"] != types.IntegerType(101) {
+		tst.Errorf("Expected total update, got %v", rec.columns["total"])
+	}
+
+	// Script object as scope
+	obj := types.NewObject()
+	obj.Set("total", types.IntegerType(5))
+	obj.Set("region", types.StringType("EU"))
+	res, err = prg.RunWithScope(ctx, obj)
+	if err != nil || res.Native() != false {
+		tst.Errorf("Incorrect object scope result %v (%v)", res, err)
+	}
+	if ctx.GetGlobal("total") != types.Undefined {
+		tst.Errorf("Scope assignment should not create a global")
+	}
+}
@@ -0,0 +1,220 @@
+/*
+ * Test methods for the live binding of Go values (types.Wrap).
+ *
+ * Copyright (C) 2005-2026 J.M. Heisz.  All Rights Reserved.
+ * See the LICENSE file accompanying the distribution your rights to use
+ * this software.
+ */
+
+package gescript
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/heisz/gescript/types"
+)
+
+type wrapItem struct {
+	Name     string  `json:"name"`
+	Price    float64 `json:"price"`
+	Quantity int     `ges:"qty" json:"quantity"`
+	Internal string  `json:"-"`
+	hidden   int
+}
+
+type wrapOrder struct {
+	ID     int               `json:"id"`
+	Status string            `json:"status"`
+	Items  []wrapItem        `json:"items"`
+	Tags   map[string]string `json:"tags"`
+	Next   *wrapOrder        `json:"next"`
+}
+
+func (ord *wrapOrder) Total() float64 {
+	total := 0.0
+	for _, item := range ord.Items {
+		total += item.Price * float64(item.Quantity)
+	}
+	return total
+}
+
+func (ord *wrapOrder) Ship(carrier string) error {
+	if carrier == "" {
+		return errors.New("No carrier specified")
+	}
+	ord.Status = "shipped via " + carrier
+	return nil
+}
+
+func (ord wrapOrder) Summary() (int, string) {
+	return ord.ID, ord.Status
+}
+
+func TestWrapStruct(tst *testing.T) {
+	ord := &wrapOrder{
+		ID:     12,
+		Status: "new",
+		Items: []wrapItem{
+			{Name: "widget", Price: 2.5, Quantity: 2},
+			{Name: "gadget", Price: 10, Quantity: 1},
+		},
+		Tags: map[string]string{"rush": "yes"},
+	}
+	ctx := NewScriptContext()
+	ctx.SetGlobal("order", types.Wrap(ord))
+
+	// Reads and writes go through to the Go value
+	checkScript(tst, ctx, "order.id + ':' + order.status", "12:new")
+	checkScript(tst, ctx, "order.status = 'paid'; order.id++; order.id",
+		int64(13))
+	checkScript(tst, ctx, "order.items[0].qty = 4; order.items[1].name",
+		"gadget")
+	checkScript(tst, ctx, "order.items.length", int64(2))
+	checkScript(tst, ctx, "order.next === null && order.Internal", nil)
+	if ord.Status != "paid" || ord.ID != 13 || ord.Items[0].Quantity != 4 {
+		tst.Fatalf("Script updates not reflected in Go struct: %+v", ord)
+	}
+
+	// Go changes are visible to the script
+	ord.Items[1].Price = 5
+	checkScript(tst, ctx, "order.items[1].price", float64(5))
+
+	// Methods, including pointer receivers, errors and multiple results
+	checkScript(tst, ctx, "order.Total()", float64(15))
+	checkScript(tst, ctx, "order.Ship('post'); order.status",
+		"shipped via post")
+	checkScript(tst, ctx, `var r = '';
+                           try { order.Ship(''); } catch (e) { r = e.message; }
+                           r`, "No carrier specified")
+	checkScript(tst, ctx, `var r = '';
+                           try { order.Ship(12); } catch (e) { r = e.name; }
+                           r`, "TypeError")
+	checkScript(tst, ctx, "order.Summary().join('/')",
+		"13/shipped via post")
+
+	// Type conversion errors and non-extensible structs
+	checkScript(tst, ctx, `var r = '';
+                           try { order.id = 'x'; } catch (e) { r = e.name; }
+                           r + order.id`, "TypeError13")
+	checkScript(tst, ctx, `var r = '';
+                           try { order.id = 1.5; } catch (e) { r = e.name; }
+                           r`, "TypeError")
+	checkScript(tst, ctx, `var r = '';
+                           try { order.extra = 1; } catch (e) { r = e.message; }
+                           r`, "Cannot add property extra, object is not "+
+		"extensible")
+
+	// Enumeration follows the tags, skipping unexported/ignored fields
+	checkScript(tst, ctx, `var k = '';
+                           for (var p in order.items[0]) k = k + p + ',';
+                           k`, "name,price,qty,")
+	checkScript(tst, ctx, "Object.keys(order).join(',')",
+		"id,status,items,tags,next")
+	checkScript(tst, ctx, "typeof order", "object")
+	checkScript(tst, ctx, `['Total' in order, 'Summary' in order.next,
+                            'total' in order, 'name' in order.items[0],
+                            'length' in order.items].join()`,
+		"true,false,false,true,true")
+
+	// Methods (and fields) resolve as identifiers through the scope
+	checkScript(tst, ctx, "var r; with (order) { r = Total() + ':' + id; } r",
+		"15:13")
+	prg, err := Parse("Summary().join('/') + ':' + Total()")
+	if err != nil {
+		tst.Fatalf("Unexpected error parsing scope script: %v", err)
+	}
+	res, err := prg.RunWithScope(ctx, types.Wrap(ord))
+	if err != nil || res.Native() != "13/shipped via post:15" {
+		tst.Fatalf("Incorrect scope method result: %v %v", res, err)
+	}
+	checkScript(tst, ctx, "JSON.stringify(order.items[1])",
+		`{"name":"gadget","price":5,"quantity":1}`)
+
+	// Struct values are copies (but still modifiable)
+	item := wrapItem{Name: "copy"}
+	ctx.SetGlobal("item", types.Wrap(item))
+	checkScript(tst, ctx, "item.name = 'changed'; item.name", "changed")
+	if item.Name != "copy" {
+		tst.Fatalf("Wrapped struct value should be a copy")
+	}
+}
+
+func TestWrapMapSlice(tst *testing.T) {
+	tags := map[string]string{"a": "1"}
+	counts := map[int]int{1: 10}
+	nums := []int{1, 2, 3}
+	ctx := NewScriptContext()
+	ctx.SetGlobal("tags", types.Wrap(tags))
+	ctx.SetGlobal("counts", types.Wrap(counts))
+	ctx.SetGlobal("nums", types.Wrap(nums))
+
+	checkScript(tst, ctx, "tags.b = '2'; tags['c'] = '3'; tags.a", "1")
+	checkScript(tst, ctx, "Object.keys(tags).join(',')", "a,b,c")
+	checkScript(tst, ctx, "delete tags.a; ('a' in tags) + ':' + ('b' in tags)",
+		"false:true")
+	checkScript(tst, ctx, "tags.missing", nil)
+	if len(tags) != 2 || tags["c"] != "3" {
+		tst.Fatalf("Script updates not reflected in Go map: %v", tags)
+	}
+
+	checkScript(tst, ctx, "counts[1] = counts[1] + 1; counts[2] = 5; counts[1]",
+		int64(11))
+	if counts[1] != 11 || counts[2] != 5 {
+		tst.Fatalf("Script updates not reflected in Go int map: %v", counts)
+	}
+
+	checkScript(tst, ctx, "nums[1] = 20; nums.length + ':' + nums", "3:1,20,3")
+	checkScript(tst, ctx, `var t = 0;
+                           for (var i in nums) t = t + nums[i];
+                           t`, int64(24))
+	checkScript(tst, ctx, `var r = '';
+                           try { nums[5] = 1; } catch (e) { r = e.name; }
+                           r`, "TypeError")
+
+	// Slices (and arrays) iterate and spread by element
+	checkScript(tst, ctx, `var t = '', sum = (a, b, c) => a + b + c;
+                           for (var v of nums) t = t + v + ';';
+                           t + [...nums, 4].join() + ':' + sum(...nums)`,
+		"1;20;3;1,20,3,4:24")
+	ctx.SetGlobal("pairs", types.Wrap([2][]string{{"a", "b"}, {"c"}}))
+	checkScript(tst, ctx, `var t = '';
+                           for (const p of pairs) t = t + p[0] + p[1];
+                           t + [...pairs[0]].length`, "abcundefined2")
+	if nums[1] != 20 {
+		tst.Fatalf("Script updates not reflected in Go slice: %v", nums)
+	}
+
+	// Structs held by value in a map (or interface) are read only copies,
+	// but entries in a map of pointers can be updated
+	orders := map[string]wrapOrder{"a": {ID: 1, Status: "new",
+		Items: []wrapItem{{Name: "widget"}}}}
+	refs := map[string]*wrapOrder{"b": {ID: 2, Status: "new"}}
+	ctx.SetGlobal("orders", types.Wrap(orders))
+	ctx.SetGlobal("refs", types.Wrap(refs))
+	ctx.SetGlobal("boxed", types.Wrap([]any{wrapItem{Name: "boxed"}}))
+	for _, src := range []string{
+		"orders.a.status = 'done'", "orders.a.id++", "boxed[0].name = 'x'",
+	} {
+		checkScript(tst, ctx, catchName(src), "TypeError")
+	}
+	checkScript(tst, ctx, `orders.a.items[0].qty = 3; refs.b.status = 'done';
+                           [orders.a.status, orders.a.Summary().join('/'),
+                            typeof orders.a.Ship, boxed[0].name,
+                            refs.b.status].join()`,
+		"new,1/new,undefined,boxed,done")
+	if orders["a"].Status != "new" || orders["a"].Items[0].Quantity != 3 ||
+		refs["b"].Status != "done" {
+		tst.Fatalf("Incorrect updates through Go maps: %+v %+v", orders,
+			refs["b"])
+	}
+	checkScript(tst, ctx, `typeof nums.map + ':' +
+                               Array.from(nums).map(x => x * 2)`,
+		"undefined:2,40,6")
+
+	// Scalars are just translated
+	if types.Wrap(12) != types.IntegerType(12) ||
+		types.Wrap("s") != types.StringType("s") {
+		tst.Fatalf("Incorrect wrapping of scalar values")
+	}
+}